@@ -0,0 +1,44 @@
+// Command passwordcorpus turns a newline-separated password list into the
+// compact corpus file read by infrastructure.LoadPasswordCorpusFile.
+//
+//	go run ./cmd/passwordcorpus -in rockyou.txt -out breached.bin
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure"
+)
+
+func main() {
+	in := flag.String("in", "", "password list, one per line")
+	out := flag.String("out", "", "corpus file to write")
+	flag.Parse()
+
+	if *in == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	list, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer list.Close()
+
+	corpus, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := infrastructure.WritePasswordCorpus(corpus, list); err != nil {
+		corpus.Close()
+		log.Fatal(err)
+	}
+
+	if err := corpus.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+type CreateAPIKeyInput struct {
+	Name      string     `json:"name" binding:"required"`
+	StoreID   uuid.UUID  `json:"store_id" binding:"required"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type APIKeyInfo struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	StoreID    uuid.UUID  `json:"store_id"`
+	Scopes     []string   `json:"scopes"`
+	Prefix     string     `json:"prefix"`
+	CreatedBy  uuid.UUID  `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// CreatedAPIKey is the only response that carries the plain key.
+type CreatedAPIKey struct {
+	APIKeyInfo
+	Key string `json:"key"`
+}
+
+// APIKeyClaims is the principal of a request authenticated by an API key,
+// the non-human counterpart of UserClaims.
+type APIKeyClaims struct {
+	KeyID   uuid.UUID        `json:"key_id"`
+	Name    string           `json:"name"`
+	StoreID uuid.UUID        `json:"store_id"`
+	Scopes  []vo.APIKeyScope `json:"scopes"`
+}
+
+func (c *APIKeyClaims) HasScope(scope vo.APIKeyScope) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// AuditQuery holds the raw filters of GET /admin/audit. Empty fields do not
+// filter; From and To are RFC 3339 timestamps.
+type AuditQuery struct {
+	ActorID  string
+	TargetID string
+	Action   string
+	From     string
+	To       string
+}
+
+// AuditFilter is AuditQuery once validated. From is inclusive and To
+// exclusive.
+type AuditFilter struct {
+	ActorID  *uuid.UUID
+	TargetID *uuid.UUID
+	Action   *vo.AuditAction
+	From     *time.Time
+	To       *time.Time
+}
+
+type AuditEventInfo struct {
+	ID             uuid.UUID                  `json:"id"`
+	Action         string                     `json:"action"`
+	ActorID        *uuid.UUID                 `json:"actor_id,omitempty"`
+	TargetID       *uuid.UUID                 `json:"target_id,omitempty"`
+	ImpersonatorID *uuid.UUID                 `json:"impersonator_id,omitempty"`
+	IPAddress      string                     `json:"ip_address,omitempty"`
+	UserAgent      string                     `json:"user_agent,omitempty"`
+	Changes        map[string]AuditChangeInfo `json:"changes,omitempty"`
+	OccurredAt     time.Time                  `json:"occurred_at"`
+}
+
+type AuditChangeInfo struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
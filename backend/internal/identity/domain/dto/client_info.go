@@ -0,0 +1,25 @@
+package dto
+
+import "context"
+
+// ClientInfo identifies the client behind a request and is recorded on the
+// session it creates or refreshes.
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+type clientInfoKey struct{}
+
+// WithClientInfo carries the client through the context, for use cases that
+// only need it to fill in the audit log.
+func WithClientInfo(ctx context.Context, client ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, client)
+}
+
+// ClientInfoFromContext returns the client stored by WithClientInfo, or an
+// empty ClientInfo when the call did not come through HTTP.
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	client, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return client
+}
@@ -1,8 +1,9 @@
 package dto
 
+// CreateUserInput is the public sign-up form. It carries no roles: staff
+// join through invitations, which fix their store and roles in advance.
 type CreateUserInput struct {
-	Username string   `json:"username" binding:"required"`
-	Email    string   `json:"email" binding:"required,email"`
-	Password string   `json:"password" binding:"required,min=8"`
-	Roles    []string `json:"roles" binding:"required"`
+	Username string `json:"username" binding:"required"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8"`
 }
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StartImpersonationInput names why support is acting as the user, which is
+// kept in the audit log. StoreID, if set, opens the impersonation inside one
+// of the user's stores, with the roles of their membership there.
+type StartImpersonationInput struct {
+	Reason  string `json:"reason" binding:"required"`
+	StoreID string `json:"store_id"`
+}
+
+// ImpersonationResult carries an access token that acts as the user until
+// ExpiresAt. It comes without a refresh token: once it expires, a new
+// impersonation has to be started.
+type ImpersonationResult struct {
+	ImpersonationID uuid.UUID `json:"impersonation_id"`
+	UserID          uuid.UUID `json:"user_id"`
+	AccessToken     string    `json:"access_token"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
+
+type impersonatorKey struct{}
+
+// WithImpersonator marks the context of a request made through an
+// impersonated session, so the audit log can name the admin behind it.
+func WithImpersonator(ctx context.Context, impersonatorID uuid.UUID) context.Context {
+	return context.WithValue(ctx, impersonatorKey{}, impersonatorID)
+}
+
+// ImpersonatorFromContext returns the admin stored by WithImpersonator, or nil
+// when the request was made by the user themselves.
+func ImpersonatorFromContext(ctx context.Context) *uuid.UUID {
+	impersonatorID, ok := ctx.Value(impersonatorKey{}).(uuid.UUID)
+	if !ok {
+		return nil
+	}
+
+	return &impersonatorID
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InviteUserInput struct {
+	Email string   `json:"email" binding:"required,email"`
+	Roles []string `json:"roles" binding:"required,min=1"`
+}
+
+type AcceptInvitationInput struct {
+	Token    string `json:"token" binding:"required"`
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+type AcceptInvitationAsMemberInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type InvitationInfo struct {
+	ID         uuid.UUID  `json:"id"`
+	Email      string     `json:"email"`
+	StoreID    uuid.UUID  `json:"store_id"`
+	Roles      []string   `json:"roles"`
+	InvitedBy  uuid.UUID  `json:"invited_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
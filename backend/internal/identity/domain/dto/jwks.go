@@ -0,0 +1,20 @@
+package dto
+
+// JWKS is the JSON Web Key Set (RFC 7517) with the public keys that validate
+// access tokens.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is a single public key. Only the members of its key type are set:
+// Crv and X for OKP (Ed25519) keys, N and E for RSA keys.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
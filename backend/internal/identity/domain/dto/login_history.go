@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LoginAttemptInfo struct {
+	ID         uuid.UUID `json:"id"`
+	Outcome    string    `json:"outcome"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	Device     string    `json:"device"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
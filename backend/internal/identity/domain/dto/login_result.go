@@ -1,6 +1,17 @@
 package dto
 
+// LoginResult carries either the session tokens or, when the user still has
+// to present a second factor, only the MFA challenge token. MFAEnrollment is
+// set only on the login that starts the enrollment a role requires; later
+// logins with the enrollment still pending get the challenge alone.
 type LoginResult struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken       string         `json:"access_token,omitempty"`
+	RefreshToken      string         `json:"refresh_token,omitempty"`
+	MFAChallengeToken string         `json:"mfa_challenge_token,omitempty"`
+	MFAEnrollment     *MFAEnrollment `json:"mfa_enrollment,omitempty"`
+	RecoveryCodes     []string       `json:"recovery_codes,omitempty"`
+}
+
+func (r *LoginResult) MFARequired() bool {
+	return r.MFAChallengeToken != ""
 }
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SetStorePasswordPolicyInput struct {
+	HistorySize *int `json:"history_size" binding:"required"`
+}
+
+// StorePasswordPolicyInfo reports a history size of 0 and no update time for
+// a store that never set a policy.
+type StorePasswordPolicyInfo struct {
+	StoreID     uuid.UUID  `json:"store_id"`
+	HistorySize int        `json:"history_size"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
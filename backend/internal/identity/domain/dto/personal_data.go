@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeleteAccountInput confirms an erasure request with the current password,
+// so a session left open on a shared computer cannot delete the account.
+type DeleteAccountInput struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// PersonalDataExport is everything stored about a user, for data subject
+// access requests. Secrets are left out on purpose: password hashes, the TOTP
+// secret and the recovery codes are never exported.
+type PersonalDataExport struct {
+	ExportedAt   time.Time          `json:"exported_at"`
+	Profile      ExportedProfile    `json:"profile"`
+	MFA          ExportedMFA        `json:"mfa"`
+	Sessions     []SessionInfo      `json:"sessions"`
+	Stores       []StoreMemberInfo  `json:"stores"`
+	Invitations  []InvitationInfo   `json:"invitations"`
+	AuditEvents  []AuditEventInfo   `json:"audit_events"`
+	LoginHistory []LoginAttemptInfo `json:"login_history"`
+}
+
+type ExportedProfile struct {
+	ID             uuid.UUID  `json:"id"`
+	Username       string     `json:"username"`
+	Email          string     `json:"email"`
+	Roles          []string   `json:"roles"`
+	Active         bool       `json:"active"`
+	EmailVerified  bool       `json:"email_verified"`
+	FailedAttempts int        `json:"failed_attempts"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+}
+
+type ExportedMFA struct {
+	Enrolled          bool `json:"enrolled"`
+	Enabled           bool `json:"enabled"`
+	RecoveryCodesLeft int  `json:"recovery_codes_left"`
+}
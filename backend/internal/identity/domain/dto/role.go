@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateRoleInput struct {
+	Name        string    `json:"name" binding:"required"`
+	StoreID     uuid.UUID `json:"store_id" binding:"required"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions" binding:"required,min=1"`
+}
+
+type UpdateRoleInput struct {
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions" binding:"required,min=1"`
+}
+
+type RoleInfo struct {
+	Name        string     `json:"name"`
+	StoreID     *uuid.UUID `json:"store_id,omitempty"`
+	Description string     `json:"description"`
+	Permissions []string   `json:"permissions"`
+	System      bool       `json:"system"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AddStoreMemberInput struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+	Roles  []string  `json:"roles" binding:"required,min=1"`
+}
+
+type UpdateStoreMemberInput struct {
+	Roles  []string `json:"roles" binding:"required,min=1"`
+	Status string   `json:"status"`
+}
+
+type SwitchStoreInput struct {
+	StoreID string `json:"store_id"`
+}
+
+type StoreMemberInfo struct {
+	UserID    uuid.UUID `json:"user_id"`
+	StoreID   uuid.UUID `json:"store_id"`
+	Roles     []string  `json:"roles"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -5,7 +5,23 @@ import (
 	"github.com/google/uuid"
 )
 
+// UserClaims describes the principal of a request. StoreID is the active
+// store of a session that switched into one; Roles then already include the
+// roles of the membership in that store.
+//
+// During an impersonation UserID is the effective user, whose roles and token
+// version the token carries, and ImpersonatorID is the admin actually making
+// the request.
 type UserClaims struct {
-	UserID uuid.UUID `json:"user_id"`
-	Roles  []vo.Role `json:"roles"`
+	UserID          uuid.UUID  `json:"user_id"`
+	Roles           []vo.Role  `json:"roles"`
+	EmailVerified   bool       `json:"email_verified"`
+	TokenVersion    int        `json:"token_version"`
+	StoreID         *uuid.UUID `json:"store_id,omitempty"`
+	ImpersonatorID  *uuid.UUID `json:"impersonator_id,omitempty"`
+	ImpersonationID *uuid.UUID `json:"impersonation_id,omitempty"`
+}
+
+func (c *UserClaims) IsImpersonated() bool {
+	return c.ImpersonatorID != nil
 }
@@ -0,0 +1,35 @@
+package dto
+
+import "github.com/google/uuid"
+
+// UserImportRow is one user read from an import file. Line is its line in the
+// file, so the report points at what the admin has to fix. Users with a store
+// become members of it with Roles; users without one get Roles globally.
+type UserImportRow struct {
+	Line     int
+	Email    string
+	Username string
+	Roles    []string
+	StoreID  string
+}
+
+// UserImportRowResult is the outcome of one row: the reasons it cannot be
+// imported or, once imported, the ID of the new user.
+type UserImportRowResult struct {
+	Line     int        `json:"line"`
+	Email    string     `json:"email"`
+	Username string     `json:"username"`
+	UserID   *uuid.UUID `json:"user_id,omitempty"`
+	Errors   []string   `json:"errors,omitempty"`
+}
+
+// UserImportReport covers every row of an import. Nothing is imported unless
+// every row is valid, so Imported is false on a dry run and whenever Invalid
+// is not zero.
+type UserImportReport struct {
+	DryRun   bool                  `json:"dry_run"`
+	Imported bool                  `json:"imported"`
+	Total    int                   `json:"total"`
+	Invalid  int                   `json:"invalid"`
+	Rows     []UserImportRowResult `json:"rows"`
+}
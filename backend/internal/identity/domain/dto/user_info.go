@@ -1,7 +1,45 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserInfo is the profile of the current user. ImpersonatedBy is set while an
+// admin is acting as the user, so clients can show that the session is not
+// the user's own.
 type UserInfo struct {
-	Username string   `json:"username"`
-	Email    string   `json:"email"`
-	Role     []string `json:"role"`
+	Username       string     `json:"username"`
+	Email          string     `json:"email"`
+	Role           []string   `json:"role"`
+	ImpersonatedBy *uuid.UUID `json:"impersonated_by,omitempty"`
+}
+
+// UpdateProfileInput changes only the fields that are set. A new email is not
+// applied until it is confirmed from the link sent to it.
+type UpdateProfileInput struct {
+	Username *string `json:"username"`
+	Email    *string `json:"email"`
+}
+
+// UpdateProfileResult is the profile after the update, with the address still
+// waiting for confirmation, if any.
+type UpdateProfileResult struct {
+	UserInfo
+	PendingEmail string `json:"pending_email,omitempty"`
+}
+
+// AdminUserInfo is a user as listed to admins, including the lockout state
+// so locked accounts can be found and released.
+type AdminUserInfo struct {
+	ID             uuid.UUID  `json:"id"`
+	Username       string     `json:"username"`
+	Email          string     `json:"email"`
+	Roles          []string   `json:"roles"`
+	Active         bool       `json:"active"`
+	EmailVerified  bool       `json:"email_verified"`
+	FailedAttempts int        `json:"failed_attempts"`
+	Locked         bool       `json:"locked"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
 }
@@ -0,0 +1,205 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// APIKey is a non-human principal bound to one store. Its plain token is only
+// known when it is created; afterwards the key is found by the token hash.
+type APIKey struct {
+	id         uuid.UUID
+	name       string
+	storeID    uuid.UUID
+	scopes     []vo.APIKeyScope
+	prefix     string
+	hash       string
+	createdBy  uuid.UUID
+	createdAt  time.Time
+	expiresAt  *time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+}
+
+func NewAPIKey(
+	name string,
+	storeID uuid.UUID,
+	scopes []vo.APIKeyScope,
+	token vo.APIKeyToken,
+	createdBy uuid.UUID,
+	expiresAt *time.Time,
+	now time.Time,
+) (*APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrAPIKeyNameRequired
+	}
+
+	if storeID == uuid.Nil {
+		return nil, ErrAPIKeyStoreRequired
+	}
+
+	if len(scopes) == 0 {
+		return nil, ErrAPIKeyScopesRequired
+	}
+
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrAPIKeyExpiryInPast
+	}
+
+	return &APIKey{
+		id:        uuid.New(),
+		name:      name,
+		storeID:   storeID,
+		scopes:    uniqueScopes(scopes),
+		prefix:    token.Prefix(),
+		hash:      token.Hash(),
+		createdBy: createdBy,
+		createdAt: now,
+		expiresAt: expiresAt,
+	}, nil
+}
+
+func RestoreAPIKey(
+	id uuid.UUID,
+	name string,
+	storeID uuid.UUID,
+	scopes []string,
+	prefix string,
+	hash string,
+	createdBy uuid.UUID,
+	createdAt time.Time,
+	expiresAt *time.Time,
+	lastUsedAt *time.Time,
+	revokedAt *time.Time,
+) (*APIKey, error) {
+	scopesVO := make([]vo.APIKeyScope, 0, len(scopes))
+	for _, s := range scopes {
+		scope, err := vo.NewAPIKeyScope(s)
+		if err != nil {
+			return nil, err
+		}
+
+		scopesVO = append(scopesVO, scope)
+	}
+
+	return &APIKey{
+		id:         id,
+		name:       name,
+		storeID:    storeID,
+		scopes:     scopesVO,
+		prefix:     prefix,
+		hash:       hash,
+		createdBy:  createdBy,
+		createdAt:  createdAt,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+	}, nil
+}
+
+func (k *APIKey) ID() uuid.UUID {
+	return k.id
+}
+
+func (k *APIKey) Name() string {
+	return k.name
+}
+
+func (k *APIKey) StoreID() uuid.UUID {
+	return k.storeID
+}
+
+func (k *APIKey) Scopes() []vo.APIKeyScope {
+	return k.scopes
+}
+
+func (k *APIKey) Prefix() string {
+	return k.prefix
+}
+
+func (k *APIKey) Hash() string {
+	return k.hash
+}
+
+func (k *APIKey) CreatedBy() uuid.UUID {
+	return k.createdBy
+}
+
+func (k *APIKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+func (k *APIKey) ExpiresAt() *time.Time {
+	return k.expiresAt
+}
+
+func (k *APIKey) LastUsedAt() *time.Time {
+	return k.lastUsedAt
+}
+
+func (k *APIKey) RevokedAt() *time.Time {
+	return k.revokedAt
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.revokedAt != nil
+}
+
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.expiresAt != nil && !now.Before(*k.expiresAt)
+}
+
+// IsUsable reports whether the key may still authenticate requests.
+func (k *APIKey) IsUsable(now time.Time) bool {
+	return !k.IsRevoked() && !k.IsExpired(now)
+}
+
+func (k *APIKey) HasScope(scope vo.APIKeyScope) bool {
+	for _, s := range k.scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k *APIKey) Revoke(now time.Time) error {
+	if k.revokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+
+	k.revokedAt = &now
+	return nil
+}
+
+// MarkUsed records a use and reports whether the stored timestamp is stale
+// enough to be worth writing, so busy keys do not cost a write per request.
+func (k *APIKey) MarkUsed(now time.Time, resolution time.Duration) bool {
+	if k.lastUsedAt != nil && now.Sub(*k.lastUsedAt) < resolution {
+		return false
+	}
+
+	k.lastUsedAt = &now
+	return true
+}
+
+func uniqueScopes(scopes []vo.APIKeyScope) []vo.APIKeyScope {
+	seen := make(map[vo.APIKeyScope]bool, len(scopes))
+	unique := make([]vo.APIKeyScope, 0, len(scopes))
+
+	for _, scope := range scopes {
+		if seen[scope] {
+			continue
+		}
+
+		seen[scope] = true
+		unique = append(unique, scope)
+	}
+
+	return unique
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	now := time.Now()
+	storeID := uuid.New()
+	token, _ := vo.GenerateAPIKeyToken()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		keyName   string
+		storeID   uuid.UUID
+		scopes    []vo.APIKeyScope
+		expiresAt *time.Time
+		wantErr   error
+	}{
+		{name: "Valid", keyName: "erp sync", storeID: storeID, scopes: []vo.APIKeyScope{vo.ScopeOrdersRead}, expiresAt: &future},
+		{name: "No Expiry", keyName: "erp sync", storeID: storeID, scopes: []vo.APIKeyScope{vo.ScopeOrdersRead}},
+		{name: "Empty Name", keyName: "  ", storeID: storeID, scopes: []vo.APIKeyScope{vo.ScopeOrdersRead}, wantErr: ErrAPIKeyNameRequired},
+		{name: "No Store", keyName: "erp sync", storeID: uuid.Nil, scopes: []vo.APIKeyScope{vo.ScopeOrdersRead}, wantErr: ErrAPIKeyStoreRequired},
+		{name: "No Scopes", keyName: "erp sync", storeID: storeID, wantErr: ErrAPIKeyScopesRequired},
+		{name: "Expiry In Past", keyName: "erp sync", storeID: storeID, scopes: []vo.APIKeyScope{vo.ScopeOrdersRead}, expiresAt: &past, wantErr: ErrAPIKeyExpiryInPast},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewAPIKey(tt.keyName, tt.storeID, tt.scopes, token, uuid.New(), tt.expiresAt, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, token.Hash(), key.Hash())
+			assert.Equal(t, token.Prefix(), key.Prefix())
+			assert.True(t, key.IsUsable(now))
+		})
+	}
+}
+
+func TestAPIKey_Lifecycle(t *testing.T) {
+	now := time.Now()
+	token, _ := vo.GenerateAPIKeyToken()
+	expiresAt := now.Add(time.Hour)
+
+	key, _ := NewAPIKey("erp sync", uuid.New(), []vo.APIKeyScope{vo.ScopeOrdersRead, vo.ScopeOrdersRead}, token, uuid.New(), &expiresAt, now)
+
+	assert.Len(t, key.Scopes(), 1)
+	assert.True(t, key.HasScope(vo.ScopeOrdersRead))
+	assert.False(t, key.HasScope(vo.ScopeOrdersWrite))
+
+	assert.False(t, key.IsUsable(expiresAt))
+
+	assert.True(t, key.MarkUsed(now, time.Minute))
+	assert.False(t, key.MarkUsed(now.Add(time.Second), time.Minute))
+	assert.True(t, key.MarkUsed(now.Add(2*time.Minute), time.Minute))
+
+	assert.NoError(t, key.Revoke(now))
+	assert.False(t, key.IsUsable(now))
+	assert.ErrorIs(t, key.Revoke(now), ErrAPIKeyRevoked)
+}
@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// AuditChange is the value of one field before and after an audited action.
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditEvent is an append-only record of a security-relevant action. The
+// actor is nil when nobody was authenticated, e.g. a failed login or a reset
+// by emailed code, and the target is nil when the action names no user. The
+// impersonator is the admin who acted through an impersonated session, in
+// which case the actor is the impersonated user.
+type AuditEvent struct {
+	id             uuid.UUID
+	action         vo.AuditAction
+	actorID        *uuid.UUID
+	targetID       *uuid.UUID
+	impersonatorID *uuid.UUID
+	ipAddress      string
+	userAgent      string
+	changes        map[string]AuditChange
+	occurredAt     time.Time
+}
+
+func NewAuditEvent(action vo.AuditAction, actorID, targetID *uuid.UUID, ipAddress, userAgent string, now time.Time) *AuditEvent {
+	return &AuditEvent{
+		id:         uuid.New(),
+		action:     action,
+		actorID:    actorID,
+		targetID:   targetID,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+		changes:    make(map[string]AuditChange),
+		occurredAt: now,
+	}
+}
+
+func RestoreAuditEvent(
+	id uuid.UUID,
+	action string,
+	actorID *uuid.UUID,
+	targetID *uuid.UUID,
+	impersonatorID *uuid.UUID,
+	ipAddress string,
+	userAgent string,
+	changes map[string]AuditChange,
+	occurredAt time.Time,
+) (*AuditEvent, error) {
+	restAction, err := vo.NewAuditAction(action)
+	if err != nil {
+		return nil, err
+	}
+
+	if changes == nil {
+		changes = make(map[string]AuditChange)
+	}
+
+	return &AuditEvent{
+		id:             id,
+		action:         restAction,
+		actorID:        actorID,
+		targetID:       targetID,
+		impersonatorID: impersonatorID,
+		ipAddress:      ipAddress,
+		userAgent:      userAgent,
+		changes:        changes,
+		occurredAt:     occurredAt,
+	}, nil
+}
+
+// WithChange records the before and after value of a field. Secrets such as
+// password hashes must never be passed here; record that they changed with a
+// boolean instead.
+func (e *AuditEvent) WithChange(field string, before, after any) *AuditEvent {
+	e.changes[field] = AuditChange{Before: before, After: after}
+	return e
+}
+
+// WithImpersonator records the admin behind an impersonated session. A nil
+// impersonatorID leaves the event unchanged.
+func (e *AuditEvent) WithImpersonator(impersonatorID *uuid.UUID) *AuditEvent {
+	e.impersonatorID = impersonatorID
+	return e
+}
+
+func (e *AuditEvent) ID() uuid.UUID {
+	return e.id
+}
+
+func (e *AuditEvent) Action() vo.AuditAction {
+	return e.action
+}
+
+func (e *AuditEvent) ActorID() *uuid.UUID {
+	return e.actorID
+}
+
+func (e *AuditEvent) TargetID() *uuid.UUID {
+	return e.targetID
+}
+
+func (e *AuditEvent) ImpersonatorID() *uuid.UUID {
+	return e.impersonatorID
+}
+
+func (e *AuditEvent) IPAddress() string {
+	return e.ipAddress
+}
+
+func (e *AuditEvent) UserAgent() string {
+	return e.userAgent
+}
+
+func (e *AuditEvent) Changes() map[string]AuditChange {
+	return e.changes
+}
+
+func (e *AuditEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEvent_WithChange(t *testing.T) {
+	actorID := uuid.New()
+	targetID := uuid.New()
+
+	event := NewAuditEvent(vo.AuditStatusChanged, &actorID, &targetID, "10.0.0.1", "curl/8.0", time.Now()).
+		WithChange("active", true, false)
+
+	assert.Equal(t, vo.AuditStatusChanged, event.Action())
+	assert.Equal(t, &actorID, event.ActorID())
+	assert.Equal(t, &targetID, event.TargetID())
+	assert.Equal(t, map[string]AuditChange{"active": {Before: true, After: false}}, event.Changes())
+}
+
+func TestRestoreAuditEvent_InvalidAction(t *testing.T) {
+	event, err := RestoreAuditEvent(uuid.New(), "user.deleted", nil, nil, nil, "", "", nil, time.Now())
+
+	assert.ErrorIs(t, err, vo.ErrInvalidAuditAction)
+	assert.Nil(t, event)
+}
+
+func TestAuditEvent_WithImpersonator(t *testing.T) {
+	userID := uuid.New()
+	impersonatorID := uuid.New()
+
+	event := NewAuditEvent(vo.AuditProfileUpdated, &userID, &userID, "", "", time.Now())
+	assert.Nil(t, event.ImpersonatorID())
+
+	event.WithImpersonator(&impersonatorID)
+	assert.Equal(t, &userID, event.ActorID())
+	assert.Equal(t, &impersonatorID, event.ImpersonatorID())
+}
@@ -3,11 +3,65 @@ package entity
 import "errors"
 
 var (
-	ErrUserNotFound       = errors.New("user not found")
-	ErrUserIsDeactivated  = errors.New("user is deactivated")
-	ErrInvalidOldPassword = errors.New("invalid old password")
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrSessionNotFound    = errors.New("session not found")
-	ErrOTPNotFound        = errors.New("otp not found")
-	ErrUserBlocked        = errors.New("user is blocked")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrUserIsDeactivated        = errors.New("user is deactivated")
+	ErrInvalidOldPassword       = errors.New("invalid old password")
+	ErrInvalidCredentials       = errors.New("invalid credentials")
+	ErrSessionNotFound          = errors.New("session not found")
+	ErrOTPNotFound              = errors.New("otp not found")
+	ErrUserBlocked              = errors.New("user is blocked")
+	ErrEmailNotVerified         = errors.New("email not verified")
+	ErrInvalidVerificationToken = errors.New("invalid verification token")
+	ErrInvalidOTP               = errors.New("invalid or expired otp")
+	ErrOTPExhausted             = errors.New("too many wrong otp attempts, request a new code")
+	ErrOTPCooldown              = errors.New("an otp was requested recently, please wait before requesting another")
+	ErrMFAAlreadyEnabled        = errors.New("mfa already enabled")
+	ErrMFANotEnrolled           = errors.New("mfa not enrolled")
+	ErrInvalidMFACode           = errors.New("invalid mfa code")
+	ErrMFAChallengeNotFound     = errors.New("mfa challenge not found or expired")
+	ErrSessionRevoked           = errors.New("session was revoked, please log in again")
+	ErrRefreshTokenReused       = errors.New("refresh token reuse detected, please log in again")
+	ErrAPIKeyNotFound           = errors.New("api key not found")
+	ErrInvalidAPIKey            = errors.New("invalid, expired or revoked api key")
+	ErrAPIKeyRevoked            = errors.New("api key already revoked")
+	ErrAPIKeyNotAllowed         = errors.New("api keys cannot access this resource")
+	ErrAPIKeyNameRequired       = errors.New("api key name is required")
+	ErrAPIKeyStoreRequired      = errors.New("api key store is required")
+	ErrAPIKeyScopesRequired     = errors.New("api key needs at least one scope")
+	ErrAPIKeyExpiryInPast       = errors.New("api key expiry must be in the future")
+	ErrPrivilegeEscalation      = errors.New("cannot grant or revoke permissions beyond your own")
+	ErrRoleNotFound             = errors.New("role not found")
+	ErrRoleAlreadyExists        = errors.New("a role with this name already exists in this or another store")
+	ErrRoleNameReserved         = errors.New("role name is reserved for a system role")
+	ErrRoleStoreRequired        = errors.New("custom role store is required")
+	ErrRolePermissionsRequired  = errors.New("role needs at least one permission")
+	ErrSystemRoleImmutable      = errors.New("system roles cannot be changed")
+	ErrRoleInUse                = errors.New("role is still assigned to users")
+	ErrMembershipNotFound       = errors.New("store membership not found")
+	ErrMembershipAlreadyExists  = errors.New("user is already a member of this store")
+	ErrMembershipStoreRequired  = errors.New("membership store is required")
+	ErrMembershipRolesRequired  = errors.New("membership needs at least one role")
+	ErrRoleNotInStore           = errors.New("role belongs to another store")
+	ErrCustomRoleNotGlobal      = errors.New("custom roles can only be granted through a store membership")
+	ErrNotStoreMember           = errors.New("not an active member of this store")
+	ErrInvitationNotFound       = errors.New("invitation not found")
+	ErrInvalidInvitation        = errors.New("invalid, expired or already used invitation")
+	ErrInvitationNotPending     = errors.New("invitation was already accepted or revoked")
+	ErrEmailAlreadyRegistered   = errors.New("email is already registered")
+	ErrUsernameTaken            = errors.New("username is already taken")
+	ErrInvalidEmailChangeToken  = errors.New("invalid or outdated email change link")
+	ErrInvalidHistorySize       = errors.New("password history size must be between 0 and 24")
+	ErrInvalidAuditFilter       = errors.New("invalid audit filter")
+	ErrInvalidUnlockToken       = errors.New("invalid or already used unlock link")
+	ErrInvalidAuditRange        = errors.New("audit range must start before it ends")
+	ErrImpersonationNotAllowed  = errors.New("this user cannot be impersonated")
+	ErrImpersonationReason      = errors.New("impersonation reason is required")
+	ErrImpersonationNotFound    = errors.New("impersonation not found or already ended")
+	ErrImpersonationForbidden   = errors.New("this action is not allowed while impersonating")
+	ErrInvalidMagicLink         = errors.New("invalid, expired or already used login link")
+	ErrInvalidUserImport        = errors.New("user import must be a CSV file with email and username columns")
+	ErrUserImportEmpty          = errors.New("user import has no rows")
+	ErrUserImportTooLarge       = errors.New("user import has too many rows")
+	ErrDuplicateImportEmail     = errors.New("email appears more than once in the import")
+	ErrDuplicateImportUsername  = errors.New("username appears more than once in the import")
 )
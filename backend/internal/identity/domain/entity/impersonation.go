@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Impersonation is a time-boxed session in which an admin acts as another
+// user. The access token it issues names both of them, and it ends when it
+// expires or when it is ended explicitly, whichever comes first.
+type Impersonation struct {
+	id             uuid.UUID
+	impersonatorID uuid.UUID
+	userID         uuid.UUID
+	storeID        *uuid.UUID
+	reason         string
+	startedAt      time.Time
+	expiresAt      time.Time
+}
+
+func NewImpersonation(impersonatorID, userID uuid.UUID, storeID *uuid.UUID, reason string, duration time.Duration, now time.Time) (*Impersonation, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrImpersonationReason
+	}
+
+	if impersonatorID == userID {
+		return nil, ErrImpersonationNotAllowed
+	}
+
+	return &Impersonation{
+		id:             uuid.New(),
+		impersonatorID: impersonatorID,
+		userID:         userID,
+		storeID:        storeID,
+		reason:         reason,
+		startedAt:      now,
+		expiresAt:      now.Add(duration),
+	}, nil
+}
+
+func RestoreImpersonation(
+	id uuid.UUID,
+	impersonatorID uuid.UUID,
+	userID uuid.UUID,
+	storeID *uuid.UUID,
+	reason string,
+	startedAt time.Time,
+	expiresAt time.Time,
+) *Impersonation {
+	return &Impersonation{
+		id:             id,
+		impersonatorID: impersonatorID,
+		userID:         userID,
+		storeID:        storeID,
+		reason:         reason,
+		startedAt:      startedAt,
+		expiresAt:      expiresAt,
+	}
+}
+
+func (i *Impersonation) ID() uuid.UUID {
+	return i.id
+}
+
+func (i *Impersonation) ImpersonatorID() uuid.UUID {
+	return i.impersonatorID
+}
+
+func (i *Impersonation) UserID() uuid.UUID {
+	return i.userID
+}
+
+func (i *Impersonation) StoreID() *uuid.UUID {
+	return i.storeID
+}
+
+func (i *Impersonation) Reason() string {
+	return i.reason
+}
+
+func (i *Impersonation) StartedAt() time.Time {
+	return i.startedAt
+}
+
+func (i *Impersonation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImpersonation(t *testing.T) {
+	adminID, userID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	impersonation, err := NewImpersonation(adminID, userID, nil, "  ticket 42 ", 15*time.Minute, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "ticket 42", impersonation.Reason())
+	assert.Equal(t, now.Add(15*time.Minute), impersonation.ExpiresAt())
+
+	_, err = NewImpersonation(adminID, userID, nil, " ", 15*time.Minute, now)
+	assert.ErrorIs(t, err, ErrImpersonationReason)
+
+	_, err = NewImpersonation(adminID, adminID, nil, "ticket 42", 15*time.Minute, now)
+	assert.ErrorIs(t, err, ErrImpersonationNotAllowed)
+}
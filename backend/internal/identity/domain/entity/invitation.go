@@ -0,0 +1,160 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// Invitation lets a manager onboard staff without choosing their password.
+// It carries the store and roles the invitee will be a member with, and can
+// be accepted once before it expires.
+type Invitation struct {
+	id         uuid.UUID
+	email      vo.Email
+	storeID    uuid.UUID
+	roles      []vo.Role
+	tokenHash  string
+	invitedBy  uuid.UUID
+	createdAt  time.Time
+	expiresAt  time.Time
+	acceptedAt *time.Time
+	revokedAt  *time.Time
+}
+
+func NewInvitation(
+	email vo.Email,
+	storeID uuid.UUID,
+	roles []vo.Role,
+	token vo.InviteToken,
+	invitedBy uuid.UUID,
+	expiresIn time.Duration,
+	now time.Time,
+) (*Invitation, error) {
+	if storeID == uuid.Nil {
+		return nil, ErrMembershipStoreRequired
+	}
+
+	if len(roles) == 0 {
+		return nil, ErrMembershipRolesRequired
+	}
+
+	return &Invitation{
+		id:        uuid.New(),
+		email:     email,
+		storeID:   storeID,
+		roles:     uniqueRoles(roles),
+		tokenHash: token.Hash(),
+		invitedBy: invitedBy,
+		createdAt: now,
+		expiresAt: now.Add(expiresIn),
+	}, nil
+}
+
+func RestoreInvitation(
+	id uuid.UUID,
+	email string,
+	storeID uuid.UUID,
+	roles []string,
+	tokenHash string,
+	invitedBy uuid.UUID,
+	createdAt time.Time,
+	expiresAt time.Time,
+	acceptedAt *time.Time,
+	revokedAt *time.Time,
+	catalog vo.RoleCatalog,
+) (*Invitation, error) {
+	restEmail, err := vo.NewEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	restoredRoles := make([]vo.Role, 0, len(roles))
+	for _, role := range roles {
+		restRole, err := catalog.Resolve(role)
+		if err != nil {
+			return nil, err
+		}
+
+		restoredRoles = append(restoredRoles, restRole)
+	}
+
+	return &Invitation{
+		id:         id,
+		email:      restEmail,
+		storeID:    storeID,
+		roles:      restoredRoles,
+		tokenHash:  tokenHash,
+		invitedBy:  invitedBy,
+		createdAt:  createdAt,
+		expiresAt:  expiresAt,
+		acceptedAt: acceptedAt,
+		revokedAt:  revokedAt,
+	}, nil
+}
+
+func (i *Invitation) ID() uuid.UUID {
+	return i.id
+}
+
+func (i *Invitation) Email() vo.Email {
+	return i.email
+}
+
+func (i *Invitation) StoreID() uuid.UUID {
+	return i.storeID
+}
+
+func (i *Invitation) Roles() []vo.Role {
+	return i.roles
+}
+
+func (i *Invitation) TokenHash() string {
+	return i.tokenHash
+}
+
+func (i *Invitation) InvitedBy() uuid.UUID {
+	return i.invitedBy
+}
+
+func (i *Invitation) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *Invitation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+func (i *Invitation) AcceptedAt() *time.Time {
+	return i.acceptedAt
+}
+
+func (i *Invitation) RevokedAt() *time.Time {
+	return i.revokedAt
+}
+
+// IsPending reports whether the invitation can still be accepted.
+func (i *Invitation) IsPending(now time.Time) bool {
+	return i.acceptedAt == nil && i.revokedAt == nil && now.Before(i.expiresAt)
+}
+
+// Accept consumes the invitation. Expired, revoked and used invitations all
+// fail alike, so the invitee learns nothing about a token that is not theirs.
+func (i *Invitation) Accept(now time.Time) error {
+	if !i.IsPending(now) {
+		return ErrInvalidInvitation
+	}
+
+	i.acceptedAt = &now
+	return nil
+}
+
+func (i *Invitation) Revoke(now time.Time) error {
+	if i.acceptedAt != nil || i.revokedAt != nil {
+		return ErrInvitationNotPending
+	}
+
+	i.revokedAt = &now
+	return nil
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInvitation(t *testing.T) {
+	email, _ := vo.NewEmail("staff@test.com")
+	token, _ := vo.GenerateInviteToken()
+	storeID := uuid.New()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		invitation, err := NewInvitation(email, storeID, []vo.Role{vo.EmployeeRole, vo.EmployeeRole}, token, uuid.New(), time.Hour, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, token.Hash(), invitation.TokenHash())
+		assert.Equal(t, []vo.Role{vo.EmployeeRole}, invitation.Roles())
+		assert.Equal(t, now.Add(time.Hour), invitation.ExpiresAt())
+		assert.True(t, invitation.IsPending(now))
+	})
+
+	t.Run("Store Required", func(t *testing.T) {
+		_, err := NewInvitation(email, uuid.Nil, []vo.Role{vo.EmployeeRole}, token, uuid.New(), time.Hour, now)
+		assert.ErrorIs(t, err, ErrMembershipStoreRequired)
+	})
+
+	t.Run("Roles Required", func(t *testing.T) {
+		_, err := NewInvitation(email, storeID, nil, token, uuid.New(), time.Hour, now)
+		assert.ErrorIs(t, err, ErrMembershipRolesRequired)
+	})
+}
+
+func TestInvitation_Lifecycle(t *testing.T) {
+	email, _ := vo.NewEmail("staff@test.com")
+	token, _ := vo.GenerateInviteToken()
+	now := time.Now()
+	newInvitation := func() *Invitation {
+		invitation, _ := NewInvitation(email, uuid.New(), []vo.Role{vo.EmployeeRole}, token, uuid.New(), time.Hour, now)
+		return invitation
+	}
+
+	t.Run("Accept Once", func(t *testing.T) {
+		invitation := newInvitation()
+
+		assert.NoError(t, invitation.Accept(now))
+		assert.NotNil(t, invitation.AcceptedAt())
+		assert.ErrorIs(t, invitation.Accept(now), ErrInvalidInvitation)
+		assert.ErrorIs(t, invitation.Revoke(now), ErrInvitationNotPending)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		invitation := newInvitation()
+
+		assert.ErrorIs(t, invitation.Accept(now.Add(time.Hour)), ErrInvalidInvitation)
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		invitation := newInvitation()
+
+		assert.NoError(t, invitation.Revoke(now))
+		assert.ErrorIs(t, invitation.Accept(now), ErrInvalidInvitation)
+		assert.ErrorIs(t, invitation.Revoke(now), ErrInvitationNotPending)
+	})
+}
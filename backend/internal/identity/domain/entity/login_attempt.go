@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// LoginAttempt is one entry of a user's login history. Attempts for emails
+// that match no user are not kept here; the audit log records those.
+type LoginAttempt struct {
+	id         uuid.UUID
+	userID     uuid.UUID
+	outcome    vo.LoginOutcome
+	ipAddress  string
+	userAgent  string
+	device     vo.DeviceFingerprint
+	occurredAt time.Time
+}
+
+func NewLoginAttempt(userID uuid.UUID, outcome vo.LoginOutcome, ipAddress, userAgent string, now time.Time) *LoginAttempt {
+	return &LoginAttempt{
+		id:         uuid.New(),
+		userID:     userID,
+		outcome:    outcome,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+		device:     vo.NewDeviceFingerprint(userAgent),
+		occurredAt: now,
+	}
+}
+
+func RestoreLoginAttempt(
+	id uuid.UUID,
+	userID uuid.UUID,
+	outcome string,
+	ipAddress string,
+	userAgent string,
+	device string,
+	occurredAt time.Time,
+) (*LoginAttempt, error) {
+	restOutcome, err := vo.NewLoginOutcome(outcome)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginAttempt{
+		id:         id,
+		userID:     userID,
+		outcome:    restOutcome,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+		device:     vo.DeviceFingerprint(device),
+		occurredAt: occurredAt,
+	}, nil
+}
+
+func (a *LoginAttempt) ID() uuid.UUID {
+	return a.id
+}
+
+func (a *LoginAttempt) UserID() uuid.UUID {
+	return a.userID
+}
+
+func (a *LoginAttempt) Outcome() vo.LoginOutcome {
+	return a.outcome
+}
+
+func (a *LoginAttempt) Succeeded() bool {
+	return a.outcome == vo.LoginSucceeded
+}
+
+func (a *LoginAttempt) IPAddress() string {
+	return a.ipAddress
+}
+
+func (a *LoginAttempt) UserAgent() string {
+	return a.userAgent
+}
+
+func (a *LoginAttempt) Device() vo.DeviceFingerprint {
+	return a.device
+}
+
+func (a *LoginAttempt) OccurredAt() time.Time {
+	return a.occurredAt
+}
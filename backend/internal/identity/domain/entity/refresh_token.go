@@ -0,0 +1,107 @@
+package entity
+
+import "github.com/google/uuid"
+
+// RefreshToken is one link of a refresh token family. Every login starts a
+// new family and every rotation appends a child with the next generation, so
+// a token that is presented after it was rotated can be traced back to the
+// session it belongs to. The token version of the user at login is carried
+// along the family, so a family started before the user's sessions were
+// revoked cannot be rotated anymore. The active store, if any, is carried
+// along too, so rotation keeps the session in the store it switched into.
+type RefreshToken struct {
+	token        string
+	userID       uuid.UUID
+	familyID     uuid.UUID
+	parent       string
+	generation   int
+	rotated      bool
+	tokenVersion int
+	storeID      *uuid.UUID
+}
+
+func NewRefreshToken(token string, userID uuid.UUID, tokenVersion int) *RefreshToken {
+	return &RefreshToken{
+		token:        token,
+		userID:       userID,
+		familyID:     uuid.New(),
+		generation:   0,
+		tokenVersion: tokenVersion,
+	}
+}
+
+func RestoreRefreshToken(
+	token string,
+	userID uuid.UUID,
+	familyID uuid.UUID,
+	parent string,
+	generation int,
+	rotated bool,
+	tokenVersion int,
+	storeID *uuid.UUID,
+) *RefreshToken {
+	return &RefreshToken{
+		token:        token,
+		userID:       userID,
+		familyID:     familyID,
+		parent:       parent,
+		generation:   generation,
+		rotated:      rotated,
+		tokenVersion: tokenVersion,
+		storeID:      storeID,
+	}
+}
+
+func (t *RefreshToken) Token() string {
+	return t.token
+}
+
+func (t *RefreshToken) UserID() uuid.UUID {
+	return t.userID
+}
+
+func (t *RefreshToken) FamilyID() uuid.UUID {
+	return t.familyID
+}
+
+func (t *RefreshToken) Parent() string {
+	return t.parent
+}
+
+func (t *RefreshToken) Generation() int {
+	return t.generation
+}
+
+func (t *RefreshToken) IsRotated() bool {
+	return t.rotated
+}
+
+func (t *RefreshToken) TokenVersion() int {
+	return t.tokenVersion
+}
+
+// StoreID is the active store of the session, or nil when it has not
+// switched into one.
+func (t *RefreshToken) StoreID() *uuid.UUID {
+	return t.storeID
+}
+
+// Rotate marks the token as used and returns its successor in the family.
+func (t *RefreshToken) Rotate(next string) *RefreshToken {
+	return t.RotateInto(next, t.storeID)
+}
+
+// RotateInto is Rotate for a session switching its active store.
+func (t *RefreshToken) RotateInto(next string, storeID *uuid.UUID) *RefreshToken {
+	t.rotated = true
+
+	return &RefreshToken{
+		token:        next,
+		userID:       t.userID,
+		familyID:     t.familyID,
+		parent:       t.token,
+		generation:   t.generation + 1,
+		tokenVersion: t.tokenVersion,
+		storeID:      storeID,
+	}
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshToken_Rotate(t *testing.T) {
+	userID := uuid.New()
+	first := NewRefreshToken("first", userID, 3)
+
+	assert.Equal(t, 0, first.Generation())
+	assert.Empty(t, first.Parent())
+	assert.False(t, first.IsRotated())
+
+	second := first.Rotate("second")
+
+	assert.True(t, first.IsRotated())
+	assert.False(t, second.IsRotated())
+	assert.Equal(t, "second", second.Token())
+	assert.Equal(t, "first", second.Parent())
+	assert.Equal(t, 1, second.Generation())
+	assert.Equal(t, first.FamilyID(), second.FamilyID())
+	assert.Equal(t, userID, second.UserID())
+	assert.Equal(t, 3, second.TokenVersion())
+
+	other := NewRefreshToken("other", userID, 3)
+	assert.NotEqual(t, first.FamilyID(), other.FamilyID())
+}
+
+func TestRefreshToken_RotateInto(t *testing.T) {
+	userID := uuid.New()
+	storeID := uuid.New()
+	first := NewRefreshToken("first", userID, 0)
+
+	assert.Nil(t, first.StoreID())
+
+	second := first.RotateInto("second", &storeID)
+
+	assert.True(t, first.IsRotated())
+	assert.Equal(t, &storeID, second.StoreID())
+	assert.Equal(t, first.FamilyID(), second.FamilyID())
+
+	third := second.Rotate("third")
+
+	assert.Equal(t, &storeID, third.StoreID())
+	assert.Nil(t, third.RotateInto("fourth", nil).StoreID())
+}
@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// Role is an entry of the role catalog. System roles are global, seeded by
+// migrations and immutable; custom roles belong to the store that defined
+// them. Role names are unique across stores, not per store: users, store
+// memberships, MFA policies and access tokens all hold roles by name alone, so
+// two stores cannot each define a CASHIER and must pick distinct names.
+type Role struct {
+	name        vo.Role
+	storeID     *uuid.UUID
+	description string
+	permissions []vo.Permission
+	system      bool
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func NewRole(
+	name vo.Role,
+	storeID uuid.UUID,
+	description string,
+	permissions []vo.Permission,
+	now time.Time,
+) (*Role, error) {
+	if vo.IsSystemRole(name) {
+		return nil, ErrRoleNameReserved
+	}
+
+	if storeID == uuid.Nil {
+		return nil, ErrRoleStoreRequired
+	}
+
+	if len(permissions) == 0 {
+		return nil, ErrRolePermissionsRequired
+	}
+
+	return &Role{
+		name:        name,
+		storeID:     &storeID,
+		description: strings.TrimSpace(description),
+		permissions: uniquePermissions(permissions),
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// RestoreRole ignores the stored permissions of system roles; theirs are
+// defined in code so that new permissions reach them without a migration.
+func RestoreRole(
+	name string,
+	storeID *uuid.UUID,
+	description string,
+	permissions []string,
+	system bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*Role, error) {
+	roleName, err := vo.ParseRoleName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissionsVO []vo.Permission
+	if system {
+		permissionsVO = vo.SystemRolePermissions(roleName)
+	} else {
+		permissionsVO = make([]vo.Permission, 0, len(permissions))
+		for _, p := range permissions {
+			permission, err := vo.NewPermission(p)
+			if err != nil {
+				return nil, err
+			}
+
+			permissionsVO = append(permissionsVO, permission)
+		}
+	}
+
+	return &Role{
+		name:        roleName,
+		storeID:     storeID,
+		description: description,
+		permissions: permissionsVO,
+		system:      system,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+func (r *Role) Name() vo.Role {
+	return r.name
+}
+
+func (r *Role) StoreID() *uuid.UUID {
+	return r.storeID
+}
+
+func (r *Role) Description() string {
+	return r.description
+}
+
+func (r *Role) Permissions() []vo.Permission {
+	return r.permissions
+}
+
+func (r *Role) IsSystem() bool {
+	return r.system
+}
+
+func (r *Role) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *Role) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Update replaces the description and permissions of a custom role. The name
+// and store never change, since users reference the role by name.
+func (r *Role) Update(description string, permissions []vo.Permission, now time.Time) error {
+	if r.system {
+		return ErrSystemRoleImmutable
+	}
+
+	if len(permissions) == 0 {
+		return ErrRolePermissionsRequired
+	}
+
+	r.description = strings.TrimSpace(description)
+	r.permissions = uniquePermissions(permissions)
+	r.updatedAt = now
+
+	return nil
+}
+
+func uniquePermissions(permissions []vo.Permission) []vo.Permission {
+	seen := make(map[vo.Permission]bool, len(permissions))
+	unique := make([]vo.Permission, 0, len(permissions))
+
+	for _, permission := range permissions {
+		if seen[permission] {
+			continue
+		}
+
+		seen[permission] = true
+		unique = append(unique, permission)
+	}
+
+	return unique
+}
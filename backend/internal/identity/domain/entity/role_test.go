@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRole(t *testing.T) {
+	storeID := uuid.New()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		role, err := NewRole("CASHIER", storeID, "  Front desk ", []vo.Permission{vo.PermOrdersWrite, vo.PermOrdersWrite, vo.PermOrdersRead}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, vo.Role("CASHIER"), role.Name())
+		assert.Equal(t, storeID, *role.StoreID())
+		assert.Equal(t, "Front desk", role.Description())
+		assert.Equal(t, []vo.Permission{vo.PermOrdersWrite, vo.PermOrdersRead}, role.Permissions())
+		assert.False(t, role.IsSystem())
+	})
+
+	t.Run("System Name Reserved", func(t *testing.T) {
+		_, err := NewRole(vo.ManagerRole, storeID, "", []vo.Permission{vo.PermOrdersRead}, now)
+		assert.ErrorIs(t, err, ErrRoleNameReserved)
+	})
+
+	t.Run("Store Required", func(t *testing.T) {
+		_, err := NewRole("CASHIER", uuid.Nil, "", []vo.Permission{vo.PermOrdersRead}, now)
+		assert.ErrorIs(t, err, ErrRoleStoreRequired)
+	})
+
+	t.Run("Permissions Required", func(t *testing.T) {
+		_, err := NewRole("CASHIER", storeID, "", nil, now)
+		assert.ErrorIs(t, err, ErrRolePermissionsRequired)
+	})
+}
+
+func TestRestoreRole(t *testing.T) {
+	now := time.Now()
+
+	t.Run("System Role Uses Code Permissions", func(t *testing.T) {
+		role, err := RestoreRole("MANAGER", nil, "", []string{}, true, now, now)
+
+		assert.NoError(t, err)
+		assert.True(t, role.IsSystem())
+		assert.Equal(t, vo.SystemRolePermissions(vo.ManagerRole), role.Permissions())
+	})
+
+	t.Run("Unknown Permission", func(t *testing.T) {
+		_, err := RestoreRole("CASHIER", nil, "", []string{"orders:delete"}, false, now, now)
+		assert.ErrorIs(t, err, vo.ErrInvalidPermission)
+	})
+}
+
+func TestRole_Update(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Minute)
+
+	role, _ := NewRole("CASHIER", uuid.New(), "", []vo.Permission{vo.PermOrdersRead}, now)
+	assert.NoError(t, role.Update("Tills", []vo.Permission{vo.PermOrdersWrite}, later))
+	assert.Equal(t, []vo.Permission{vo.PermOrdersWrite}, role.Permissions())
+	assert.Equal(t, later, role.UpdatedAt())
+
+	assert.ErrorIs(t, role.Update("Tills", nil, later), ErrRolePermissionsRequired)
+
+	system, _ := RestoreRole("ADMIN", nil, "", nil, true, now, now)
+	assert.ErrorIs(t, system.Update("", []vo.Permission{vo.PermOrdersRead}, later), ErrSystemRoleImmutable)
+}
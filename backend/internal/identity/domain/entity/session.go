@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session describes where a refresh token family is being used. Its ID is
+// the family ID, so revoking a session revokes every token of that family.
+type Session struct {
+	id         uuid.UUID
+	userID     uuid.UUID
+	createdAt  time.Time
+	lastUsedAt time.Time
+	ipAddress  string
+	userAgent  string
+}
+
+func NewSession(id uuid.UUID, userID uuid.UUID, ipAddress, userAgent string, now time.Time) *Session {
+	return &Session{
+		id:         id,
+		userID:     userID,
+		createdAt:  now,
+		lastUsedAt: now,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+	}
+}
+
+func RestoreSession(
+	id uuid.UUID,
+	userID uuid.UUID,
+	createdAt time.Time,
+	lastUsedAt time.Time,
+	ipAddress string,
+	userAgent string,
+) *Session {
+	return &Session{
+		id:         id,
+		userID:     userID,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+	}
+}
+
+func (s *Session) ID() uuid.UUID {
+	return s.id
+}
+
+func (s *Session) UserID() uuid.UUID {
+	return s.userID
+}
+
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Session) LastUsedAt() time.Time {
+	return s.lastUsedAt
+}
+
+func (s *Session) IPAddress() string {
+	return s.ipAddress
+}
+
+func (s *Session) UserAgent() string {
+	return s.userAgent
+}
+
+// Touch records a refresh made from the given client.
+func (s *Session) Touch(ipAddress, userAgent string, now time.Time) {
+	s.lastUsedAt = now
+	s.ipAddress = ipAddress
+	s.userAgent = userAgent
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SigningKey is one key of the access token keyring, identified in tokens by
+// its kid. The newest key that is not retired signs new tokens; retired keys
+// are only kept so the tokens they signed stay valid until they expire.
+type SigningKey struct {
+	id         string
+	algorithm  string
+	privateKey []byte
+	createdAt  time.Time
+	retiredAt  *time.Time
+}
+
+// NewSigningKey wraps a freshly generated private key, encoded as PKCS#8 DER.
+func NewSigningKey(algorithm string, privateKey []byte, now time.Time) *SigningKey {
+	return &SigningKey{
+		id:         uuid.New().String(),
+		algorithm:  algorithm,
+		privateKey: privateKey,
+		createdAt:  now,
+	}
+}
+
+func RestoreSigningKey(
+	id string,
+	algorithm string,
+	privateKey []byte,
+	createdAt time.Time,
+	retiredAt *time.Time,
+) *SigningKey {
+	return &SigningKey{
+		id:         id,
+		algorithm:  algorithm,
+		privateKey: privateKey,
+		createdAt:  createdAt,
+		retiredAt:  retiredAt,
+	}
+}
+
+func (k *SigningKey) ID() string {
+	return k.id
+}
+
+func (k *SigningKey) Algorithm() string {
+	return k.algorithm
+}
+
+func (k *SigningKey) PrivateKey() []byte {
+	return k.privateKey
+}
+
+func (k *SigningKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+func (k *SigningKey) RetiredAt() *time.Time {
+	return k.retiredAt
+}
+
+func (k *SigningKey) IsRetired() bool {
+	return k.retiredAt != nil
+}
+
+// Retire stops the key from signing new tokens. Retiring it again keeps the
+// original date, so the overlap window is not extended.
+func (k *SigningKey) Retire(now time.Time) {
+	if k.retiredAt != nil {
+		return
+	}
+
+	k.retiredAt = &now
+}
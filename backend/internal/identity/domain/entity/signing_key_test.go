@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningKey_Retire(t *testing.T) {
+	now := time.Now()
+	key := NewSigningKey("EdDSA", []byte("der"), now)
+
+	assert.NotEmpty(t, key.ID())
+	assert.False(t, key.IsRetired())
+	assert.Nil(t, key.RetiredAt())
+
+	key.Retire(now.Add(time.Hour))
+	assert.True(t, key.IsRetired())
+	assert.Equal(t, now.Add(time.Hour), *key.RetiredAt())
+
+	key.Retire(now.Add(2 * time.Hour))
+	assert.Equal(t, now.Add(time.Hour), *key.RetiredAt())
+
+	other := NewSigningKey("EdDSA", []byte("der"), now)
+	assert.NotEqual(t, key.ID(), other.ID())
+}
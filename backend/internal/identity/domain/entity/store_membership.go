@@ -0,0 +1,137 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// StoreMembership grants a user roles inside one store, on top of the global
+// roles of the user. A user who works at several stores holds one membership
+// per store, each with its own roles.
+type StoreMembership struct {
+	userID    uuid.UUID
+	storeID   uuid.UUID
+	roles     []vo.Role
+	status    vo.MembershipStatus
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewStoreMembership(userID uuid.UUID, storeID uuid.UUID, roles []vo.Role, now time.Time) (*StoreMembership, error) {
+	if storeID == uuid.Nil {
+		return nil, ErrMembershipStoreRequired
+	}
+
+	if len(roles) == 0 {
+		return nil, ErrMembershipRolesRequired
+	}
+
+	return &StoreMembership{
+		userID:    userID,
+		storeID:   storeID,
+		roles:     uniqueRoles(roles),
+		status:    vo.MembershipActive,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func RestoreStoreMembership(
+	userID uuid.UUID,
+	storeID uuid.UUID,
+	roles []string,
+	status string,
+	createdAt time.Time,
+	updatedAt time.Time,
+	catalog vo.RoleCatalog,
+) (*StoreMembership, error) {
+	restoredRoles := make([]vo.Role, 0, len(roles))
+	for _, role := range roles {
+		restRole, err := catalog.Resolve(role)
+		if err != nil {
+			return nil, err
+		}
+
+		restoredRoles = append(restoredRoles, restRole)
+	}
+
+	restStatus, err := vo.NewMembershipStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreMembership{
+		userID:    userID,
+		storeID:   storeID,
+		roles:     restoredRoles,
+		status:    restStatus,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}, nil
+}
+
+func (m *StoreMembership) UserID() uuid.UUID {
+	return m.userID
+}
+
+func (m *StoreMembership) StoreID() uuid.UUID {
+	return m.storeID
+}
+
+func (m *StoreMembership) Roles() []vo.Role {
+	return m.roles
+}
+
+func (m *StoreMembership) Status() vo.MembershipStatus {
+	return m.status
+}
+
+func (m *StoreMembership) IsActive() bool {
+	return m.status == vo.MembershipActive
+}
+
+func (m *StoreMembership) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m *StoreMembership) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+func (m *StoreMembership) ReplaceRoles(roles []vo.Role, now time.Time) error {
+	if len(roles) == 0 {
+		return ErrMembershipRolesRequired
+	}
+
+	m.roles = uniqueRoles(roles)
+	m.updatedAt = now
+
+	return nil
+}
+
+func (m *StoreMembership) ChangeStatus(status vo.MembershipStatus, now time.Time) {
+	if m.status == status {
+		return
+	}
+
+	m.status = status
+	m.updatedAt = now
+}
+
+func uniqueRoles(roles []vo.Role) []vo.Role {
+	seen := make(map[vo.Role]bool, len(roles))
+	unique := make([]vo.Role, 0, len(roles))
+
+	for _, role := range roles {
+		if seen[role] {
+			continue
+		}
+
+		seen[role] = true
+		unique = append(unique, role)
+	}
+
+	return unique
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStoreMembership(t *testing.T) {
+	userID := uuid.New()
+	storeID := uuid.New()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		membership, err := NewStoreMembership(userID, storeID, []vo.Role{vo.EmployeeRole, vo.EmployeeRole, "CASHIER"}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, userID, membership.UserID())
+		assert.Equal(t, storeID, membership.StoreID())
+		assert.Equal(t, []vo.Role{vo.EmployeeRole, "CASHIER"}, membership.Roles())
+		assert.True(t, membership.IsActive())
+	})
+
+	t.Run("Store Required", func(t *testing.T) {
+		_, err := NewStoreMembership(userID, uuid.Nil, []vo.Role{vo.EmployeeRole}, now)
+		assert.ErrorIs(t, err, ErrMembershipStoreRequired)
+	})
+
+	t.Run("Roles Required", func(t *testing.T) {
+		_, err := NewStoreMembership(userID, storeID, nil, now)
+		assert.ErrorIs(t, err, ErrMembershipRolesRequired)
+	})
+}
+
+func TestStoreMembership_Changes(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	now := time.Now()
+	membership, _ := NewStoreMembership(uuid.New(), uuid.New(), []vo.Role{vo.EmployeeRole}, created)
+
+	assert.ErrorIs(t, membership.ReplaceRoles(nil, now), ErrMembershipRolesRequired)
+	assert.Equal(t, created, membership.UpdatedAt())
+
+	assert.NoError(t, membership.ReplaceRoles([]vo.Role{vo.ManagerRole}, now))
+	assert.Equal(t, []vo.Role{vo.ManagerRole}, membership.Roles())
+	assert.Equal(t, now, membership.UpdatedAt())
+
+	membership.ChangeStatus(vo.MembershipSuspended, now)
+	assert.False(t, membership.IsActive())
+}
+
+func TestRestoreStoreMembership(t *testing.T) {
+	now := time.Now()
+	catalog := vo.SystemRoleCatalog().With("CASHIER", []vo.Permission{vo.PermOrdersRead})
+
+	membership, err := RestoreStoreMembership(uuid.New(), uuid.New(), []string{"CASHIER"}, "SUSPENDED", now, now, catalog)
+	assert.NoError(t, err)
+	assert.Equal(t, []vo.Role{"CASHIER"}, membership.Roles())
+	assert.False(t, membership.IsActive())
+
+	_, err = RestoreStoreMembership(uuid.New(), uuid.New(), []string{"CASHIER"}, "ACTIVE", now, now, vo.SystemRoleCatalog())
+	assert.ErrorIs(t, err, vo.ErrInvalidRole)
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+// StorePasswordPolicy holds the password rules a store imposes on its
+// members. A user who belongs to several stores follows the strictest one.
+type StorePasswordPolicy struct {
+	storeID     uuid.UUID
+	historySize int
+	updatedAt   time.Time
+}
+
+// NewStorePasswordPolicy takes the number of recent passwords, the current
+// one included, that members of the store may not reuse. Zero leaves the
+// server default in place.
+func NewStorePasswordPolicy(storeID uuid.UUID, historySize int, now time.Time) (*StorePasswordPolicy, error) {
+	if storeID == uuid.Nil {
+		return nil, ErrMembershipStoreRequired
+	}
+
+	if historySize < 0 || historySize > vo.MaxPasswordHistory {
+		return nil, ErrInvalidHistorySize
+	}
+
+	return &StorePasswordPolicy{
+		storeID:     storeID,
+		historySize: historySize,
+		updatedAt:   now,
+	}, nil
+}
+
+func RestoreStorePasswordPolicy(storeID uuid.UUID, historySize int, updatedAt time.Time) *StorePasswordPolicy {
+	return &StorePasswordPolicy{
+		storeID:     storeID,
+		historySize: historySize,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (p *StorePasswordPolicy) StoreID() uuid.UUID {
+	return p.storeID
+}
+
+func (p *StorePasswordPolicy) HistorySize() int {
+	return p.historySize
+}
+
+func (p *StorePasswordPolicy) UpdatedAt() time.Time {
+	return p.updatedAt
+}
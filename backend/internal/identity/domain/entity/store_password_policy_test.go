@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStorePasswordPolicy(t *testing.T) {
+	storeID := uuid.New()
+
+	tests := []struct {
+		name        string
+		storeID     uuid.UUID
+		historySize int
+		wantErr     error
+	}{
+		{"Valid", storeID, 5, nil},
+		{"Zero Keeps Default", storeID, 0, nil},
+		{"Maximum", storeID, 24, nil},
+		{"Negative", storeID, -1, ErrInvalidHistorySize},
+		{"Too Large", storeID, 25, ErrInvalidHistorySize},
+		{"Store Required", uuid.Nil, 5, ErrMembershipStoreRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := NewStorePasswordPolicy(tt.storeID, tt.historySize, time.Now())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, policy)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.historySize, policy.HistorySize())
+				assert.Equal(t, tt.storeID, policy.StoreID())
+			}
+		})
+	}
+}
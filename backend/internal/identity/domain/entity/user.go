@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"fmt"
 	"slices"
 	"time"
 
@@ -23,6 +24,8 @@ type User struct {
 	failedAttempts int
 	lockedUntil    *time.Time
 	emailVerified  bool
+	tokenVersion   int
+	deletedAt      *time.Time
 }
 
 func NewUser(email vo.Email, username string, password vo.Password, roles []vo.Role) (*User, error) {
@@ -40,6 +43,7 @@ func NewUser(email vo.Email, username string, password vo.Password, roles []vo.R
 		failedAttempts: 0,
 		lockedUntil:    nil,
 		emailVerified:  false,
+		tokenVersion:   0,
 	}, nil
 }
 
@@ -53,6 +57,9 @@ func RestoreUser(
 	failedAttempts int,
 	lockedUntil *time.Time,
 	emailVerified bool,
+	tokenVersion int,
+	deletedAt *time.Time,
+	catalog vo.RoleCatalog,
 ) (*User, error) {
 	restoredPassword, err := vo.RestorePassword(password)
 	if err != nil {
@@ -61,7 +68,7 @@ func RestoreUser(
 
 	restoredRoles := make([]vo.Role, 0, len(roles))
 	for _, role := range roles {
-		restRole, err := vo.NewRole(role)
+		restRole, err := catalog.Resolve(role)
 		if err != nil {
 			return nil, err
 		}
@@ -84,6 +91,8 @@ func RestoreUser(
 		failedAttempts: failedAttempts,
 		lockedUntil:    lockedUntil,
 		emailVerified:  emailVerified,
+		tokenVersion:   tokenVersion,
+		deletedAt:      deletedAt,
 	}, nil
 }
 
@@ -123,6 +132,16 @@ func (u *User) EmailVerified() bool {
 	return u.emailVerified
 }
 
+func (u *User) TokenVersion() int {
+	return u.tokenVersion
+}
+
+// RevokeSessions invalidates every access and refresh token issued so far,
+// since tokens carry the version they were issued with.
+func (u *User) RevokeSessions() {
+	u.tokenVersion++
+}
+
 func (u *User) Deactivate() {
 	u.active = false
 }
@@ -131,10 +150,44 @@ func (u *User) IsActive() bool {
 	return u.active
 }
 
+func (u *User) DeletedAt() *time.Time {
+	return u.deletedAt
+}
+
+func (u *User) IsDeleted() bool {
+	return u.deletedAt != nil
+}
+
+// Anonymize erases what identifies the person behind the account and keeps
+// the row, so audit events, store history and anything else holding the user
+// ID still points somewhere. The email and username are replaced by values
+// derived from the ID, which keeps them unique, and every session is revoked.
+func (u *User) Anonymize(now time.Time) {
+	u.email = vo.Email(fmt.Sprintf("deleted-%s@anonymized.invalid", u.id))
+	u.username = fmt.Sprintf("deleted-%s", u.id)
+	u.password = vo.UnusablePassword
+	u.roles = []vo.Role{}
+	u.active = false
+	u.failedAttempts = 0
+	u.lockedUntil = nil
+	u.emailVerified = false
+	u.deletedAt = &now
+	u.RevokeSessions()
+}
+
 func (u *User) ChangeEmail(email vo.Email) {
 	u.email = email
 }
 
+func (u *User) ChangeUsername(username string) error {
+	if username == "" {
+		return ErrEmptyUsername
+	}
+
+	u.username = username
+	return nil
+}
+
 func (u *User) ChangePassword(p vo.Password) {
 	u.password = p
 }
@@ -172,7 +225,8 @@ func (u *User) IsLocked(now time.Time) bool {
 	return u.lockedUntil.After(now)
 }
 
-func (u *User) RecordFailedLogin(threshold int, baseDuration time.Duration, now time.Time) {
+// RecordFailedLogin reports whether this failure locked the account.
+func (u *User) RecordFailedLogin(threshold int, baseDuration time.Duration, now time.Time) bool {
 	u.failedAttempts++
 
 	if u.failedAttempts >= threshold {
@@ -181,7 +235,10 @@ func (u *User) RecordFailedLogin(threshold int, baseDuration time.Duration, now
 
 		expiration := now.Add(extraDuration)
 		u.lockedUntil = &expiration
+		return true
 	}
+
+	return false
 }
 
 func (u *User) ResetFailedAttempts() {
@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+type UserMFA struct {
+	userID        uuid.UUID
+	secret        vo.TOTPSecret
+	enabled       bool
+	recoveryCodes []string
+	lastUsedStep  int64
+}
+
+// NewUserMFA starts an enrollment; the factor only counts once Confirm
+// receives a valid code generated from the secret.
+func NewUserMFA(userID uuid.UUID, secret vo.TOTPSecret) *UserMFA {
+	return &UserMFA{
+		userID:        userID,
+		secret:        secret,
+		enabled:       false,
+		recoveryCodes: []string{},
+	}
+}
+
+func RestoreUserMFA(
+	userID uuid.UUID,
+	secret string,
+	enabled bool,
+	recoveryCodes []string,
+	lastUsedStep int64,
+) (*UserMFA, error) {
+	restoredSecret, err := vo.RestoreTOTPSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserMFA{
+		userID:        userID,
+		secret:        restoredSecret,
+		enabled:       enabled,
+		recoveryCodes: recoveryCodes,
+		lastUsedStep:  lastUsedStep,
+	}, nil
+}
+
+func (m *UserMFA) UserID() uuid.UUID {
+	return m.userID
+}
+
+func (m *UserMFA) Secret() vo.TOTPSecret {
+	return m.secret
+}
+
+func (m *UserMFA) IsEnabled() bool {
+	return m.enabled
+}
+
+func (m *UserMFA) RecoveryCodes() []string {
+	return m.recoveryCodes
+}
+
+func (m *UserMFA) LastUsedStep() int64 {
+	return m.lastUsedStep
+}
+
+func (m *UserMFA) Confirm(code string, now time.Time) error {
+	if m.enabled {
+		return ErrMFAAlreadyEnabled
+	}
+
+	if !m.VerifyCode(code, now) {
+		return ErrInvalidMFACode
+	}
+
+	m.enabled = true
+	return nil
+}
+
+// VerifyCode rejects a code from a step that was already used, so a code
+// seen over someone's shoulder can't be replayed within its window.
+func (m *UserMFA) VerifyCode(code string, now time.Time) bool {
+	step, ok := m.secret.Verify(code, now)
+	if !ok || step <= m.lastUsedStep {
+		return false
+	}
+
+	m.lastUsedStep = step
+	return true
+}
+
+func (m *UserMFA) UseRecoveryCode(code string) bool {
+	recoveryCode, err := vo.NewRecoveryCode(code)
+	if err != nil {
+		return false
+	}
+
+	index := slices.Index(m.recoveryCodes, recoveryCode.Hash())
+	if index < 0 {
+		return false
+	}
+
+	m.recoveryCodes = slices.Delete(m.recoveryCodes, index, index+1)
+	return true
+}
+
+func (m *UserMFA) ReplaceRecoveryCodes(codes []vo.RecoveryCode) {
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, code.Hash())
+	}
+
+	m.recoveryCodes = hashes
+}
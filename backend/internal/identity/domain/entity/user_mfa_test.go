@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserMFA_Confirm(t *testing.T) {
+	secret, _ := vo.GenerateTOTPSecret()
+	now := time.Now()
+
+	t.Run("Should enable with a valid code", func(t *testing.T) {
+		mfa := NewUserMFA(uuid.New(), secret)
+		assert.False(t, mfa.IsEnabled())
+
+		err := mfa.Confirm(secret.Code(vo.TOTPStep(now)), now)
+		assert.NoError(t, err)
+		assert.True(t, mfa.IsEnabled())
+	})
+
+	t.Run("Should reject an invalid code", func(t *testing.T) {
+		mfa := NewUserMFA(uuid.New(), secret)
+
+		err := mfa.Confirm("000000", now.Add(-time.Hour))
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+		assert.False(t, mfa.IsEnabled())
+	})
+
+	t.Run("Should not confirm twice", func(t *testing.T) {
+		mfa, _ := RestoreUserMFA(uuid.New(), secret.String(), true, nil, 0)
+
+		err := mfa.Confirm(secret.Code(vo.TOTPStep(now)), now)
+		assert.ErrorIs(t, err, ErrMFAAlreadyEnabled)
+	})
+}
+
+func TestUserMFA_VerifyCode(t *testing.T) {
+	secret, _ := vo.GenerateTOTPSecret()
+	now := time.Now()
+	mfa, _ := RestoreUserMFA(uuid.New(), secret.String(), true, nil, 0)
+	code := secret.Code(vo.TOTPStep(now))
+
+	assert.True(t, mfa.VerifyCode(code, now))
+	assert.Equal(t, vo.TOTPStep(now), mfa.LastUsedStep())
+
+	// Replay of the same code must fail
+	assert.False(t, mfa.VerifyCode(code, now))
+}
+
+func TestUserMFA_RecoveryCodes(t *testing.T) {
+	secret, _ := vo.GenerateTOTPSecret()
+	mfa := NewUserMFA(uuid.New(), secret)
+
+	codes, _ := vo.GenerateRecoveryCodes(3)
+	mfa.ReplaceRecoveryCodes(codes)
+	assert.Len(t, mfa.RecoveryCodes(), 3)
+
+	assert.True(t, mfa.UseRecoveryCode(codes[0].String()))
+	assert.Len(t, mfa.RecoveryCodes(), 2)
+
+	// Codes are single-use
+	assert.False(t, mfa.UseRecoveryCode(codes[0].String()))
+	assert.False(t, mfa.UseRecoveryCode("invalid"))
+}
@@ -40,10 +40,21 @@ func TestUser_RoleManagement(t *testing.T) {
 	assert.Equal(t, vo.AdminRole, u.Roles()[0])
 }
 
+func TestUser_ChangeUsername(t *testing.T) {
+	email, _ := vo.NewEmail("test@test.com")
+	u, _ := NewUser(email, "user", "pass", []vo.Role{vo.EmployeeRole})
+
+	assert.NoError(t, u.ChangeUsername("renamed"))
+	assert.Equal(t, "renamed", u.Username())
+
+	assert.ErrorIs(t, u.ChangeUsername(""), ErrEmptyUsername)
+	assert.Equal(t, "renamed", u.Username())
+}
+
 func TestRestoreUser(t *testing.T) {
 	id := uuid.New()
 	now := time.Now()
-	u, err := RestoreUser(id, "test@test.com", "user", "hash", []string{"ADMIN"}, false, 3, &now, true)
+	u, err := RestoreUser(id, "test@test.com", "user", "hash", []string{"ADMIN"}, false, 3, &now, true, 4, nil, vo.SystemRoleCatalog())
 
 	assert.NoError(t, err)
 	assert.Equal(t, id, u.ID())
@@ -51,6 +62,31 @@ func TestRestoreUser(t *testing.T) {
 	assert.Equal(t, 3, u.FailedAttempts())
 	assert.NotNil(t, u.LockedUntil())
 	assert.True(t, u.EmailVerified())
+	assert.Equal(t, 4, u.TokenVersion())
+}
+
+func TestRestoreUser_ValidatesRolesAgainstCatalog(t *testing.T) {
+	cashier := vo.Role("CASHIER")
+	catalog := vo.SystemRoleCatalog().With(cashier, []vo.Permission{vo.PermOrdersWrite})
+
+	u, err := RestoreUser(uuid.New(), "test@test.com", "user", "hash", []string{"CASHIER"}, true, 0, nil, true, 0, nil, catalog)
+	assert.NoError(t, err)
+	assert.Equal(t, []vo.Role{cashier}, u.Roles())
+
+	_, err = RestoreUser(uuid.New(), "test@test.com", "user", "hash", []string{"CASHIER"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	assert.ErrorIs(t, err, vo.ErrInvalidRole)
+}
+
+func TestUser_RevokeSessions(t *testing.T) {
+	email, _ := vo.NewEmail("test@test.com")
+	u, _ := NewUser(email, "user", "pass", []vo.Role{vo.EmployeeRole})
+
+	assert.Equal(t, 0, u.TokenVersion())
+
+	u.RevokeSessions()
+	u.RevokeSessions()
+
+	assert.Equal(t, 2, u.TokenVersion())
 }
 
 func TestUser_AccountLockout(t *testing.T) {
@@ -62,16 +98,17 @@ func TestUser_AccountLockout(t *testing.T) {
 	now := time.Now()
 
 	t.Run("Should increment failed attempts", func(t *testing.T) {
-		u.RecordFailedLogin(threshold, baseDuration, now)
+		assert.False(t, u.RecordFailedLogin(threshold, baseDuration, now))
 		assert.Equal(t, 1, u.FailedAttempts())
 		assert.Nil(t, u.LockedUntil())
 	})
 
 	t.Run("Should lock account when threshold is reached", func(t *testing.T) {
 		// Já temos 1 falha, vamos para a 5ª
-		for i := 0; i < 4; i++ {
+		for i := 0; i < 3; i++ {
 			u.RecordFailedLogin(threshold, baseDuration, now)
 		}
+		assert.True(t, u.RecordFailedLogin(threshold, baseDuration, now))
 
 		assert.Equal(t, 5, u.FailedAttempts())
 		assert.NotNil(t, u.LockedUntil())
@@ -97,3 +134,27 @@ func TestUser_AccountLockout(t *testing.T) {
 		assert.Nil(t, u.LockedUntil())
 	})
 }
+
+func TestUser_Anonymize(t *testing.T) {
+	email, _ := vo.NewEmail("test@test.com")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	u, _ := NewUser(email, "user", password, []vo.Role{vo.EmployeeRole})
+	u.VerifyEmail()
+
+	now := time.Now()
+	u.Anonymize(now)
+
+	assert.NotContains(t, u.Email().String(), "test@test.com")
+	assert.Contains(t, u.Email().String(), u.ID().String())
+	assert.Contains(t, u.Username(), u.ID().String())
+	assert.False(t, u.Password().Matches("Password123!", vo.SinglePepper("pepper")))
+	assert.Empty(t, u.Roles())
+	assert.False(t, u.IsActive())
+	assert.False(t, u.EmailVerified())
+	assert.Equal(t, 1, u.TokenVersion())
+	assert.True(t, u.IsDeleted())
+	assert.Equal(t, &now, u.DeletedAt())
+
+	_, err := vo.NewEmail(u.Email().String())
+	assert.NoError(t, err)
+}
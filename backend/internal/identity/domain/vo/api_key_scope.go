@@ -0,0 +1,66 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidAPIKeyScope = errors.New("invalid api key scope")
+
+// APIKeyScope limits what an API key can do. Keys never inherit the roles of
+// the admin who created them; every route they may call checks one scope.
+type APIKeyScope string
+
+const (
+	ScopeUsersRead     APIKeyScope = "users:read"
+	ScopeProductsRead  APIKeyScope = "products:read"
+	ScopeProductsWrite APIKeyScope = "products:write"
+	ScopeStockRead     APIKeyScope = "stock:read"
+	ScopeStockWrite    APIKeyScope = "stock:write"
+	ScopeOrdersRead    APIKeyScope = "orders:read"
+	ScopeOrdersWrite   APIKeyScope = "orders:write"
+)
+
+// apiKeyScopePermissions names the permission a user must hold to give a key
+// each scope, so a key never does more than its creator could.
+var apiKeyScopePermissions = map[APIKeyScope]Permission{
+	ScopeUsersRead:     PermUsersRead,
+	ScopeProductsRead:  PermProductsRead,
+	ScopeProductsWrite: PermProductsWrite,
+	ScopeStockRead:     PermStockRead,
+	ScopeStockWrite:    PermStockWrite,
+	ScopeOrdersRead:    PermOrdersRead,
+	ScopeOrdersWrite:   PermOrdersWrite,
+}
+
+func NewAPIKeyScope(value string) (APIKeyScope, error) {
+	normalizedValue := APIKeyScope(strings.TrimSpace(strings.ToLower(value)))
+
+	for _, scope := range AllAPIKeyScopes() {
+		if scope == normalizedValue {
+			return normalizedValue, nil
+		}
+	}
+
+	return "", ErrInvalidAPIKeyScope
+}
+
+func (s APIKeyScope) String() string {
+	return string(s)
+}
+
+func (s APIKeyScope) Permission() Permission {
+	return apiKeyScopePermissions[s]
+}
+
+func AllAPIKeyScopes() []APIKeyScope {
+	return []APIKeyScope{
+		ScopeUsersRead,
+		ScopeProductsRead,
+		ScopeProductsWrite,
+		ScopeStockRead,
+		ScopeStockWrite,
+		ScopeOrdersRead,
+		ScopeOrdersWrite,
+	}
+}
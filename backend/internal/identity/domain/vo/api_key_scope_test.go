@@ -0,0 +1,42 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIKeyScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    APIKeyScope
+		wantErr error
+	}{
+		{name: "Valid", input: "orders:read", want: ScopeOrdersRead},
+		{name: "Normalized", input: "  Stock:Write ", want: ScopeStockWrite},
+		{name: "Unknown", input: "orders:delete", wantErr: ErrInvalidAPIKeyScope},
+		{name: "Empty", input: "", wantErr: ErrInvalidAPIKeyScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAPIKeyScope(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAPIKeyScope_Permission(t *testing.T) {
+	for _, scope := range AllAPIKeyScopes() {
+		permission, err := NewPermission(scope.Permission().String())
+		assert.NoError(t, err, "scope %s", scope)
+		assert.Equal(t, scope.String(), permission.String())
+	}
+}
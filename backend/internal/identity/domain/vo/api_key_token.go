@@ -0,0 +1,70 @@
+package vo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const (
+	apiKeyTokenPrefix = "omk"
+	apiKeyIDBytes     = 6
+	apiKeySecretBytes = 32
+)
+
+var (
+	ErrInvalidAPIKeyFormat = errors.New("invalid api key format")
+	ErrGeneratingAPIKey    = errors.New("failed to generate api key")
+)
+
+// APIKeyToken is the full key handed to the integration, formatted as
+// omk_<id>_<secret>. The id part is not secret and is kept to tell keys apart
+// in listings; only the hash of the whole token is stored.
+type APIKeyToken string
+
+func NewAPIKeyToken(value string) (APIKeyToken, error) {
+	parts := strings.Split(strings.TrimSpace(value), "_")
+	if len(parts) != 3 || parts[0] != apiKeyTokenPrefix || parts[1] == "" || parts[2] == "" {
+		return "", ErrInvalidAPIKeyFormat
+	}
+
+	return APIKeyToken(strings.Join(parts, "_")), nil
+}
+
+func GenerateAPIKeyToken() (APIKeyToken, error) {
+	id := make([]byte, apiKeyIDBytes)
+	secret := make([]byte, apiKeySecretBytes)
+
+	if _, err := rand.Read(id); err != nil {
+		return "", ErrGeneratingAPIKey
+	}
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", ErrGeneratingAPIKey
+	}
+
+	return APIKeyToken(apiKeyTokenPrefix + "_" + hex.EncodeToString(id) + "_" + hex.EncodeToString(secret)), nil
+}
+
+// Prefix is the displayable part of the key, e.g. omk_1a2b3c4d5e6f.
+func (t APIKeyToken) Prefix() string {
+	idx := strings.LastIndex(string(t), "_")
+	if idx < 0 {
+		return ""
+	}
+
+	return string(t[:idx])
+}
+
+// Hash is what gets persisted; the secret carries enough entropy that a
+// plain SHA-256 is sufficient and can be looked up directly.
+func (t APIKeyToken) Hash() string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t APIKeyToken) String() string {
+	return string(t)
+}
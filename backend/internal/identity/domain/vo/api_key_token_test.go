@@ -0,0 +1,37 @@
+package vo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyToken(t *testing.T) {
+	t.Run("Generate", func(t *testing.T) {
+		token, err := GenerateAPIKeyToken()
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(token.String(), token.Prefix()+"_"))
+		assert.True(t, strings.HasPrefix(token.Prefix(), "omk_"))
+		assert.Len(t, token.Hash(), 64)
+
+		other, _ := GenerateAPIKeyToken()
+		assert.NotEqual(t, token, other)
+		assert.NotEqual(t, token.Hash(), other.Hash())
+	})
+
+	t.Run("Parse Round Trip", func(t *testing.T) {
+		token, _ := GenerateAPIKeyToken()
+
+		parsed, err := NewAPIKeyToken(" " + token.String() + " ")
+		assert.NoError(t, err)
+		assert.Equal(t, token.Hash(), parsed.Hash())
+	})
+
+	t.Run("Invalid Format", func(t *testing.T) {
+		for _, value := range []string{"", "omk_abc", "xyz_abc_def", "omk__secret", "omk_abc_"} {
+			_, err := NewAPIKeyToken(value)
+			assert.ErrorIs(t, err, ErrInvalidAPIKeyFormat, value)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidAuditAction = errors.New("invalid audit action")
+
+// AuditAction names a security-relevant event in the audit log. Values are
+// stored as is, so existing ones must never be renamed.
+type AuditAction string
+
+const (
+	AuditLoginSucceeded     AuditAction = "auth.login.succeeded"
+	AuditLoginFailed        AuditAction = "auth.login.failed"
+	AuditMagicLinkSent      AuditAction = "auth.magic_link.sent"
+	AuditAccountLocked      AuditAction = "auth.account.locked"
+	AuditAccountUnlocked    AuditAction = "auth.account.unlocked"
+	AuditTokenRotated       AuditAction = "auth.token.rotated"
+	AuditTokenReuseDetected AuditAction = "auth.token.reuse_detected"
+	AuditPasswordChanged    AuditAction = "user.password.changed"
+	AuditPasswordReset      AuditAction = "user.password.reset"
+	AuditProfileUpdated     AuditAction = "user.profile.updated"
+	AuditEmailChangeRequest AuditAction = "user.email.change_requested"
+	AuditEmailChanged       AuditAction = "user.email.changed"
+	AuditAccountDeleted     AuditAction = "user.account.deleted"
+	AuditAccountImported    AuditAction = "user.account.imported"
+	AuditRolesChanged       AuditAction = "user.roles.changed"
+	AuditStatusChanged      AuditAction = "user.status.changed"
+	AuditImpersonationStart AuditAction = "user.impersonation.started"
+	AuditImpersonationEnd   AuditAction = "user.impersonation.ended"
+	AuditMemberUpdated      AuditAction = "store.member.updated"
+	AuditMFAReset           AuditAction = "user.mfa.reset"
+	AuditSessionsRevoked    AuditAction = "user.sessions.revoked"
+	AuditAPIKeyCreated      AuditAction = "api_key.created"
+	AuditAPIKeyRevoked      AuditAction = "api_key.revoked"
+	AuditRoleCreated        AuditAction = "role.created"
+	AuditRoleUpdated        AuditAction = "role.updated"
+	AuditRoleDeleted        AuditAction = "role.deleted"
+	AuditMemberAdded        AuditAction = "store.member.added"
+	AuditMemberRemoved      AuditAction = "store.member.removed"
+	AuditInvitationCreated  AuditAction = "store.invitation.created"
+	AuditInvitationRevoked  AuditAction = "store.invitation.revoked"
+	AuditInvitationAccepted AuditAction = "store.invitation.accepted"
+)
+
+func NewAuditAction(value string) (AuditAction, error) {
+	normalizedValue := AuditAction(strings.TrimSpace(strings.ToLower(value)))
+
+	for _, action := range AllAuditActions() {
+		if action == normalizedValue {
+			return normalizedValue, nil
+		}
+	}
+
+	return "", ErrInvalidAuditAction
+}
+
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+func AllAuditActions() []AuditAction {
+	return []AuditAction{
+		AuditLoginSucceeded,
+		AuditLoginFailed,
+		AuditMagicLinkSent,
+		AuditAccountLocked,
+		AuditAccountUnlocked,
+		AuditTokenRotated,
+		AuditTokenReuseDetected,
+		AuditPasswordChanged,
+		AuditPasswordReset,
+		AuditProfileUpdated,
+		AuditEmailChangeRequest,
+		AuditEmailChanged,
+		AuditAccountDeleted,
+		AuditAccountImported,
+		AuditRolesChanged,
+		AuditStatusChanged,
+		AuditImpersonationStart,
+		AuditImpersonationEnd,
+		AuditMemberUpdated,
+		AuditMFAReset,
+		AuditSessionsRevoked,
+		AuditAPIKeyCreated,
+		AuditAPIKeyRevoked,
+		AuditRoleCreated,
+		AuditRoleUpdated,
+		AuditRoleDeleted,
+		AuditMemberAdded,
+		AuditMemberRemoved,
+		AuditInvitationCreated,
+		AuditInvitationRevoked,
+		AuditInvitationAccepted,
+	}
+}
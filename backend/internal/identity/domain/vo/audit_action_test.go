@@ -0,0 +1,34 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    AuditAction
+		wantErr error
+	}{
+		{name: "Known", input: "auth.login.failed", want: AuditLoginFailed},
+		{name: "Normalized", input: " USER.ROLES.CHANGED ", want: AuditRolesChanged},
+		{name: "Unknown", input: "auth.login", wantErr: ErrInvalidAuditAction},
+		{name: "Empty", input: "", wantErr: ErrInvalidAuditAction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAuditAction(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
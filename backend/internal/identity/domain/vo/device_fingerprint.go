@@ -0,0 +1,76 @@
+package vo
+
+import "strings"
+
+// DeviceFingerprint is a coarse description of the client behind a login,
+// such as "firefox/linux/desktop", derived from the user agent alone. It is
+// meant to tell a user's usual devices from a new one, not to identify a
+// device: two laptops running the same browser share a fingerprint.
+type DeviceFingerprint string
+
+const unknownDevicePart = "unknown"
+
+// userAgentToken maps a substring of the user agent to the name it stands
+// for. Lists are checked in order, so tokens that other browsers or systems
+// also send, such as "Safari" or "Linux", come after the more specific ones.
+type userAgentToken struct {
+	token string
+	name  string
+}
+
+var (
+	browserTokens = []userAgentToken{
+		{"edg/", "edge"},
+		{"opr/", "opera"},
+		{"samsungbrowser/", "samsung"},
+		{"firefox/", "firefox"},
+		{"fxios/", "firefox"},
+		{"crios/", "chrome"},
+		{"chrome/", "chrome"},
+		{"safari/", "safari"},
+		{"curl/", "curl"},
+		{"okhttp/", "okhttp"},
+	}
+	osTokens = []userAgentToken{
+		{"android", "android"},
+		{"iphone", "ios"},
+		{"ipad", "ios"},
+		{"windows", "windows"},
+		{"mac os x", "macos"},
+		{"cros", "chromeos"},
+		{"linux", "linux"},
+	}
+)
+
+func NewDeviceFingerprint(userAgent string) DeviceFingerprint {
+	ua := strings.ToLower(userAgent)
+
+	return DeviceFingerprint(matchUserAgent(ua, browserTokens) + "/" + matchUserAgent(ua, osTokens) + "/" + deviceClass(ua))
+}
+
+func (f DeviceFingerprint) String() string {
+	return string(f)
+}
+
+func matchUserAgent(ua string, tokens []userAgentToken) string {
+	for _, t := range tokens {
+		if strings.Contains(ua, t.token) {
+			return t.name
+		}
+	}
+
+	return unknownDevicePart
+}
+
+func deviceClass(ua string) string {
+	switch {
+	case ua == "":
+		return unknownDevicePart
+	case strings.Contains(ua, "ipad") || strings.Contains(ua, "tablet"):
+		return "tablet"
+	case strings.Contains(ua, "mobile") || strings.Contains(ua, "iphone") || strings.Contains(ua, "android"):
+		return "mobile"
+	default:
+		return "desktop"
+	}
+}
@@ -0,0 +1,52 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDeviceFingerprint(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		want      DeviceFingerprint
+	}{
+		{
+			name:      "Chrome On Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+			want:      "chrome/windows/desktop",
+		},
+		{
+			name:      "Edge Is Not Chrome",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.0.0",
+			want:      "edge/windows/desktop",
+		},
+		{
+			name:      "Safari On iPhone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1",
+			want:      "safari/ios/mobile",
+		},
+		{
+			name:      "Firefox On Linux",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+			want:      "firefox/linux/desktop",
+		},
+		{
+			name:      "Chrome On Android",
+			userAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36",
+			want:      "chrome/android/mobile",
+		},
+		{
+			name:      "Empty",
+			userAgent: "",
+			want:      "unknown/unknown/unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewDeviceFingerprint(tt.userAgent))
+		})
+	}
+}
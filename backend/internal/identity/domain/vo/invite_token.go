@@ -0,0 +1,50 @@
+package vo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const inviteTokenBytes = 32
+
+var (
+	ErrInvalidInviteToken = errors.New("invalid invite token format")
+	ErrGeneratingInvite   = errors.New("failed to generate invite token")
+)
+
+// InviteToken is the secret emailed to an invitee. Like API keys, only its
+// hash is stored, so a database leak does not hand out pending accounts.
+type InviteToken string
+
+func NewInviteToken(value string) (InviteToken, error) {
+	normalizedValue := strings.TrimSpace(value)
+
+	decoded, err := hex.DecodeString(normalizedValue)
+	if err != nil || len(decoded) != inviteTokenBytes {
+		return "", ErrInvalidInviteToken
+	}
+
+	return InviteToken(strings.ToLower(normalizedValue)), nil
+}
+
+func GenerateInviteToken() (InviteToken, error) {
+	secret := make([]byte, inviteTokenBytes)
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", ErrGeneratingInvite
+	}
+
+	return InviteToken(hex.EncodeToString(secret)), nil
+}
+
+func (t InviteToken) Hash() string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t InviteToken) String() string {
+	return string(t)
+}
@@ -0,0 +1,29 @@
+package vo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInviteToken(t *testing.T) {
+	token, err := GenerateInviteToken()
+	assert.NoError(t, err)
+	assert.Len(t, token.String(), 64)
+
+	parsed, err := NewInviteToken(" " + strings.ToUpper(token.String()) + " ")
+	assert.NoError(t, err)
+	assert.Equal(t, token, parsed)
+	assert.Equal(t, token.Hash(), parsed.Hash())
+	assert.NotEqual(t, token.String(), token.Hash())
+
+	other, _ := GenerateInviteToken()
+	assert.NotEqual(t, token.Hash(), other.Hash())
+
+	_, err = NewInviteToken("not-hex")
+	assert.ErrorIs(t, err, ErrInvalidInviteToken)
+
+	_, err = NewInviteToken("abcd")
+	assert.ErrorIs(t, err, ErrInvalidInviteToken)
+}
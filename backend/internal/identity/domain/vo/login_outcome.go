@@ -0,0 +1,34 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidLoginOutcome = errors.New("invalid login outcome")
+
+// LoginOutcome tells how a login attempt of a known user ended. Values are
+// stored as is, so existing ones must never be renamed.
+type LoginOutcome string
+
+const (
+	LoginSucceeded       LoginOutcome = "succeeded"
+	LoginInvalidPassword LoginOutcome = "invalid_password"
+	LoginInvalidMFACode  LoginOutcome = "invalid_mfa_code"
+	LoginAccountLocked   LoginOutcome = "account_locked"
+)
+
+func NewLoginOutcome(value string) (LoginOutcome, error) {
+	normalizedValue := LoginOutcome(strings.TrimSpace(strings.ToLower(value)))
+
+	switch normalizedValue {
+	case LoginSucceeded, LoginInvalidPassword, LoginInvalidMFACode, LoginAccountLocked:
+		return normalizedValue, nil
+	default:
+		return "", ErrInvalidLoginOutcome
+	}
+}
+
+func (o LoginOutcome) String() string {
+	return string(o)
+}
@@ -0,0 +1,32 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidMembershipStatus = errors.New("invalid membership status")
+
+// MembershipStatus tells whether a store membership currently grants its
+// roles. A suspended member keeps the membership but cannot act in the store.
+type MembershipStatus string
+
+const (
+	MembershipActive    MembershipStatus = "ACTIVE"
+	MembershipSuspended MembershipStatus = "SUSPENDED"
+)
+
+func NewMembershipStatus(value string) (MembershipStatus, error) {
+	normalizedValue := MembershipStatus(strings.TrimSpace(strings.ToUpper(value)))
+
+	switch normalizedValue {
+	case MembershipActive, MembershipSuspended:
+		return normalizedValue, nil
+	default:
+		return "", ErrInvalidMembershipStatus
+	}
+}
+
+func (s MembershipStatus) String() string {
+	return string(s)
+}
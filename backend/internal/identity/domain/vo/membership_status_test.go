@@ -0,0 +1,34 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMembershipStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    MembershipStatus
+		wantErr error
+	}{
+		{name: "Active", input: "ACTIVE", want: MembershipActive},
+		{name: "Normalized", input: " suspended ", want: MembershipSuspended},
+		{name: "Unknown", input: "FIRED", wantErr: ErrInvalidMembershipStatus},
+		{name: "Empty", input: "", wantErr: ErrInvalidMembershipStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewMembershipStatus(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -28,7 +28,13 @@ var (
 
 type Password string
 
-func NewPassword(plainText, pepper string) (Password, error) {
+// UnusablePassword is not a valid hash, so Matches never accepts anything
+// against it.
+const UnusablePassword Password = "!"
+
+// NewPassword validates the plain text against the password policy and hashes
+// it with the current pepper.
+func NewPassword(plainText string, peppers Peppers) (Password, error) {
 	if len(plainText) < 8 {
 		return "", ErrPasswordTooShort
 	}
@@ -37,59 +43,138 @@ func NewPassword(plainText, pepper string) (Password, error) {
 		return "", ErrLowPasswordComplexity
 	}
 
-	saltedPlain := plainText + pepper
+	return hashPassword(plainText, peppers)
+}
+
+// RehashPassword hashes a password that was just verified with Matches using
+// the current parameters and pepper. The policy is not checked again: the user
+// already owns this password and a stricter policy must not lock them out.
+func RehashPassword(plainText string, peppers Peppers) (Password, error) {
+	return hashPassword(plainText, peppers)
+}
+
+func RestorePassword(hash string) (Password, error) {
+	if hash == "" {
+		return "", ErrEmptyPassword
+	}
+
+	return Password(hash), nil
+}
+
+// IsUsable reports whether the password can be logged in with, i.e. it is
+// not UnusablePassword.
+func (p Password) IsUsable() bool {
+	return p != UnusablePassword
+}
+
+func (p Password) Matches(plainText string, peppers Peppers) bool {
+	hash, ok := parseHash(string(p))
+	if !ok {
+		return false
+	}
+
+	pepper, ok := peppers.lookup(hash.pepperID)
+	if !ok {
+		return false
+	}
+
+	compareHash := argon2.IDKey([]byte(plainText+pepper), hash.salt, hash.time, hash.memory, hash.threads, uint32(len(hash.key)))
+
+	return subtle.ConstantTimeCompare(hash.key, compareHash) == 1
+}
+
+// NeedsRehash reports whether the hash was made with other Argon2id
+// parameters or another pepper than the current ones.
+func (p Password) NeedsRehash(peppers Peppers) bool {
+	hash, ok := parseHash(string(p))
+	if !ok {
+		return true
+	}
+
+	return hash.version != argon2.Version ||
+		hash.memory != memory ||
+		hash.time != timeCost ||
+		hash.threads != threads ||
+		len(hash.key) != keyLength ||
+		hash.pepperID != peppers.CurrentID()
+}
+
+func hashPassword(plainText string, peppers Peppers) (Password, error) {
+	pepperID := peppers.CurrentID()
+	pepper, ok := peppers.lookup(pepperID)
+	if !ok {
+		return "", ErrUnknownPepper
+	}
 
 	salt := make([]byte, keyLength/2)
 	if _, err := rand.Read(salt); err != nil {
 		return "", ErrInternalError
 	}
 
-	hashKey := argon2.IDKey([]byte(saltedPlain), salt, timeCost, memory, threads, keyLength)
+	hashKey := argon2.IDKey([]byte(plainText+pepper), salt, timeCost, memory, threads, keyLength)
 
 	b64Salt := base64.RawStdEncoding.EncodeToString(salt)
 	b64Hash := base64.RawStdEncoding.EncodeToString(hashKey)
 
-	encodeHash := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
-		argon2.Version, memory, timeCost, threads, b64Salt, b64Hash)
+	encodeHash := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d,k=%s$%s$%s",
+		argon2.Version, memory, timeCost, threads, pepperID, b64Salt, b64Hash)
 
 	return Password(encodeHash), nil
 }
 
-func RestorePassword(hash string) (Password, error) {
-	if hash == "" {
-		return "", ErrEmptyPassword
+type passwordHash struct {
+	version  int
+	memory   uint32
+	time     uint32
+	threads  uint8
+	pepperID string
+	salt     []byte
+	key      []byte
+}
+
+// parseHash reads the encoded form written by hashPassword. Hashes written
+// before pepper versioning have no k= parameter and use DefaultPepperID.
+func parseHash(encoded string) (passwordHash, bool) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return passwordHash{}, false
 	}
 
-	return Password(hash), nil
-}
+	hash := passwordHash{pepperID: DefaultPepperID}
 
-func (p Password) Matches(plainText, pepper string) bool {
-	parts := strings.Split(string(p), "$")
-	if len(parts) != 6 {
-		return false
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &hash.version); err != nil {
+		return passwordHash{}, false
 	}
 
-	var memory, time uint32
-	var threads uint8
+	params := strings.Split(parts[3], ",")
+	if len(params) < 3 || len(params) > 4 {
+		return passwordHash{}, false
+	}
 
-	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads)
-	if err != nil {
-		return false
+	_, err := fmt.Sscanf(strings.Join(params[:3], ","), "m=%d,t=%d,p=%d", &hash.memory, &hash.time, &hash.threads)
+	if err != nil || hash.time == 0 || hash.threads == 0 {
+		return passwordHash{}, false
 	}
 
-	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
-	if err != nil {
-		return false
+	if len(params) == 4 {
+		pepperID, found := strings.CutPrefix(params[3], "k=")
+		if !found || !pepperIDPattern.MatchString(pepperID) {
+			return passwordHash{}, false
+		}
+		hash.pepperID = pepperID
 	}
 
-	decodedHash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	hash.salt, err = base64.RawStdEncoding.DecodeString(parts[4])
 	if err != nil {
-		return false
+		return passwordHash{}, false
 	}
 
-	compareHash := argon2.IDKey([]byte(plainText+pepper), salt, time, memory, threads, uint32(len(decodedHash)))
+	hash.key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(hash.key) == 0 {
+		return passwordHash{}, false
+	}
 
-	return subtle.ConstantTimeCompare(decodedHash, compareHash) == 1
+	return hash, true
 }
 
 func (p Password) String() string {
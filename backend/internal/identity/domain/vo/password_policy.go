@@ -0,0 +1,182 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrPasswordTooLong           = errors.New("password too long")
+	ErrBreachedPassword          = errors.New("password is too common or appeared in a data breach")
+	ErrPasswordSimilarToIdentity = errors.New("password must not contain the username or email")
+	ErrPasswordReused            = errors.New("password was used recently")
+)
+
+// MaxPasswordHistory is the most former passwords a policy may block,
+// counting the current one.
+const MaxPasswordHistory = 24
+
+// minIdentityLength keeps short usernames like "ana" from rejecting every
+// password that happens to contain them.
+const minIdentityLength = 4
+
+// PasswordCandidate is a new password together with what the rules may
+// compare it against. Previous holds the user's recent hashes, newest first.
+type PasswordCandidate struct {
+	PlainText string
+	Username  string
+	Email     Email
+	Previous  []Password
+}
+
+type PasswordRule interface {
+	Check(candidate PasswordCandidate) error
+}
+
+// PasswordCorpus is a set of passwords known to be guessable, e.g. built from
+// public breach dumps.
+type PasswordCorpus interface {
+	Contains(plainText string) bool
+}
+
+// PasswordPolicy screens a new password before it is hashed. NewPassword
+// still enforces the minimum length and complexity on its own, so a policy
+// can only make the requirements stricter.
+type PasswordPolicy struct {
+	rules []PasswordRule
+}
+
+func NewPasswordPolicy(rules ...PasswordRule) PasswordPolicy {
+	return PasswordPolicy{rules: rules}
+}
+
+// DefaultPasswordPolicy is the policy used when nothing else is configured:
+// 8 to 128 characters, complexity, the breach corpus, no username or email
+// inside the password, and no reuse of the hashes in the candidate. Callers
+// decide how far back the history goes by how many hashes they pass.
+func DefaultPasswordPolicy(corpus PasswordCorpus, peppers Peppers) PasswordPolicy {
+	return NewPasswordPolicy(
+		LengthRule(8, 128),
+		ComplexityRule(),
+		BreachedPasswordRule(corpus),
+		IdentitySimilarityRule(),
+		HistoryRule(MaxPasswordHistory, peppers),
+	)
+}
+
+// Check returns the error of the first rule the candidate breaks.
+func (p PasswordPolicy) Check(candidate PasswordCandidate) error {
+	for _, rule := range p.rules {
+		if err := rule.Check(candidate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type lengthRule struct {
+	min int
+	max int
+}
+
+// LengthRule counts characters, not bytes. A max of 0 means no upper bound,
+// but one should be set: every byte of the password goes through Argon2id.
+func LengthRule(min, max int) PasswordRule {
+	return lengthRule{min: min, max: max}
+}
+
+func (r lengthRule) Check(candidate PasswordCandidate) error {
+	length := utf8.RuneCountInString(candidate.PlainText)
+	if length < r.min {
+		return ErrPasswordTooShort
+	}
+
+	if r.max > 0 && length > r.max {
+		return ErrPasswordTooLong
+	}
+
+	return nil
+}
+
+type complexityRule struct{}
+
+func ComplexityRule() PasswordRule {
+	return complexityRule{}
+}
+
+func (complexityRule) Check(candidate PasswordCandidate) error {
+	if !validateComplexity(candidate.PlainText) {
+		return ErrLowPasswordComplexity
+	}
+
+	return nil
+}
+
+type breachedPasswordRule struct {
+	corpus PasswordCorpus
+}
+
+func BreachedPasswordRule(corpus PasswordCorpus) PasswordRule {
+	return breachedPasswordRule{corpus: corpus}
+}
+
+func (r breachedPasswordRule) Check(candidate PasswordCandidate) error {
+	if r.corpus != nil && r.corpus.Contains(candidate.PlainText) {
+		return ErrBreachedPassword
+	}
+
+	return nil
+}
+
+type identitySimilarityRule struct{}
+
+// IdentitySimilarityRule rejects passwords containing the username or the
+// local part of the email, ignoring case.
+func IdentitySimilarityRule() PasswordRule {
+	return identitySimilarityRule{}
+}
+
+func (identitySimilarityRule) Check(candidate PasswordCandidate) error {
+	password := strings.ToLower(candidate.PlainText)
+
+	localPart, _, _ := strings.Cut(candidate.Email.String(), "@")
+	for _, identity := range []string{candidate.Username, localPart} {
+		identity = strings.ToLower(strings.TrimSpace(identity))
+		if utf8.RuneCountInString(identity) < minIdentityLength {
+			continue
+		}
+
+		if strings.Contains(password, identity) {
+			return ErrPasswordSimilarToIdentity
+		}
+	}
+
+	return nil
+}
+
+type historyRule struct {
+	depth   int
+	peppers Peppers
+}
+
+// HistoryRule rejects a password matching any of the depth most recent
+// hashes in the candidate.
+func HistoryRule(depth int, peppers Peppers) PasswordRule {
+	return historyRule{depth: depth, peppers: peppers}
+}
+
+func (r historyRule) Check(candidate PasswordCandidate) error {
+	for i, previous := range candidate.Previous {
+		if i >= r.depth {
+			break
+		}
+
+		if previous.Matches(candidate.PlainText, r.peppers) {
+			return ErrPasswordReused
+		}
+	}
+
+	return nil
+}
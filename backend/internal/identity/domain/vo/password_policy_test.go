@@ -0,0 +1,76 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubCorpus map[string]bool
+
+func (c stubCorpus) Contains(plainText string) bool {
+	return c[plainText]
+}
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	peppers := SinglePepper("pepper")
+	current, _ := NewPassword("Current123!", peppers)
+	older, _ := NewPassword("Older123!", peppers)
+	email, _ := NewEmail("maria.silva@test.com")
+	policy := NewPasswordPolicy(
+		LengthRule(10, 20),
+		ComplexityRule(),
+		BreachedPasswordRule(stubCorpus{"Summer2024!": true}),
+		IdentitySimilarityRule(),
+		HistoryRule(1, peppers),
+	)
+
+	tests := []struct {
+		name     string
+		password string
+		username string
+		wantErr  error
+	}{
+		{"Valid", "Tr0ub4dor&3x", "maria", nil},
+		{"Too Short", "Ab1!defg", "maria", ErrPasswordTooShort},
+		{"Too Long", "Abcdefgh1!Abcdefgh1!X", "maria", ErrPasswordTooLong},
+		{"Low Complexity", "abcdefghijk", "maria", ErrLowPasswordComplexity},
+		{"Breached", "Summer2024!", "maria", ErrBreachedPassword},
+		{"Contains Username", "xMariaRocks1!", "mariarocks", ErrPasswordSimilarToIdentity},
+		{"Contains Email Local Part", "Maria.Silva99!", "someone", ErrPasswordSimilarToIdentity},
+		{"Short Username Ignored", "Anastasia#2024", "ana", nil},
+		{"Reuses Current", "Current123!", "maria", ErrPasswordReused},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Check(PasswordCandidate{
+				PlainText: tt.password,
+				Username:  tt.username,
+				Email:     email,
+				Previous:  []Password{current, older},
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHistoryRule_Depth(t *testing.T) {
+	peppers := SinglePepper("pepper")
+	current, _ := NewPassword("Current123!", peppers)
+	older, _ := NewPassword("Older12345!", peppers)
+	candidate := PasswordCandidate{PlainText: "Older12345!", Previous: []Password{current, older}}
+
+	assert.NoError(t, HistoryRule(1, peppers).Check(candidate))
+	assert.ErrorIs(t, HistoryRule(2, peppers).Check(candidate), ErrPasswordReused)
+	assert.NoError(t, HistoryRule(0, peppers).Check(candidate))
+}
+
+func TestBreachedPasswordRule_NilCorpus(t *testing.T) {
+	assert.NoError(t, BreachedPasswordRule(nil).Check(PasswordCandidate{PlainText: "Password1!"}))
+}
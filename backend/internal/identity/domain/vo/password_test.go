@@ -1,12 +1,13 @@
 package vo
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
-const testPepper = "secret-pepper"
+var testPepper = SinglePepper("secret-pepper")
 
 func TestNewPassword(t *testing.T) {
 	tests := []struct {
@@ -40,7 +41,67 @@ func TestPassword_Matches(t *testing.T) {
 
 	assert.True(t, pwd.Matches(plain, testPepper))
 	assert.False(t, pwd.Matches("WrongPass123!", testPepper))
-	assert.False(t, pwd.Matches(plain, "wrong-pepper"))
+	assert.False(t, pwd.Matches(plain, SinglePepper("wrong-pepper")))
+	assert.False(t, pwd.NeedsRehash(testPepper))
+}
+
+func TestPassword_PepperRotation(t *testing.T) {
+	plain := "StrongPass123!"
+	old, err := NewPassword(plain, testPepper)
+	assert.NoError(t, err)
+
+	rotated, err := NewPeppers("2", map[string]string{DefaultPepperID: "secret-pepper", "2": "new-pepper"})
+	assert.NoError(t, err)
+
+	assert.True(t, old.Matches(plain, rotated))
+	assert.True(t, old.NeedsRehash(rotated))
+
+	rehashed, err := RehashPassword(plain, rotated)
+	assert.NoError(t, err)
+	assert.Contains(t, rehashed.String(), ",k=2$")
+	assert.True(t, rehashed.Matches(plain, rotated))
+	assert.False(t, rehashed.NeedsRehash(rotated))
+
+	// once the old pepper is dropped, only upgraded hashes still verify
+	retired, _ := NewPeppers("2", map[string]string{"2": "new-pepper"})
+	assert.False(t, old.Matches(plain, retired))
+	assert.True(t, rehashed.Matches(plain, retired))
+}
+
+func TestPassword_UnversionedHash(t *testing.T) {
+	plain := "StrongPass123!"
+	pwd, err := NewPassword(plain, testPepper)
+	assert.NoError(t, err)
+
+	legacy := Password(strings.Replace(pwd.String(), ",k="+DefaultPepperID, "", 1))
+	assert.True(t, legacy.Matches(plain, testPepper))
+	assert.False(t, legacy.NeedsRehash(testPepper))
+}
+
+func TestPassword_NeedsRehashOnOldParameters(t *testing.T) {
+	plain := "StrongPass123!"
+	pwd, err := NewPassword(plain, testPepper)
+	assert.NoError(t, err)
+
+	weak := Password(strings.Replace(pwd.String(), "t=3", "t=1", 1))
+	assert.True(t, weak.NeedsRehash(testPepper))
+	assert.True(t, Password("not-a-hash").NeedsRehash(testPepper))
+	assert.False(t, Password("$argon2id$v=19$m=65536,t=3,p=4,k=default$c2FsdA$").Matches("", testPepper))
+}
+
+func TestNewPeppers(t *testing.T) {
+	_, err := NewPeppers("1", map[string]string{"2": "pepper"})
+	assert.ErrorIs(t, err, ErrUnknownPepper)
+
+	_, err = NewPeppers("a,b", map[string]string{"a,b": "pepper"})
+	assert.ErrorIs(t, err, ErrInvalidPepperID)
+
+	_, err = NewPeppers("1", map[string]string{"1": ""})
+	assert.ErrorIs(t, err, ErrEmptyPepper)
+
+	peppers, err := NewPeppers("1", map[string]string{"1": "pepper"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", peppers.CurrentID())
 }
 
 func TestRestorePassword(t *testing.T) {
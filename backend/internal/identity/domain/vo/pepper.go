@@ -0,0 +1,66 @@
+package vo
+
+import (
+	"errors"
+	"regexp"
+)
+
+// DefaultPepperID is the key of hashes written before peppers were versioned.
+// Those hashes carry no pepper ID, so the pepper they were made with must stay
+// registered under this key until every one of them has been rehashed.
+const DefaultPepperID = "default"
+
+var (
+	ErrInvalidPepperID = errors.New("pepper ID must be alphanumeric")
+	ErrUnknownPepper   = errors.New("current pepper is not configured")
+	ErrEmptyPepper     = errors.New("empty pepper")
+)
+
+var pepperIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Peppers is the keyed set of server-side secrets appended to passwords before
+// hashing. New hashes use the current pepper and record its ID, so the others
+// are only kept to verify older hashes.
+type Peppers struct {
+	current string
+	keys    map[string]string
+}
+
+func NewPeppers(current string, keys map[string]string) (Peppers, error) {
+	copied := make(map[string]string, len(keys))
+	for id, pepper := range keys {
+		if !pepperIDPattern.MatchString(id) {
+			return Peppers{}, ErrInvalidPepperID
+		}
+
+		if pepper == "" {
+			return Peppers{}, ErrEmptyPepper
+		}
+
+		copied[id] = pepper
+	}
+
+	if _, ok := copied[current]; !ok {
+		return Peppers{}, ErrUnknownPepper
+	}
+
+	return Peppers{current: current, keys: copied}, nil
+}
+
+// SinglePepper keeps the unversioned configuration working: the pepper is
+// registered as DefaultPepperID and is the current one.
+func SinglePepper(pepper string) Peppers {
+	return Peppers{
+		current: DefaultPepperID,
+		keys:    map[string]string{DefaultPepperID: pepper},
+	}
+}
+
+func (p Peppers) CurrentID() string {
+	return p.current
+}
+
+func (p Peppers) lookup(id string) (string, bool) {
+	pepper, ok := p.keys[id]
+	return pepper, ok
+}
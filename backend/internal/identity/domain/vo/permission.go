@@ -0,0 +1,109 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidPermission = errors.New("invalid permission")
+
+// Permission is a single action a principal may perform. Routes and use cases
+// check permissions, never roles; roles are only bundles of permissions.
+// Permissions that API keys can hold share their name with an APIKeyScope.
+type Permission string
+
+const (
+	PermUsersRead           Permission = "users:read"
+	PermUsersStatusWrite    Permission = "users:status:write"
+	PermUsersRolesWrite     Permission = "users:roles:write"
+	PermUsersMFAReset       Permission = "users:mfa:reset"
+	PermUsersSessionsRevoke Permission = "users:sessions:revoke"
+	PermUsersUnlock         Permission = "users:unlock"
+	PermUsersImpersonate    Permission = "users:impersonate"
+	PermMFAPolicyRead       Permission = "mfa:policy:read"
+	PermMFAPolicyWrite      Permission = "mfa:policy:write"
+	PermAPIKeysManage       Permission = "api_keys:manage"
+	PermRolesManage         Permission = "roles:manage"
+	PermMembersManage       Permission = "members:manage"
+	PermPasswordPolicyRead  Permission = "password:policy:read"
+	PermPasswordPolicyWrite Permission = "password:policy:write"
+	PermAuditRead           Permission = "audit:read"
+	PermProductsRead        Permission = "products:read"
+	PermProductsWrite       Permission = "products:write"
+	PermStockRead           Permission = "stock:read"
+	PermStockWrite          Permission = "stock:write"
+	PermStockAdjust         Permission = "stock:adjust"
+	PermOrdersRead          Permission = "orders:read"
+	PermOrdersWrite         Permission = "orders:write"
+)
+
+func NewPermission(value string) (Permission, error) {
+	normalizedValue := Permission(strings.TrimSpace(strings.ToLower(value)))
+
+	for _, permission := range AllPermissions() {
+		if permission == normalizedValue {
+			return normalizedValue, nil
+		}
+	}
+
+	return "", ErrInvalidPermission
+}
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+func AllPermissions() []Permission {
+	return []Permission{
+		PermUsersRead,
+		PermUsersStatusWrite,
+		PermUsersRolesWrite,
+		PermUsersMFAReset,
+		PermUsersSessionsRevoke,
+		PermUsersUnlock,
+		PermUsersImpersonate,
+		PermMFAPolicyRead,
+		PermMFAPolicyWrite,
+		PermAPIKeysManage,
+		PermRolesManage,
+		PermMembersManage,
+		PermPasswordPolicyRead,
+		PermPasswordPolicyWrite,
+		PermAuditRead,
+		PermProductsRead,
+		PermProductsWrite,
+		PermStockRead,
+		PermStockWrite,
+		PermStockAdjust,
+		PermOrdersRead,
+		PermOrdersWrite,
+	}
+}
+
+// PermissionSet is the union of the permissions granted by a set of roles.
+type PermissionSet map[Permission]struct{}
+
+func NewPermissionSet(permissions ...Permission) PermissionSet {
+	set := make(PermissionSet, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = struct{}{}
+	}
+
+	return set
+}
+
+func (s PermissionSet) Has(permission Permission) bool {
+	_, ok := s[permission]
+	return ok
+}
+
+// Covers reports whether s holds every permission in other.
+func (s PermissionSet) Covers(other PermissionSet) bool {
+	for permission := range other {
+		if !s.Has(permission) {
+			return false
+		}
+	}
+
+	return true
+}
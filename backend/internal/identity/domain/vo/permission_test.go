@@ -0,0 +1,41 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPermission(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Permission
+		wantErr error
+	}{
+		{name: "Valid", input: "users:roles:write", want: PermUsersRolesWrite},
+		{name: "Normalized", input: "  Stock:Adjust ", want: PermStockAdjust},
+		{name: "Unknown", input: "users:delete", wantErr: ErrInvalidPermission},
+		{name: "Empty", input: "", wantErr: ErrInvalidPermission},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPermission(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAPIKeyScopesArePermissions(t *testing.T) {
+	for _, scope := range AllAPIKeyScopes() {
+		_, err := NewPermission(scope.String())
+		assert.NoError(t, err, scope)
+	}
+}
@@ -0,0 +1,71 @@
+package vo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	recoveryCodeChars  = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	recoveryCodeLength = 10
+)
+
+var (
+	ErrInvalidRecoveryCode    = errors.New("invalid recovery code")
+	ErrGeneratingRecoveryCode = errors.New("failed to generate recovery code")
+)
+
+// RecoveryCode is a single-use fallback for a lost authenticator, shown to
+// the user as XXXXX-XXXXX.
+type RecoveryCode string
+
+func NewRecoveryCode(value string) (RecoveryCode, error) {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(value))
+
+	if len(normalized) != recoveryCodeLength {
+		return "", ErrInvalidRecoveryCode
+	}
+
+	for _, char := range normalized {
+		if !strings.ContainsRune(recoveryCodeChars, char) {
+			return "", ErrInvalidRecoveryCode
+		}
+	}
+
+	return RecoveryCode(normalized), nil
+}
+
+func GenerateRecoveryCodes(amount int) ([]RecoveryCode, error) {
+	codes := make([]RecoveryCode, 0, amount)
+
+	for i := 0; i < amount; i++ {
+		buffer := make([]byte, recoveryCodeLength)
+		for j := range buffer {
+			num, err := rand.Int(rand.Reader, big.NewInt(int64(len(recoveryCodeChars))))
+			if err != nil {
+				return nil, ErrGeneratingRecoveryCode
+			}
+
+			buffer[j] = recoveryCodeChars[num.Int64()]
+		}
+
+		codes = append(codes, RecoveryCode(buffer))
+	}
+
+	return codes, nil
+}
+
+// Hash is what gets persisted; the codes carry enough entropy that a plain
+// SHA-256 is sufficient.
+func (r RecoveryCode) Hash() string {
+	sum := sha256.Sum256([]byte(r))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r RecoveryCode) String() string {
+	return string(r[:recoveryCodeLength/2]) + "-" + string(r[recoveryCodeLength/2:])
+}
@@ -0,0 +1,45 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRecoveryCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    RecoveryCode
+		wantErr error
+	}{
+		{"Formatted", "ABCDE-FGHJK", "ABCDEFGHJK", nil},
+		{"Lowercase without dash", "abcdefghjk", "ABCDEFGHJK", nil},
+		{"Too short", "ABCDE", "", ErrInvalidRecoveryCode},
+		{"Ambiguous characters", "ABCDE-FGHI0", "", ErrInvalidRecoveryCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRecoveryCode(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	assert.NoError(t, err)
+	assert.Len(t, codes, 10)
+
+	for _, code := range codes {
+		parsed, err := NewRecoveryCode(code.String())
+		assert.NoError(t, err)
+		assert.Equal(t, code.Hash(), parsed.Hash())
+	}
+}
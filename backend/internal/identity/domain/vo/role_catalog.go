@@ -0,0 +1,125 @@
+package vo
+
+import "sort"
+
+var systemRolePermissions = map[Role][]Permission{
+	AdminRole: AllPermissions(),
+	ManagerRole: {
+		PermUsersRead,
+		PermUsersStatusWrite,
+		PermUsersRolesWrite,
+		PermUsersMFAReset,
+		PermUsersSessionsRevoke,
+		PermUsersUnlock,
+		PermMFAPolicyRead,
+		PermMFAPolicyWrite,
+		PermMembersManage,
+		PermPasswordPolicyRead,
+		PermPasswordPolicyWrite,
+		PermProductsRead,
+		PermProductsWrite,
+		PermStockRead,
+		PermStockWrite,
+		PermStockAdjust,
+		PermOrdersRead,
+		PermOrdersWrite,
+	},
+	EmployeeRole: {
+		PermProductsRead,
+		PermStockRead,
+		PermStockWrite,
+		PermOrdersRead,
+		PermOrdersWrite,
+	},
+}
+
+// RoleCatalog maps every known role to the permissions it grants. System
+// roles and their permissions are fixed in code; custom roles are added from
+// the database with With.
+type RoleCatalog struct {
+	grants map[Role]PermissionSet
+}
+
+func SystemRoleCatalog() RoleCatalog {
+	catalog := RoleCatalog{grants: make(map[Role]PermissionSet, len(systemRolePermissions))}
+	for role, permissions := range systemRolePermissions {
+		catalog.grants[role] = NewPermissionSet(permissions...)
+	}
+
+	return catalog
+}
+
+func IsSystemRole(role Role) bool {
+	_, ok := systemRolePermissions[role]
+	return ok
+}
+
+// With returns a copy of the catalog that also knows role. System roles
+// cannot be redefined and are left untouched.
+func (c RoleCatalog) With(role Role, permissions []Permission) RoleCatalog {
+	if IsSystemRole(role) {
+		return c
+	}
+
+	next := RoleCatalog{grants: make(map[Role]PermissionSet, len(c.grants)+1)}
+	for known, set := range c.grants {
+		next.grants[known] = set
+	}
+	next.grants[role] = NewPermissionSet(permissions...)
+
+	return next
+}
+
+// Resolve parses value and checks that the catalog knows the role.
+func (c RoleCatalog) Resolve(value string) (Role, error) {
+	role, err := ParseRoleName(value)
+	if err != nil {
+		return "", err
+	}
+
+	if !c.Has(role) {
+		return "", ErrInvalidRole
+	}
+
+	return role, nil
+}
+
+func (c RoleCatalog) Has(role Role) bool {
+	_, ok := c.grants[role]
+	return ok
+}
+
+// Roles returns the system roles in AllRoles order, then the custom ones
+// sorted by name.
+func (c RoleCatalog) Roles() []Role {
+	roles := AllRoles()
+
+	custom := make([]Role, 0, len(c.grants))
+	for role := range c.grants {
+		if !IsSystemRole(role) {
+			custom = append(custom, role)
+		}
+	}
+	sort.Slice(custom, func(i, j int) bool { return custom[i] < custom[j] })
+
+	return append(roles, custom...)
+}
+
+// GrantsOf returns every permission granted by at least one of roles. Roles
+// the catalog does not know grant nothing.
+func (c RoleCatalog) GrantsOf(roles ...Role) PermissionSet {
+	set := make(PermissionSet)
+	for _, role := range roles {
+		for permission := range c.grants[role] {
+			set[permission] = struct{}{}
+		}
+	}
+
+	return set
+}
+
+// SystemRolePermissions returns the permissions fixed in code for a system
+// role, or nil for any other role.
+func SystemRolePermissions(role Role) []Permission {
+	return append([]Permission(nil), systemRolePermissions[role]...)
+}
@@ -0,0 +1,89 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRoleName(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Role
+		wantErr error
+	}{
+		{"System role", "ADMIN", AdminRole, nil},
+		{"Custom role normalized", "  stock_clerk ", Role("STOCK_CLERK"), nil},
+		{"Starts with digit", "1CASHIER", "", ErrInvalidRole},
+		{"Invalid characters", "HEAD-CHEF", "", ErrInvalidRole},
+		{"Too short", "A", "", ErrInvalidRole},
+		{"Empty", "  ", "", ErrEmptyRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRoleName(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoleCatalog(t *testing.T) {
+	cashier := Role("CASHIER")
+	system := SystemRoleCatalog()
+	catalog := system.With(cashier, []Permission{PermOrdersRead, PermOrdersWrite})
+
+	t.Run("Resolve", func(t *testing.T) {
+		role, err := catalog.Resolve("cashier")
+		assert.NoError(t, err)
+		assert.Equal(t, cashier, role)
+
+		_, err = system.Resolve("cashier")
+		assert.ErrorIs(t, err, ErrInvalidRole)
+	})
+
+	t.Run("With does not mutate the receiver", func(t *testing.T) {
+		assert.False(t, system.Has(cashier))
+		assert.True(t, catalog.Has(cashier))
+	})
+
+	t.Run("System roles cannot be redefined", func(t *testing.T) {
+		redefined := catalog.With(AdminRole, []Permission{PermOrdersRead})
+		assert.True(t, redefined.GrantsOf(AdminRole).Has(PermRolesManage))
+	})
+
+	t.Run("Roles lists system roles first", func(t *testing.T) {
+		assert.Equal(t, AllRoles(), system.Roles())
+		assert.Equal(t, append(AllRoles(), cashier), catalog.Roles())
+	})
+
+	t.Run("GrantsOf", func(t *testing.T) {
+		admin := catalog.GrantsOf(AdminRole)
+		manager := catalog.GrantsOf(ManagerRole)
+		employee := catalog.GrantsOf(EmployeeRole)
+
+		for _, permission := range AllPermissions() {
+			assert.True(t, admin.Has(permission), permission)
+		}
+
+		assert.True(t, manager.Has(PermUsersRead))
+		assert.False(t, manager.Has(PermAPIKeysManage))
+		assert.False(t, employee.Has(PermUsersRead))
+		assert.True(t, catalog.GrantsOf(cashier).Has(PermOrdersWrite))
+		assert.Empty(t, catalog.GrantsOf(Role("UNKNOWN")))
+		assert.Empty(t, catalog.GrantsOf())
+
+		assert.True(t, admin.Covers(manager))
+		assert.True(t, manager.Covers(employee))
+		assert.False(t, manager.Covers(admin))
+		assert.False(t, employee.Covers(manager))
+		assert.True(t, catalog.GrantsOf(ManagerRole, EmployeeRole).Covers(manager))
+	})
+}
@@ -2,6 +2,7 @@ package vo
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 )
 
@@ -10,6 +11,10 @@ var (
 	ErrEmptyRole   = errors.New("empty role")
 )
 
+var roleNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,31}$`)
+
+// Role names a bundle of permissions. The system roles below always exist;
+// stores add their own through the role catalog.
 type Role string
 
 const (
@@ -18,25 +23,33 @@ const (
 	ManagerRole  Role = "MANAGER"
 )
 
+// NewRole accepts only the system roles. Anything that may name a custom
+// role must be resolved against a RoleCatalog instead.
 func NewRole(value string) (Role, error) {
+	return SystemRoleCatalog().Resolve(value)
+}
+
+// ParseRoleName checks only that value is a well-formed role name, for roles
+// that were already validated when they were stored or signed.
+func ParseRoleName(value string) (Role, error) {
 	normalizedValue := Role(strings.TrimSpace(strings.ToUpper(value)))
 
 	if normalizedValue == "" {
 		return "", ErrEmptyRole
 	}
 
-	switch normalizedValue {
-	case EmployeeRole, AdminRole, ManagerRole:
-		return normalizedValue, nil
-	default:
+	if !roleNamePattern.MatchString(normalizedValue.String()) {
 		return "", ErrInvalidRole
 	}
+
+	return normalizedValue, nil
 }
 
 func (r Role) String() string {
 	return string(r)
 }
 
+// AllRoles returns the system roles.
 func AllRoles() []Role {
 	return []Role{
 		EmployeeRole,
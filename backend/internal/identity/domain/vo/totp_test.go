@@ -0,0 +1,77 @@
+package vo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// RFC 6238 appendix B secret ("12345678901234567890") in base32
+const rfcSecret = TOTPSecret("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+
+func TestTOTPSecret_Code(t *testing.T) {
+	tests := []struct {
+		name string
+		unix int64
+		want string
+	}{
+		{"T=59", 59, "287082"},
+		{"T=1111111109", 1111111109, "081804"},
+		{"T=1234567890", 1234567890, "005924"},
+		{"T=2000000000", 2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rfcSecret.Code(TOTPStep(time.Unix(tt.unix, 0))))
+		})
+	}
+}
+
+func TestTOTPSecret_Verify(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	step := TOTPStep(now)
+
+	t.Run("Current step", func(t *testing.T) {
+		matched, ok := rfcSecret.Verify(rfcSecret.Code(step), now)
+		assert.True(t, ok)
+		assert.Equal(t, step, matched)
+	})
+
+	t.Run("Previous step within skew", func(t *testing.T) {
+		matched, ok := rfcSecret.Verify(rfcSecret.Code(step-1), now)
+		assert.True(t, ok)
+		assert.Equal(t, step-1, matched)
+	})
+
+	t.Run("Outside skew window", func(t *testing.T) {
+		_, ok := rfcSecret.Verify(rfcSecret.Code(step-3), now)
+		assert.False(t, ok)
+	})
+
+	t.Run("Malformed code", func(t *testing.T) {
+		_, ok := rfcSecret.Verify("12345", now)
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	assert.NoError(t, err)
+
+	_, err = RestoreTOTPSecret(secret.String())
+	assert.NoError(t, err)
+
+	_, err = RestoreTOTPSecret("not base32!")
+	assert.ErrorIs(t, err, ErrInvalidTOTPSecret)
+}
+
+func TestTOTPSecret_ProvisioningURI(t *testing.T) {
+	uri := rfcSecret.ProvisioningURI("Store Manager", "test@example.com")
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Store%20Manager:test@example.com?"))
+	assert.Contains(t, uri, "secret="+rfcSecret.String())
+	assert.Contains(t, uri, "issuer=Store+Manager")
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type APIKeyModel struct {
+	bun.BaseModel `bun:"table:api_keys"`
+
+	ID         uuid.UUID  `bun:"id,pk,type:uuid"`
+	Name       string     `bun:"name,notnull"`
+	StoreID    uuid.UUID  `bun:"store_id,type:uuid,notnull"`
+	Scopes     []string   `bun:"scopes,array,notnull"`
+	Prefix     string     `bun:"prefix,notnull"`
+	Hash       string     `bun:"hash,notnull,unique"`
+	CreatedBy  uuid.UUID  `bun:"created_by,type:uuid,notnull"`
+	CreatedAt  time.Time  `bun:"created_at,notnull"`
+	ExpiresAt  *time.Time `bun:"expires_at,nullzero"`
+	LastUsedAt *time.Time `bun:"last_used_at,nullzero"`
+	RevokedAt  *time.Time `bun:"revoked_at,nullzero"`
+}
+
+func ToAPIKeyModel(k *entity.APIKey) *APIKeyModel {
+	scopes := make([]string, 0, len(k.Scopes()))
+	for _, scope := range k.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	return &APIKeyModel{
+		ID:         k.ID(),
+		Name:       k.Name(),
+		StoreID:    k.StoreID(),
+		Scopes:     scopes,
+		Prefix:     k.Prefix(),
+		Hash:       k.Hash(),
+		CreatedBy:  k.CreatedBy(),
+		CreatedAt:  k.CreatedAt(),
+		ExpiresAt:  k.ExpiresAt(),
+		LastUsedAt: k.LastUsedAt(),
+		RevokedAt:  k.RevokedAt(),
+	}
+}
+
+func ToAPIKeyEntity(m *APIKeyModel) (*entity.APIKey, error) {
+	return entity.RestoreAPIKey(
+		m.ID,
+		m.Name,
+		m.StoreID,
+		m.Scopes,
+		m.Prefix,
+		m.Hash,
+		m.CreatedBy,
+		m.CreatedAt,
+		m.ExpiresAt,
+		m.LastUsedAt,
+		m.RevokedAt,
+	)
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AuditEventModel struct {
+	bun.BaseModel `bun:"table:audit_events"`
+
+	ID             uuid.UUID                     `bun:"id,pk,type:uuid"`
+	Action         string                        `bun:"action,notnull"`
+	ActorID        *uuid.UUID                    `bun:"actor_id,type:uuid"`
+	TargetID       *uuid.UUID                    `bun:"target_id,type:uuid"`
+	ImpersonatorID *uuid.UUID                    `bun:"impersonator_id,type:uuid"`
+	IPAddress      string                        `bun:"ip_address,nullzero"`
+	UserAgent      string                        `bun:"user_agent,nullzero"`
+	Changes        map[string]entity.AuditChange `bun:"changes,type:jsonb,notnull"`
+	OccurredAt     time.Time                     `bun:"occurred_at,notnull"`
+}
+
+func ToAuditEventModel(e *entity.AuditEvent) *AuditEventModel {
+	return &AuditEventModel{
+		ID:             e.ID(),
+		Action:         e.Action().String(),
+		ActorID:        e.ActorID(),
+		TargetID:       e.TargetID(),
+		ImpersonatorID: e.ImpersonatorID(),
+		IPAddress:      e.IPAddress(),
+		UserAgent:      e.UserAgent(),
+		Changes:        e.Changes(),
+		OccurredAt:     e.OccurredAt(),
+	}
+}
+
+func ToAuditEventEntity(m *AuditEventModel) (*entity.AuditEvent, error) {
+	return entity.RestoreAuditEvent(
+		m.ID,
+		m.Action,
+		m.ActorID,
+		m.TargetID,
+		m.ImpersonatorID,
+		m.IPAddress,
+		m.UserAgent,
+		m.Changes,
+		m.OccurredAt,
+	)
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type InvitationModel struct {
+	bun.BaseModel `bun:"table:invitations"`
+
+	ID         uuid.UUID  `bun:"id,pk,type:uuid"`
+	Email      string     `bun:"email,notnull"`
+	StoreID    uuid.UUID  `bun:"store_id,type:uuid,notnull"`
+	Roles      []string   `bun:"roles,array,notnull"`
+	TokenHash  string     `bun:"token_hash,notnull,unique"`
+	InvitedBy  uuid.UUID  `bun:"invited_by,type:uuid,notnull"`
+	CreatedAt  time.Time  `bun:"created_at,notnull"`
+	ExpiresAt  time.Time  `bun:"expires_at,notnull"`
+	AcceptedAt *time.Time `bun:"accepted_at"`
+	RevokedAt  *time.Time `bun:"revoked_at"`
+}
+
+func ToInvitationModel(i *entity.Invitation) *InvitationModel {
+	roles := make([]string, 0, len(i.Roles()))
+	for _, role := range i.Roles() {
+		roles = append(roles, role.String())
+	}
+
+	return &InvitationModel{
+		ID:         i.ID(),
+		Email:      i.Email().String(),
+		StoreID:    i.StoreID(),
+		Roles:      roles,
+		TokenHash:  i.TokenHash(),
+		InvitedBy:  i.InvitedBy(),
+		CreatedAt:  i.CreatedAt(),
+		ExpiresAt:  i.ExpiresAt(),
+		AcceptedAt: i.AcceptedAt(),
+		RevokedAt:  i.RevokedAt(),
+	}
+}
+
+func ToInvitationEntity(m *InvitationModel, catalog vo.RoleCatalog) (*entity.Invitation, error) {
+	return entity.RestoreInvitation(
+		m.ID,
+		m.Email,
+		m.StoreID,
+		m.Roles,
+		m.TokenHash,
+		m.InvitedBy,
+		m.CreatedAt,
+		m.ExpiresAt,
+		m.AcceptedAt,
+		m.RevokedAt,
+		catalog,
+	)
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type LoginAttemptModel struct {
+	bun.BaseModel `bun:"table:login_history"`
+
+	ID         uuid.UUID `bun:"id,pk,type:uuid"`
+	UserID     uuid.UUID `bun:"user_id,type:uuid,notnull"`
+	Outcome    string    `bun:"outcome,notnull"`
+	IPAddress  string    `bun:"ip_address,nullzero"`
+	UserAgent  string    `bun:"user_agent,nullzero"`
+	Device     string    `bun:"device,notnull"`
+	OccurredAt time.Time `bun:"occurred_at,notnull"`
+}
+
+func ToLoginAttemptModel(a *entity.LoginAttempt) *LoginAttemptModel {
+	return &LoginAttemptModel{
+		ID:         a.ID(),
+		UserID:     a.UserID(),
+		Outcome:    a.Outcome().String(),
+		IPAddress:  a.IPAddress(),
+		UserAgent:  a.UserAgent(),
+		Device:     a.Device().String(),
+		OccurredAt: a.OccurredAt(),
+	}
+}
+
+func ToLoginAttemptEntity(m *LoginAttemptModel) (*entity.LoginAttempt, error) {
+	return entity.RestoreLoginAttempt(
+		m.ID,
+		m.UserID,
+		m.Outcome,
+		m.IPAddress,
+		m.UserAgent,
+		m.Device,
+		m.OccurredAt,
+	)
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type PasswordHistoryModel struct {
+	bun.BaseModel `bun:"table:password_history"`
+
+	ID        int64     `bun:"id,pk,autoincrement"`
+	UserID    uuid.UUID `bun:"user_id,type:uuid,notnull"`
+	Password  string    `bun:"password,notnull"`
+	CreatedAt time.Time `bun:"created_at,notnull"`
+}
+
+type StorePasswordPolicyModel struct {
+	bun.BaseModel `bun:"table:store_password_policies"`
+
+	StoreID     uuid.UUID `bun:"store_id,pk,type:uuid"`
+	HistorySize int       `bun:"history_size,notnull"`
+	UpdatedAt   time.Time `bun:"updated_at,notnull"`
+}
+
+func ToStorePasswordPolicyModel(p *entity.StorePasswordPolicy) *StorePasswordPolicyModel {
+	return &StorePasswordPolicyModel{
+		StoreID:     p.StoreID(),
+		HistorySize: p.HistorySize(),
+		UpdatedAt:   p.UpdatedAt(),
+	}
+}
+
+func ToStorePasswordPolicyEntity(m *StorePasswordPolicyModel) *entity.StorePasswordPolicy {
+	return entity.RestoreStorePasswordPolicy(m.StoreID, m.HistorySize, m.UpdatedAt)
+}
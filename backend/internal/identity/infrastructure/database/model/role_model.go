@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type RoleModel struct {
+	bun.BaseModel `bun:"table:roles"`
+
+	Name        string     `bun:"name,pk"`
+	StoreID     *uuid.UUID `bun:"store_id,type:uuid,nullzero"`
+	Description string     `bun:"description,notnull"`
+	Permissions []string   `bun:"permissions,array,notnull"`
+	System      bool       `bun:"system,notnull"`
+	CreatedAt   time.Time  `bun:"created_at,notnull"`
+	UpdatedAt   time.Time  `bun:"updated_at,notnull"`
+}
+
+func ToRoleModel(r *entity.Role) *RoleModel {
+	permissions := make([]string, 0, len(r.Permissions()))
+	for _, permission := range r.Permissions() {
+		permissions = append(permissions, permission.String())
+	}
+
+	return &RoleModel{
+		Name:        r.Name().String(),
+		StoreID:     r.StoreID(),
+		Description: r.Description(),
+		Permissions: permissions,
+		System:      r.IsSystem(),
+		CreatedAt:   r.CreatedAt(),
+		UpdatedAt:   r.UpdatedAt(),
+	}
+}
+
+func ToRoleEntity(m *RoleModel) (*entity.Role, error) {
+	return entity.RestoreRole(
+		m.Name,
+		m.StoreID,
+		m.Description,
+		m.Permissions,
+		m.System,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/uptrace/bun"
+)
+
+type SigningKeyModel struct {
+	bun.BaseModel `bun:"table:signing_keys"`
+
+	ID         string     `bun:"kid,pk"`
+	Algorithm  string     `bun:"algorithm,notnull"`
+	PrivateKey []byte     `bun:"private_key,type:bytea,notnull"`
+	Encrypted  bool       `bun:"encrypted,notnull"`
+	CreatedAt  time.Time  `bun:"created_at,notnull"`
+	RetiredAt  *time.Time `bun:"retired_at,nullzero"`
+}
+
+func ToSigningKeyModel(k *entity.SigningKey) *SigningKeyModel {
+	return &SigningKeyModel{
+		ID:         k.ID(),
+		Algorithm:  k.Algorithm(),
+		PrivateKey: k.PrivateKey(),
+		CreatedAt:  k.CreatedAt(),
+		RetiredAt:  k.RetiredAt(),
+	}
+}
+
+func ToSigningKeyEntity(m *SigningKeyModel) *entity.SigningKey {
+	return entity.RestoreSigningKey(
+		m.ID,
+		m.Algorithm,
+		m.PrivateKey,
+		m.CreatedAt,
+		m.RetiredAt,
+	)
+}
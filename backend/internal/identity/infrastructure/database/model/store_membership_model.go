@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type StoreMembershipModel struct {
+	bun.BaseModel `bun:"table:store_memberships"`
+
+	UserID    uuid.UUID `bun:"user_id,pk,type:uuid"`
+	StoreID   uuid.UUID `bun:"store_id,pk,type:uuid"`
+	Roles     []string  `bun:"roles,array,notnull"`
+	Status    string    `bun:"status,notnull"`
+	CreatedAt time.Time `bun:"created_at,notnull"`
+	UpdatedAt time.Time `bun:"updated_at,notnull"`
+}
+
+func ToStoreMembershipModel(m *entity.StoreMembership) *StoreMembershipModel {
+	roles := make([]string, 0, len(m.Roles()))
+	for _, role := range m.Roles() {
+		roles = append(roles, role.String())
+	}
+
+	return &StoreMembershipModel{
+		UserID:    m.UserID(),
+		StoreID:   m.StoreID(),
+		Roles:     roles,
+		Status:    m.Status().String(),
+		CreatedAt: m.CreatedAt(),
+		UpdatedAt: m.UpdatedAt(),
+	}
+}
+
+func ToStoreMembershipEntity(m *StoreMembershipModel, catalog vo.RoleCatalog) (*entity.StoreMembership, error) {
+	return entity.RestoreStoreMembership(
+		m.UserID,
+		m.StoreID,
+		m.Roles,
+		m.Status,
+		m.CreatedAt,
+		m.UpdatedAt,
+		catalog,
+	)
+}
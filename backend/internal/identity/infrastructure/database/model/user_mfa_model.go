@@ -0,0 +1,43 @@
+package model
+
+import (
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type UserMFAModel struct {
+	bun.BaseModel `bun:"table:user_mfa"`
+
+	UserID        uuid.UUID `bun:"user_id,pk,type:uuid"`
+	Secret        string    `bun:"secret,notnull"`
+	Enabled       bool      `bun:"enabled,notnull"`
+	RecoveryCodes []string  `bun:"recovery_codes,array,notnull"`
+	LastUsedStep  int64     `bun:"last_used_step,notnull"`
+}
+
+type MFARolePolicyModel struct {
+	bun.BaseModel `bun:"table:mfa_role_policies"`
+
+	Role string `bun:"role,pk"`
+}
+
+func ToUserMFAModel(m *entity.UserMFA) *UserMFAModel {
+	return &UserMFAModel{
+		UserID:        m.UserID(),
+		Secret:        m.Secret().String(),
+		Enabled:       m.IsEnabled(),
+		RecoveryCodes: m.RecoveryCodes(),
+		LastUsedStep:  m.LastUsedStep(),
+	}
+}
+
+func ToUserMFAEntity(m *UserMFAModel) (*entity.UserMFA, error) {
+	return entity.RestoreUserMFA(
+		m.UserID,
+		m.Secret,
+		m.Enabled,
+		m.RecoveryCodes,
+		m.LastUsedStep,
+	)
+}
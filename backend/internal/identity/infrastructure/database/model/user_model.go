@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/google/uuid"
 )
 
@@ -17,6 +18,8 @@ type UserModel struct {
 	FailedAttempts int        `bun:"failed_attempts,notnull"`
 	LockedUntil    *time.Time `bun:"locked_until"`
 	EmailVerified  bool       `bun:"email_verified,notnull"`
+	TokenVersion   int        `bun:"token_version,notnull"`
+	DeletedAt      *time.Time `bun:"deleted_at"`
 }
 
 func ToModel(u *entity.User) *UserModel {
@@ -35,10 +38,12 @@ func ToModel(u *entity.User) *UserModel {
 		FailedAttempts: u.FailedAttempts(),
 		LockedUntil:    u.LockedUntil(),
 		EmailVerified:  u.EmailVerified(),
+		TokenVersion:   u.TokenVersion(),
+		DeletedAt:      u.DeletedAt(),
 	}
 }
 
-func ToEntity(m *UserModel) (*entity.User, error) {
+func ToEntity(m *UserModel, catalog vo.RoleCatalog) (*entity.User, error) {
 	return entity.RestoreUser(
 		m.ID,
 		m.Email,
@@ -49,5 +54,8 @@ func ToEntity(m *UserModel) (*entity.User, error) {
 		m.FailedAttempts,
 		m.LockedUntil,
 		m.EmailVerified,
+		m.TokenVersion,
+		m.DeletedAt,
+		catalog,
 	)
 }
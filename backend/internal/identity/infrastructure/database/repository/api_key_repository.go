@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type apiKeyRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewAPIKeyRepository(db *bun.DB) ports.APIKeyRepository {
+	return &apiKeyRepositoryImpl{db: db}
+}
+
+func (r *apiKeyRepositoryImpl) Save(ctx context.Context, key *entity.APIKey) error {
+	keyModel := model.ToAPIKeyModel(key)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().Model(keyModel).Exec(ctx)
+	return err
+}
+
+func (r *apiKeyRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.APIKey, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *apiKeyRepositoryImpl) FindByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	return r.findOne(ctx, "hash = ?", hash)
+}
+
+func (r *apiKeyRepositoryImpl) List(ctx context.Context, storeID *uuid.UUID) ([]*entity.APIKey, error) {
+	var keyModels []model.APIKeyModel
+
+	db := database.GetDB(ctx, r.db)
+
+	query := db.NewSelect().Model(&keyModels)
+	if storeID != nil {
+		query = query.Where("store_id = ?", *storeID)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	keys := make([]*entity.APIKey, 0, len(keyModels))
+	for i := range keyModels {
+		key, err := model.ToAPIKeyEntity(&keyModels[i])
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (r *apiKeyRepositoryImpl) UpdateRevokedAt(ctx context.Context, key *entity.APIKey) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewUpdate().
+		Model((*model.APIKeyModel)(nil)).
+		Set("revoked_at = ?", key.RevokedAt()).
+		Where("id = ?", key.ID()).
+		Exec(ctx)
+	return err
+}
+
+func (r *apiKeyRepositoryImpl) UpdateLastUsedAt(ctx context.Context, key *entity.APIKey) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewUpdate().
+		Model((*model.APIKeyModel)(nil)).
+		Set("last_used_at = ?", key.LastUsedAt()).
+		Where("id = ?", key.ID()).
+		Exec(ctx)
+	return err
+}
+
+func (r *apiKeyRepositoryImpl) findOne(ctx context.Context, where string, arg any) (*entity.APIKey, error) {
+	keyModel := new(model.APIKeyModel)
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(keyModel).
+		Where(where, arg).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return model.ToAPIKeyEntity(keyModel)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/uptrace/bun"
+)
+
+type auditEventRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewAuditEventRepository(db *bun.DB) ports.AuditEventRepository {
+	return &auditEventRepositoryImpl{db: db}
+}
+
+func (r *auditEventRepositoryImpl) Append(ctx context.Context, event *entity.AuditEvent) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().
+		Model(model.ToAuditEventModel(event)).
+		Exec(ctx)
+
+	return err
+}
+
+func (r *auditEventRepositoryImpl) List(ctx context.Context, filter dto.AuditFilter, pagination common.Pagination) (*common.PaginatedResult[*entity.AuditEvent], error) {
+	var models []model.AuditEventModel
+
+	db := database.GetDB(ctx, r.db)
+
+	query := db.NewSelect().Model(&models)
+
+	if filter.ActorID != nil {
+		query.Where("actor_id = ?", *filter.ActorID)
+	}
+
+	if filter.TargetID != nil {
+		query.Where("target_id = ?", *filter.TargetID)
+	}
+
+	if filter.Action != nil {
+		query.Where("action = ?", filter.Action.String())
+	}
+
+	if filter.From != nil {
+		query.Where("occurred_at >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query.Where("occurred_at < ?", *filter.To)
+	}
+
+	total, err := query.
+		Order("occurred_at DESC").
+		Limit(pagination.GetLimit()).
+		Offset(pagination.GetOffset()).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*entity.AuditEvent, 0, len(models))
+	for i := range models {
+		event, err := model.ToAuditEventEntity(&models[i])
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return common.NewPaginatedResult(events, int64(total), pagination), nil
+}
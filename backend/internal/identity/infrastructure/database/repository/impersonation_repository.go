@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	impersonationImpersonatorField = "impersonator_id"
+	impersonationUserField         = "user_id"
+	impersonationStoreField        = "store_id"
+	impersonationReasonField       = "reason"
+	impersonationStartedAtField    = "started_at"
+	impersonationExpiresAtField    = "expires_at"
+)
+
+type impersonationRepository struct {
+	client *redis.Client
+}
+
+func NewImpersonationRepository(client *redis.Client) ports.ImpersonationRepository {
+	return &impersonationRepository{
+		client: client,
+	}
+}
+
+func (r *impersonationRepository) getKey(id uuid.UUID) string {
+	return fmt.Sprintf("impersonation:%s", id.String())
+}
+
+// Save expires the key together with the impersonation, so an impersonation
+// that is never ended leaves nothing behind.
+func (r *impersonationRepository) Save(ctx context.Context, impersonation *entity.Impersonation) error {
+	key := r.getKey(impersonation.ID())
+
+	storeID := ""
+	if impersonation.StoreID() != nil {
+		storeID = impersonation.StoreID().String()
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key,
+		impersonationImpersonatorField, impersonation.ImpersonatorID().String(),
+		impersonationUserField, impersonation.UserID().String(),
+		impersonationStoreField, storeID,
+		impersonationReasonField, impersonation.Reason(),
+		impersonationStartedAtField, impersonation.StartedAt().Unix(),
+		impersonationExpiresAtField, impersonation.ExpiresAt().Unix(),
+	)
+	pipe.ExpireAt(ctx, key, impersonation.ExpiresAt())
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *impersonationRepository) Find(ctx context.Context, id uuid.UUID) (*entity.Impersonation, error) {
+	fields, err := r.client.HGetAll(ctx, r.getKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, entity.ErrImpersonationNotFound
+	}
+
+	impersonatorID, err := uuid.Parse(fields[impersonationImpersonatorField])
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(fields[impersonationUserField])
+	if err != nil {
+		return nil, err
+	}
+
+	var storeID *uuid.UUID
+	if raw := fields[impersonationStoreField]; raw != "" {
+		parsed, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		storeID = &parsed
+	}
+
+	startedAt, err := strconv.ParseInt(fields[impersonationStartedAtField], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := strconv.ParseInt(fields[impersonationExpiresAtField], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.RestoreImpersonation(
+		id,
+		impersonatorID,
+		userID,
+		storeID,
+		fields[impersonationReasonField],
+		time.Unix(startedAt, 0),
+		time.Unix(expiresAt, 0),
+	), nil
+}
+
+func (r *impersonationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.client.Del(ctx, r.getKey(id)).Result()
+	if err != nil {
+		return err
+	}
+
+	// Ended by someone else, or expired, between lookup and delete.
+	if result == 0 {
+		return entity.ErrImpersonationNotFound
+	}
+
+	return nil
+}
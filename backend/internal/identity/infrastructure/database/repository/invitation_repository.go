@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type invitationRepositoryImpl struct {
+	db    *bun.DB
+	roles ports.RoleCatalogProvider
+}
+
+func NewInvitationRepository(db *bun.DB, roles ports.RoleCatalogProvider) ports.InvitationRepository {
+	return &invitationRepositoryImpl{db: db, roles: roles}
+}
+
+func (r *invitationRepositoryImpl) Save(ctx context.Context, invitation *entity.Invitation) error {
+	invitationModel := model.ToInvitationModel(invitation)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().Model(invitationModel).Exec(ctx)
+	return err
+}
+
+func (r *invitationRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Invitation, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *invitationRepositoryImpl) FindByTokenHash(ctx context.Context, hash string) (*entity.Invitation, error) {
+	return r.findOne(ctx, "token_hash = ?", hash)
+}
+
+func (r *invitationRepositoryImpl) findOne(ctx context.Context, where string, arg any) (*entity.Invitation, error) {
+	invitationModel := new(model.InvitationModel)
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(invitationModel).
+		Where(where, arg).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	catalog, err := r.roles.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToInvitationEntity(invitationModel, catalog)
+}
+
+func (r *invitationRepositoryImpl) ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.Invitation, error) {
+	return r.list(ctx, "store_id = ?", storeID)
+}
+
+func (r *invitationRepositoryImpl) ListByEmail(ctx context.Context, email vo.Email) ([]*entity.Invitation, error) {
+	return r.list(ctx, "email = ?", email)
+}
+
+func (r *invitationRepositoryImpl) list(ctx context.Context, where string, arg any) ([]*entity.Invitation, error) {
+	var invitationModels []model.InvitationModel
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(&invitationModels).
+		Where(where, arg).
+		Order("created_at DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := r.roles.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*entity.Invitation, 0, len(invitationModels))
+	for i := range invitationModels {
+		invitation, err := model.ToInvitationEntity(&invitationModels[i], catalog)
+		if err != nil {
+			return nil, err
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, nil
+}
+
+func (r *invitationRepositoryImpl) RedactEmail(ctx context.Context, email vo.Email, replacement vo.Email) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewUpdate().
+		Model((*model.InvitationModel)(nil)).
+		Set("email = ?", replacement).
+		Where("email = ?", email).
+		Exec(ctx)
+	return err
+}
+
+func (r *invitationRepositoryImpl) UpdateAcceptedAt(ctx context.Context, invitation *entity.Invitation) error {
+	db := database.GetDB(ctx, r.db)
+
+	res, err := db.NewUpdate().
+		Model((*model.InvitationModel)(nil)).
+		Set("accepted_at = ?", invitation.AcceptedAt()).
+		Where("id = ?", invitation.ID()).
+		Where("accepted_at IS NULL").
+		Where("revoked_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return entity.ErrInvalidInvitation
+	}
+
+	return nil
+}
+
+func (r *invitationRepositoryImpl) UpdateRevokedAt(ctx context.Context, invitation *entity.Invitation) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewUpdate().
+		Model((*model.InvitationModel)(nil)).
+		Set("revoked_at = ?", invitation.RevokedAt()).
+		Where("id = ?", invitation.ID()).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type loginHistoryRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewLoginHistoryRepository(db *bun.DB) ports.LoginHistoryRepository {
+	return &loginHistoryRepositoryImpl{db: db}
+}
+
+func (r *loginHistoryRepositoryImpl) Append(ctx context.Context, attempt *entity.LoginAttempt) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().
+		Model(model.ToLoginAttemptModel(attempt)).
+		Exec(ctx)
+
+	return err
+}
+
+func (r *loginHistoryRepositoryImpl) List(ctx context.Context, userID uuid.UUID, pagination common.Pagination) (*common.PaginatedResult[*entity.LoginAttempt], error) {
+	var models []model.LoginAttemptModel
+
+	db := database.GetDB(ctx, r.db)
+
+	total, err := db.NewSelect().
+		Model(&models).
+		Where("user_id = ?", userID).
+		Order("occurred_at DESC").
+		Limit(pagination.GetLimit()).
+		Offset(pagination.GetOffset()).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*entity.LoginAttempt, 0, len(models))
+	for i := range models {
+		attempt, err := model.ToLoginAttemptEntity(&models[i])
+		if err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	return common.NewPaginatedResult(attempts, int64(total), pagination), nil
+}
+
+func (r *loginHistoryRepositoryImpl) SeenFrom(ctx context.Context, userID uuid.UUID, device vo.DeviceFingerprint, ipAddress string, since time.Time) (bool, error) {
+	db := database.GetDB(ctx, r.db)
+
+	return db.NewSelect().
+		Model((*model.LoginAttemptModel)(nil)).
+		Where("user_id = ?", userID).
+		Where("outcome = ?", vo.LoginSucceeded.String()).
+		Where("device = ?", device.String()).
+		Where("ip_address = ?", ipAddress).
+		Where("occurred_at >= ?", since).
+		Exists(ctx)
+}
+
+func (r *loginHistoryRepositoryImpl) HasSucceeded(ctx context.Context, userID uuid.UUID) (bool, error) {
+	db := database.GetDB(ctx, r.db)
+
+	return db.NewSelect().
+		Model((*model.LoginAttemptModel)(nil)).
+		Where("user_id = ?", userID).
+		Where("outcome = ?", vo.LoginSucceeded.String()).
+		Exists(ctx)
+}
+
+func (r *loginHistoryRepositoryImpl) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewDelete().
+		Model((*model.LoginAttemptModel)(nil)).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+
+	return err
+}
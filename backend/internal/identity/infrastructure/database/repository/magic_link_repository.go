@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type magicLinkRepository struct {
+	client *redis.Client
+}
+
+func NewMagicLinkRepository(client *redis.Client) ports.MagicLinkRepository {
+	return &magicLinkRepository{
+		client: client,
+	}
+}
+
+func (r *magicLinkRepository) getKey(linkID uuid.UUID) string {
+	return fmt.Sprintf("magic_link:%s", linkID.String())
+}
+
+func (r *magicLinkRepository) Save(ctx context.Context, linkID uuid.UUID, userID uuid.UUID, expiresIn time.Duration) error {
+	return r.client.Set(ctx, r.getKey(linkID), userID.String(), expiresIn).Err()
+}
+
+func (r *magicLinkRepository) Consume(ctx context.Context, linkID uuid.UUID) (uuid.UUID, error) {
+	result, err := r.client.GetDel(ctx, r.getKey(linkID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return uuid.Nil, entity.ErrInvalidMagicLink
+		}
+
+		return uuid.Nil, err
+	}
+
+	return uuid.Parse(result)
+}
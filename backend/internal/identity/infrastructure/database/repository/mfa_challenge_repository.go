@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type mfaChallengeRepository struct {
+	client *redis.Client
+}
+
+func NewMFAChallengeRepository(client *redis.Client) ports.MFAChallengeRepository {
+	return &mfaChallengeRepository{
+		client: client,
+	}
+}
+
+func (r *mfaChallengeRepository) getKey(challenge string) string {
+	return fmt.Sprintf("mfa_challenge:%s", challenge)
+}
+
+func (r *mfaChallengeRepository) SaveChallenge(ctx context.Context, challenge string, userID uuid.UUID, expiresIn time.Duration) error {
+	return r.client.Set(ctx, r.getKey(challenge), userID.String(), expiresIn).Err()
+}
+
+func (r *mfaChallengeRepository) GetUserIDByChallenge(ctx context.Context, challenge string) (uuid.UUID, error) {
+	result, err := r.client.Get(ctx, r.getKey(challenge)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return uuid.Nil, entity.ErrMFAChallengeNotFound
+		}
+
+		return uuid.Nil, err
+	}
+
+	return uuid.Parse(result)
+}
+
+func (r *mfaChallengeRepository) DeleteChallenge(ctx context.Context, challenge string) error {
+	result, err := r.client.Del(ctx, r.getKey(challenge)).Result()
+	if err != nil {
+		return err
+	}
+
+	// Someone else consumed the challenge between lookup and delete.
+	if result == 0 {
+		return entity.ErrMFAChallengeNotFound
+	}
+
+	return nil
+}
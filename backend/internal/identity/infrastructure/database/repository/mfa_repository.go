@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type mfaRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewMFARepository(db *bun.DB) ports.MFARepository {
+	return &mfaRepositoryImpl{db: db}
+}
+
+func (r *mfaRepositoryImpl) FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error) {
+	mfaModel := new(model.UserMFAModel)
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(mfaModel).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return model.ToUserMFAEntity(mfaModel)
+}
+
+func (r *mfaRepositoryImpl) Save(ctx context.Context, mfa *entity.UserMFA) error {
+	mfaModel := model.ToUserMFAModel(mfa)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().
+		Model(mfaModel).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("secret = EXCLUDED.secret").
+		Set("enabled = EXCLUDED.enabled").
+		Set("recovery_codes = EXCLUDED.recovery_codes").
+		Set("last_used_step = EXCLUDED.last_used_step").
+		Exec(ctx)
+	return err
+}
+
+func (r *mfaRepositoryImpl) Delete(ctx context.Context, userID uuid.UUID) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewDelete().
+		Model((*model.UserMFAModel)(nil)).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+	return err
+}
+
+type mfaPolicyRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewMFAPolicyRepository(db *bun.DB) ports.MFAPolicyRepository {
+	return &mfaPolicyRepositoryImpl{db: db}
+}
+
+func (r *mfaPolicyRepositoryImpl) GetRequiredRoles(ctx context.Context) ([]vo.Role, error) {
+	var policies []model.MFARolePolicyModel
+
+	db := database.GetDB(ctx, r.db)
+
+	if err := db.NewSelect().Model(&policies).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	roles := make([]vo.Role, 0, len(policies))
+	for _, policy := range policies {
+		role, err := vo.ParseRoleName(policy.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+func (r *mfaPolicyRepositoryImpl) SetRoleRequirement(ctx context.Context, role vo.Role, required bool) error {
+	db := database.GetDB(ctx, r.db)
+
+	if !required {
+		_, err := db.NewDelete().
+			Model((*model.MFARolePolicyModel)(nil)).
+			Where("role = ?", role.String()).
+			Exec(ctx)
+		return err
+	}
+
+	_, err := db.NewInsert().
+		Model(&model.MFARolePolicyModel{Role: role.String()}).
+		On("CONFLICT (role) DO NOTHING").
+		Exec(ctx)
+	return err
+}
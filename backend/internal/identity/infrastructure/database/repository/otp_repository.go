@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -13,41 +16,92 @@ import (
 )
 
 type otpRepository struct {
-	client *redis.Client
+	client  *redis.Client
+	hashKey []byte
 }
 
-func NewOtpRepository(client *redis.Client) ports.OTPRepository {
-	return &otpRepository{client: client}
+func NewOtpRepository(client *redis.Client, hashKey string) ports.OTPRepository {
+	return &otpRepository{
+		client:  client,
+		hashKey: []byte(hashKey),
+	}
 }
 
 func (o *otpRepository) getKey(email vo.Email) string {
 	return fmt.Sprintf("otp:%s", email.String())
 }
 
+func (o *otpRepository) getAttemptsKey(email vo.Email) string {
+	return fmt.Sprintf("otp_attempts:%s", email.String())
+}
+
+func (o *otpRepository) getCooldownKey(email vo.Email) string {
+	return fmt.Sprintf("otp_cooldown:%s", email.String())
+}
+
+// hash binds the code to the email so a leaked value can't be replayed
+// against another account, and the key keeps the 10^6 keyspace from being
+// brute-forced offline.
+func (o *otpRepository) hash(email vo.Email, otp vo.OTP) string {
+	mac := hmac.New(sha256.New, o.hashKey)
+	mac.Write([]byte(email.String() + ":" + otp.String()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 func (o *otpRepository) SaveOTP(ctx context.Context, email vo.Email, otp vo.OTP, expiresIn time.Duration) error {
-	return o.client.Set(ctx, o.getKey(email), otp.String(), expiresIn).Err()
+	pipe := o.client.TxPipeline()
+	pipe.Set(ctx, o.getKey(email), o.hash(email, otp), expiresIn)
+	pipe.Del(ctx, o.getAttemptsKey(email))
+
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
-func (o *otpRepository) GetOTP(ctx context.Context, email vo.Email) (vo.OTP, error) {
-	result, err := o.client.Get(ctx, o.getKey(email)).Result()
+func (o *otpRepository) VerifyOTP(ctx context.Context, email vo.Email, otp vo.OTP) (bool, error) {
+	stored, err := o.client.Get(ctx, o.getKey(email)).Result()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
-			return "", entity.ErrOTPNotFound
+			return false, entity.ErrOTPNotFound
 		}
 
-		return "", err
+		return false, err
 	}
 
-	otpVo, err := vo.NewOTP(result)
+	return hmac.Equal([]byte(stored), []byte(o.hash(email, otp))), nil
+}
+
+// incrementAttemptsScript counts an attempt against a pending code and
+// returns -1 when there is none. The counter expires with the code, so it
+// never outlives it.
+var incrementAttemptsScript = redis.NewScript(`
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl == -2 then
+	return -1
+end
+local attempts = redis.call("INCR", KEYS[2])
+if ttl > 0 then
+	redis.call("PEXPIRE", KEYS[2], ttl)
+end
+return attempts
+`)
+
+// IncrementOTPAttempts returns the count including this attempt, so callers
+// compare it instead of reading the counter separately.
+func (o *otpRepository) IncrementOTPAttempts(ctx context.Context, email vo.Email) (int, error) {
+	attempts, err := incrementAttemptsScript.Run(ctx, o.client, []string{o.getKey(email), o.getAttemptsKey(email)}).Int()
 	if err != nil {
-		return "", err
+		return 0, err
 	}
 
-	return otpVo, nil
+	if attempts < 0 {
+		return 0, entity.ErrOTPNotFound
+	}
+
+	return attempts, nil
 }
 
 func (o *otpRepository) DeleteOTP(ctx context.Context, email vo.Email) error {
-	result, err := o.client.Del(ctx, o.getKey(email)).Result()
+	result, err := o.client.Del(ctx, o.getKey(email), o.getAttemptsKey(email)).Result()
 	if err != nil {
 		return err
 	}
@@ -58,3 +112,9 @@ func (o *otpRepository) DeleteOTP(ctx context.Context, email vo.Email) error {
 
 	return nil
 }
+
+// StartOTPCooldown checks and starts the cooldown in a single SET NX, so
+// only one of several concurrent requests gets to issue a code.
+func (o *otpRepository) StartOTPCooldown(ctx context.Context, email vo.Email, duration time.Duration) (bool, error) {
+	return o.client.SetNX(ctx, o.getCooldownKey(email), "1", duration).Result()
+}
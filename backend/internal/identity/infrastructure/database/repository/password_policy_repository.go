@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type passwordHistoryRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewPasswordHistoryRepository(db *bun.DB) ports.PasswordHistoryRepository {
+	return &passwordHistoryRepositoryImpl{db: db}
+}
+
+func (r *passwordHistoryRepositoryImpl) Add(ctx context.Context, userID uuid.UUID, password vo.Password, keep int) error {
+	db := database.GetDB(ctx, r.db)
+
+	// bun reads a zero limit as no limit, so an empty history is cleared here
+	if keep <= 0 {
+		_, err := db.NewDelete().
+			Model((*model.PasswordHistoryModel)(nil)).
+			Where("user_id = ?", userID).
+			Exec(ctx)
+		return err
+	}
+
+	_, err := db.NewInsert().
+		Model(&model.PasswordHistoryModel{
+			UserID:    userID,
+			Password:  password.String(),
+			CreatedAt: time.Now(),
+		}).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	// ids grow with every insert, so they order the history even when two
+	// changes share a timestamp
+	_, err = db.NewDelete().
+		Model((*model.PasswordHistoryModel)(nil)).
+		Where("user_id = ?", userID).
+		Where("id NOT IN (?)", db.NewSelect().
+			Model((*model.PasswordHistoryModel)(nil)).
+			Column("id").
+			Where("user_id = ?", userID).
+			Order("id DESC").
+			Limit(keep)).
+		Exec(ctx)
+	return err
+}
+
+func (r *passwordHistoryRepositoryImpl) ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]vo.Password, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var historyModels []model.PasswordHistoryModel
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(&historyModels).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	passwords := make([]vo.Password, 0, len(historyModels))
+	for _, historyModel := range historyModels {
+		password, err := vo.RestorePassword(historyModel.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		passwords = append(passwords, password)
+	}
+
+	return passwords, nil
+}
+
+func (r *passwordHistoryRepositoryImpl) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewDelete().
+		Model((*model.PasswordHistoryModel)(nil)).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+	return err
+}
+
+type storePasswordPolicyRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewStorePasswordPolicyRepository(db *bun.DB) ports.StorePasswordPolicyRepository {
+	return &storePasswordPolicyRepositoryImpl{db: db}
+}
+
+func (r *storePasswordPolicyRepositoryImpl) Save(ctx context.Context, policy *entity.StorePasswordPolicy) error {
+	policyModel := model.ToStorePasswordPolicyModel(policy)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().
+		Model(policyModel).
+		On("CONFLICT (store_id) DO UPDATE").
+		Set("history_size = EXCLUDED.history_size").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	return err
+}
+
+func (r *storePasswordPolicyRepositoryImpl) Find(ctx context.Context, storeID uuid.UUID) (*entity.StorePasswordPolicy, error) {
+	policyModel := new(model.StorePasswordPolicyModel)
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(policyModel).
+		Where("store_id = ?", storeID).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return model.ToStorePasswordPolicyEntity(policyModel), nil
+}
+
+func (r *storePasswordPolicyRepositoryImpl) MaxHistorySizeForUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	db := database.GetDB(ctx, r.db)
+
+	var size int
+	err := db.NewRaw(
+		`SELECT COALESCE(MAX(p.history_size), 0)
+		FROM store_password_policies AS p
+		JOIN store_memberships AS m ON m.store_id = p.store_id
+		WHERE m.user_id = ? AND m.status = ?`,
+		userID, vo.MembershipActive.String(),
+	).Scan(ctx, &size)
+
+	return size, err
+}
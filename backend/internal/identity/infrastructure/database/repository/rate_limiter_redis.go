@@ -13,6 +13,7 @@ import (
 
 type redisRateLimiter struct {
 	client    *redis.Client
+	scope     string
 	limit     int
 	window    time.Duration
 	baseBlock time.Duration
@@ -27,7 +28,23 @@ func NewRedisLimiter(client *redis.Client, limit int, window, baseBlock time.Dur
 	}
 }
 
+// NewScopedRedisLimiter keeps its counters apart from the global limiter, so
+// an endpoint can have a tighter budget without consuming the shared one.
+func NewScopedRedisLimiter(client *redis.Client, scope string, limit int, window, baseBlock time.Duration) ports.RateLimiterRepository {
+	return &redisRateLimiter{
+		client:    client,
+		scope:     scope,
+		limit:     limit,
+		window:    window,
+		baseBlock: baseBlock,
+	}
+}
+
 func (r *redisRateLimiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	if r.scope != "" {
+		key = fmt.Sprintf("%s:%s", r.scope, key)
+	}
+
 	now := time.Now().UnixNano()
 	windowStart := now - r.window.Nanoseconds()
 
@@ -35,7 +52,7 @@ func (r *redisRateLimiter) Allow(ctx context.Context, key string) (bool, time.Du
 	blockKey := fmt.Sprintf("rate_limit_block:%s", key)
 	violationsKey := fmt.Sprintf("rate_limit_violation:%s", key)
 
-	remainingBlock, err := r.client.TTL(ctx, zsetKey).Result()
+	remainingBlock, err := r.client.TTL(ctx, blockKey).Result()
 	if err == nil && remainingBlock > 0 {
 		return false, remainingBlock, nil
 	}
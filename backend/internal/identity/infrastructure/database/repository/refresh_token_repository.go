@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
@@ -12,6 +13,22 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	refreshTokenUserField       = "user_id"
+	refreshTokenFamilyField     = "family_id"
+	refreshTokenParentField     = "parent"
+	refreshTokenGenerationField = "generation"
+	refreshTokenRotatedField    = "rotated"
+	refreshTokenVersionField    = "token_version"
+	refreshTokenStoreField      = "store_id"
+
+	sessionUserField      = "user_id"
+	sessionCreatedAtField = "created_at"
+	sessionLastUsedField  = "last_used_at"
+	sessionIPField        = "ip_address"
+	sessionUserAgentField = "user_agent"
+)
+
 type refreshTokenRepository struct {
 	client *redis.Client
 }
@@ -26,37 +43,272 @@ func (r *refreshTokenRepository) getKey(refreshToken string) string {
 	return fmt.Sprintf("refresh_token:%s", refreshToken)
 }
 
-func (r *refreshTokenRepository) SaveRefreshToken(ctx context.Context, userID uuid.UUID, refreshToken string, expiresIn time.Duration) error {
-	return r.client.Set(ctx, r.getKey(refreshToken), userID.String(), expiresIn).Err()
+func (r *refreshTokenRepository) getFamilyKey(familyID uuid.UUID) string {
+	return fmt.Sprintf("refresh_token_family:%s", familyID.String())
+}
+
+func (r *refreshTokenRepository) getSessionKey(familyID uuid.UUID) string {
+	return fmt.Sprintf("refresh_token_session:%s", familyID.String())
+}
+
+func (r *refreshTokenRepository) getUserKey(userID uuid.UUID) string {
+	return fmt.Sprintf("user_refresh_families:%s", userID.String())
+}
+
+func (r *refreshTokenRepository) SaveRefreshToken(ctx context.Context, refreshToken *entity.RefreshToken, expiresIn time.Duration) error {
+	key := r.getKey(refreshToken.Token())
+	familyKey := r.getFamilyKey(refreshToken.FamilyID())
+	userKey := r.getUserKey(refreshToken.UserID())
+
+	storeID := ""
+	if refreshToken.StoreID() != nil {
+		storeID = refreshToken.StoreID().String()
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key,
+		refreshTokenUserField, refreshToken.UserID().String(),
+		refreshTokenFamilyField, refreshToken.FamilyID().String(),
+		refreshTokenParentField, refreshToken.Parent(),
+		refreshTokenGenerationField, refreshToken.Generation(),
+		refreshTokenRotatedField, 0,
+		refreshTokenVersionField, refreshToken.TokenVersion(),
+		refreshTokenStoreField, storeID,
+	)
+	pipe.Expire(ctx, key, expiresIn)
+	pipe.SAdd(ctx, familyKey, refreshToken.Token())
+	pipe.Expire(ctx, familyKey, expiresIn)
+	pipe.SAdd(ctx, userKey, refreshToken.FamilyID().String())
+	pipe.Expire(ctx, userKey, expiresIn)
+
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
-func (r *refreshTokenRepository) GetUserIDByRefreshToken(ctx context.Context, refreshToken string) (uuid.UUID, error) {
-	result, err := r.client.Get(ctx, r.getKey(refreshToken)).Result()
+func (r *refreshTokenRepository) FindRefreshToken(ctx context.Context, refreshToken string) (*entity.RefreshToken, error) {
+	fields, err := r.client.HGetAll(ctx, r.getKey(refreshToken)).Result()
 	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			return uuid.Nil, entity.ErrSessionNotFound
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, entity.ErrSessionNotFound
+	}
+
+	userID, err := uuid.Parse(fields[refreshTokenUserField])
+	if err != nil {
+		return nil, err
+	}
+
+	familyID, err := uuid.Parse(fields[refreshTokenFamilyField])
+	if err != nil {
+		return nil, err
+	}
+
+	generation, err := strconv.Atoi(fields[refreshTokenGenerationField])
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens saved before versions were tracked carry none and count as 0.
+	tokenVersion := 0
+	if raw, ok := fields[refreshTokenVersionField]; ok {
+		tokenVersion, err = strconv.Atoi(raw)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return uuid.Nil, err
+	var storeID *uuid.UUID
+	if raw := fields[refreshTokenStoreField]; raw != "" {
+		parsed, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		storeID = &parsed
 	}
 
-	id, err := uuid.Parse(result)
+	return entity.RestoreRefreshToken(
+		refreshToken,
+		userID,
+		familyID,
+		fields[refreshTokenParentField],
+		generation,
+		fields[refreshTokenRotatedField] != "0",
+		tokenVersion,
+		storeID,
+	), nil
+}
+
+// markRotatedScript increments the rotation counter only if the token still
+// exists and returns -1 otherwise. Checking and incrementing in one script
+// keeps an expiry or a revocation in between from recreating the key as a
+// bare counter with no TTL.
+var markRotatedScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+return redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+`)
+
+// MarkRefreshTokenRotated increments the counter atomically so that only the
+// first caller sees it go from 0 to 1; the key keeps its TTL, so the rotated
+// token stays recognizable until it would have expired anyway.
+func (r *refreshTokenRepository) MarkRefreshTokenRotated(ctx context.Context, refreshToken string) (bool, error) {
+	rotations, err := markRotatedScript.Run(ctx, r.client, []string{r.getKey(refreshToken)}, refreshTokenRotatedField).Int64()
 	if err != nil {
-		return uuid.Nil, err
+		return false, err
 	}
 
-	return id, nil
+	if rotations < 0 {
+		return false, entity.ErrSessionNotFound
+	}
+
+	return rotations > 1, nil
 }
 
+// DeleteRefreshToken ends the session the token belongs to, which includes
+// every token rotated before it.
 func (r *refreshTokenRepository) DeleteRefreshToken(ctx context.Context, refreshToken string) error {
-	result, err := r.client.Del(ctx, r.getKey(refreshToken)).Result()
+	token, err := r.FindRefreshToken(ctx, refreshToken)
 	if err != nil {
 		return err
 	}
 
-	if result == 0 {
-		return entity.ErrSessionNotFound
+	return r.RevokeFamily(ctx, token.UserID(), token.FamilyID())
+}
+
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, userID uuid.UUID, familyID uuid.UUID) error {
+	familyKey := r.getFamilyKey(familyID)
+
+	tokens, err := r.client.SMembers(ctx, familyKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	keys := make([]string, 0, len(tokens)+2)
+	for _, token := range tokens {
+		keys = append(keys, r.getKey(token))
+	}
+	keys = append(keys, familyKey, r.getSessionKey(familyID))
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, keys...)
+	pipe.SRem(ctx, r.getUserKey(userID), familyID.String())
+
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (r *refreshTokenRepository) DeleteAllRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	families, err := r.client.SMembers(ctx, r.getUserKey(userID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	keys := make([]string, 0, len(families)+1)
+	for _, family := range families {
+		familyID, err := uuid.Parse(family)
+		if err != nil {
+			continue
+		}
+
+		familyKey := r.getFamilyKey(familyID)
+
+		tokens, err := r.client.SMembers(ctx, familyKey).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+
+		for _, token := range tokens {
+			keys = append(keys, r.getKey(token))
+		}
+		keys = append(keys, familyKey, r.getSessionKey(familyID))
+	}
+	keys = append(keys, r.getUserKey(userID))
+
+	return r.client.Del(ctx, keys...).Err()
+}
+
+func (r *refreshTokenRepository) SaveSession(ctx context.Context, session *entity.Session, expiresIn time.Duration) error {
+	key := r.getSessionKey(session.ID())
+
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key,
+		sessionUserField, session.UserID().String(),
+		sessionCreatedAtField, session.CreatedAt().Unix(),
+		sessionLastUsedField, session.LastUsedAt().Unix(),
+		sessionIPField, session.IPAddress(),
+		sessionUserAgentField, session.UserAgent(),
+	)
+	pipe.Expire(ctx, key, expiresIn)
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *refreshTokenRepository) FindSession(ctx context.Context, sessionID uuid.UUID) (*entity.Session, error) {
+	fields, err := r.client.HGetAll(ctx, r.getSessionKey(sessionID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, entity.ErrSessionNotFound
+	}
+
+	userID, err := uuid.Parse(fields[sessionUserField])
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := strconv.ParseInt(fields[sessionCreatedAtField], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	lastUsedAt, err := strconv.ParseInt(fields[sessionLastUsedField], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.RestoreSession(
+		sessionID,
+		userID,
+		time.Unix(createdAt, 0),
+		time.Unix(lastUsedAt, 0),
+		fields[sessionIPField],
+		fields[sessionUserAgentField],
+	), nil
+}
+
+// ListSessions skips index entries whose session already expired; the index
+// itself is only trimmed when a family is revoked.
+func (r *refreshTokenRepository) ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	families, err := r.client.SMembers(ctx, r.getUserKey(userID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	sessions := make([]*entity.Session, 0, len(families))
+	for _, family := range families {
+		familyID, err := uuid.Parse(family)
+		if err != nil {
+			continue
+		}
+
+		session, err := r.FindSession(ctx, familyID)
+		if err != nil {
+			if errors.Is(err, entity.ErrSessionNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
 	}
 
-	return nil
+	return sessions, nil
 }
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type roleRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewRoleRepository(db *bun.DB) ports.RoleRepository {
+	return &roleRepositoryImpl{db: db}
+}
+
+func (r *roleRepositoryImpl) Save(ctx context.Context, role *entity.Role) error {
+	roleModel := model.ToRoleModel(role)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().Model(roleModel).Exec(ctx)
+	return err
+}
+
+func (r *roleRepositoryImpl) Update(ctx context.Context, role *entity.Role) error {
+	roleModel := model.ToRoleModel(role)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewUpdate().
+		Model(roleModel).
+		Column("description", "permissions", "updated_at").
+		WherePK().
+		Where("system = FALSE").
+		Exec(ctx)
+	return err
+}
+
+func (r *roleRepositoryImpl) Delete(ctx context.Context, name vo.Role) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewDelete().
+		Model((*model.RoleModel)(nil)).
+		Where("name = ?", name.String()).
+		Where("system = FALSE").
+		Exec(ctx)
+	return err
+}
+
+func (r *roleRepositoryImpl) FindByName(ctx context.Context, name vo.Role) (*entity.Role, error) {
+	roleModel := new(model.RoleModel)
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(roleModel).
+		Where("name = ?", name.String()).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return model.ToRoleEntity(roleModel)
+}
+
+func (r *roleRepositoryImpl) List(ctx context.Context, storeID *uuid.UUID) ([]*entity.Role, error) {
+	var roleModels []model.RoleModel
+
+	db := database.GetDB(ctx, r.db)
+
+	query := db.NewSelect().Model(&roleModels)
+	if storeID != nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("system = TRUE").WhereOr("store_id = ?", *storeID)
+		})
+	}
+
+	if err := query.OrderExpr("system DESC, name ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	roles := make([]*entity.Role, 0, len(roleModels))
+	for i := range roleModels {
+		role, err := model.ToRoleEntity(&roleModels[i])
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+func (r *roleRepositoryImpl) CountUsers(ctx context.Context, name vo.Role) (int, error) {
+	db := database.GetDB(ctx, r.db)
+
+	var count int
+	err := db.NewRaw(
+		`SELECT COUNT(*) FROM (
+			SELECT id FROM users WHERE roles @> ARRAY[?]::TEXT[]
+			UNION
+			SELECT user_id FROM store_memberships WHERE roles @> ARRAY[?]::TEXT[]
+		) AS holders`,
+		name.String(), name.String(),
+	).Scan(ctx, &count)
+
+	return count, err
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/uptrace/bun"
+)
+
+// minEncryptionKeyLength matches the AES-256 key the encryption key is
+// hashed into, so the hash never adds strength the secret does not have.
+const minEncryptionKeyLength = 32
+
+var (
+	ErrWeakEncryptionKey = errors.New("signing key encryption key must be at least 32 bytes")
+	errSealedKeyTooShort = errors.New("sealed signing key is too short")
+)
+
+type signingKeyRepositoryImpl struct {
+	db   *bun.DB
+	aead cipher.AEAD
+}
+
+// NewSigningKeyRepository seals private keys with AES-256-GCM under a key
+// derived from encryptionKey, which must be kept out of the database: a copy
+// of signing_keys alone must not be enough to sign access tokens. A key
+// shorter than minEncryptionKeyLength, empty included, is refused.
+func NewSigningKeyRepository(db *bun.DB, encryptionKey string) (ports.SigningKeyRepository, error) {
+	if len(encryptionKey) < minEncryptionKeyLength {
+		return nil, ErrWeakEncryptionKey
+	}
+
+	sum := sha256.Sum256([]byte(encryptionKey))
+
+	// neither call can fail with a 32 byte key
+	block, _ := aes.NewCipher(sum[:])
+	aead, _ := cipher.NewGCM(block)
+
+	return &signingKeyRepositoryImpl{
+		db:   db,
+		aead: aead,
+	}, nil
+}
+
+// seal binds the ciphertext to the kid and algorithm, so a sealed key copied
+// onto another row fails to open instead of signing under the wrong kid.
+func (r *signingKeyRepositoryImpl) seal(keyModel *model.SigningKeyModel) error {
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	keyModel.PrivateKey = r.aead.Seal(nonce, nonce, keyModel.PrivateKey, sealedKeyData(keyModel))
+	keyModel.Encrypted = true
+	return nil
+}
+
+func (r *signingKeyRepositoryImpl) open(keyModel *model.SigningKeyModel) error {
+	nonceSize := r.aead.NonceSize()
+	if len(keyModel.PrivateKey) < nonceSize {
+		return errSealedKeyTooShort
+	}
+
+	nonce, sealed := keyModel.PrivateKey[:nonceSize], keyModel.PrivateKey[nonceSize:]
+	plain, err := r.aead.Open(nil, nonce, sealed, sealedKeyData(keyModel))
+	if err != nil {
+		return err
+	}
+
+	keyModel.PrivateKey = plain
+	keyModel.Encrypted = false
+	return nil
+}
+
+func sealedKeyData(keyModel *model.SigningKeyModel) []byte {
+	return []byte(keyModel.ID + ":" + keyModel.Algorithm)
+}
+
+func (r *signingKeyRepositoryImpl) Save(ctx context.Context, key *entity.SigningKey) error {
+	keyModel := model.ToSigningKeyModel(key)
+	if err := r.seal(keyModel); err != nil {
+		return err
+	}
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().
+		Model(keyModel).
+		On("CONFLICT (kid) DO UPDATE").
+		Set("retired_at = EXCLUDED.retired_at").
+		Exec(ctx)
+	return err
+}
+
+func (r *signingKeyRepositoryImpl) FindAll(ctx context.Context) ([]*entity.SigningKey, error) {
+	var keyModels []model.SigningKeyModel
+
+	db := database.GetDB(ctx, r.db)
+
+	if err := db.NewSelect().Model(&keyModels).Order("created_at ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	keys := make([]*entity.SigningKey, 0, len(keyModels))
+	for i := range keyModels {
+		keyModel := &keyModels[i]
+
+		if keyModel.Encrypted {
+			if err := r.open(keyModel); err != nil {
+				return nil, fmt.Errorf("opening signing key %s: %w", keyModel.ID, err)
+			}
+		} else if err := r.sealLegacy(ctx, keyModel); err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, model.ToSigningKeyEntity(keyModel))
+	}
+
+	return keys, nil
+}
+
+// sealLegacy encrypts a key stored before private keys were sealed. The key
+// is still returned in the clear, only the stored copy changes.
+func (r *signingKeyRepositoryImpl) sealLegacy(ctx context.Context, keyModel *model.SigningKeyModel) error {
+	sealed := *keyModel
+	if err := r.seal(&sealed); err != nil {
+		return err
+	}
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewUpdate().
+		Model(&sealed).
+		Column("private_key", "encrypted").
+		WherePK().
+		Where("encrypted = FALSE").
+		Exec(ctx)
+	return err
+}
+
+func (r *signingKeyRepositoryImpl) DeleteRetiredBefore(ctx context.Context, cutoff time.Time) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewDelete().
+		Model((*model.SigningKeyModel)(nil)).
+		Where("retired_at IS NOT NULL").
+		Where("retired_at < ?", cutoff).
+		Exec(ctx)
+	return err
+}
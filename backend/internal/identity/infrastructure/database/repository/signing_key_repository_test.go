@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningKeyRepository_SealOpen(t *testing.T) {
+	newRepository := func(encryptionKey string) *signingKeyRepositoryImpl {
+		repo, err := NewSigningKeyRepository(nil, encryptionKey)
+		assert.NoError(t, err)
+		return repo.(*signingKeyRepositoryImpl)
+	}
+	r := newRepository("an-encryption-key-of-32-bytes-ok")
+	plain := []byte("pkcs8-der")
+	newModel := func(kid string) *model.SigningKeyModel {
+		return &model.SigningKeyModel{ID: kid, Algorithm: "EdDSA", PrivateKey: append([]byte(nil), plain...), CreatedAt: time.Now()}
+	}
+
+	tests := []struct {
+		name    string
+		tamper  func(m *model.SigningKeyModel) *signingKeyRepositoryImpl
+		wantErr bool
+	}{
+		{
+			name:   "Round Trip",
+			tamper: func(m *model.SigningKeyModel) *signingKeyRepositoryImpl { return r },
+		},
+		{
+			name: "Moved To Another Kid",
+			tamper: func(m *model.SigningKeyModel) *signingKeyRepositoryImpl {
+				m.ID = "other-kid"
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name: "Wrong Encryption Key",
+			tamper: func(m *model.SigningKeyModel) *signingKeyRepositoryImpl {
+				return newRepository("another-encryption-key-of-32-byte")
+			},
+			wantErr: true,
+		},
+		{
+			name: "Truncated",
+			tamper: func(m *model.SigningKeyModel) *signingKeyRepositoryImpl {
+				m.PrivateKey = m.PrivateKey[:4]
+				return r
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newModel("kid")
+			assert.NoError(t, r.seal(m))
+			assert.True(t, m.Encrypted)
+			assert.NotContains(t, string(m.PrivateKey), string(plain))
+
+			err := tt.tamper(m).open(m)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, plain, m.PrivateKey)
+				assert.False(t, m.Encrypted)
+			}
+		})
+	}
+}
+
+func TestNewSigningKeyRepository_RefusesWeakKeys(t *testing.T) {
+	for _, key := range []string{"", "short-key", "thirty-one-bytes-is-still-short"} {
+		repo, err := NewSigningKeyRepository(nil, key)
+		assert.ErrorIs(t, err, ErrWeakEncryptionKey, "key %q", key)
+		assert.Nil(t, repo)
+	}
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/database/model"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type storeMembershipRepositoryImpl struct {
+	db    *bun.DB
+	roles ports.RoleCatalogProvider
+}
+
+func NewStoreMembershipRepository(db *bun.DB, roles ports.RoleCatalogProvider) ports.StoreMembershipRepository {
+	return &storeMembershipRepositoryImpl{db: db, roles: roles}
+}
+
+func (r *storeMembershipRepositoryImpl) Save(ctx context.Context, membership *entity.StoreMembership) error {
+	membershipModel := model.ToStoreMembershipModel(membership)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewInsert().Model(membershipModel).Exec(ctx)
+	return err
+}
+
+func (r *storeMembershipRepositoryImpl) Update(ctx context.Context, membership *entity.StoreMembership) error {
+	membershipModel := model.ToStoreMembershipModel(membership)
+
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewUpdate().
+		Model(membershipModel).
+		Column("roles", "status", "updated_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+func (r *storeMembershipRepositoryImpl) Delete(ctx context.Context, userID, storeID uuid.UUID) error {
+	db := database.GetDB(ctx, r.db)
+
+	_, err := db.NewDelete().
+		Model((*model.StoreMembershipModel)(nil)).
+		Where("user_id = ?", userID).
+		Where("store_id = ?", storeID).
+		Exec(ctx)
+	return err
+}
+
+func (r *storeMembershipRepositoryImpl) Find(ctx context.Context, userID, storeID uuid.UUID) (*entity.StoreMembership, error) {
+	membershipModel := new(model.StoreMembershipModel)
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(membershipModel).
+		Where("user_id = ?", userID).
+		Where("store_id = ?", storeID).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	catalog, err := r.roles.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToStoreMembershipEntity(membershipModel, catalog)
+}
+
+func (r *storeMembershipRepositoryImpl) ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.StoreMembership, error) {
+	return r.list(ctx, "store_id = ?", storeID)
+}
+
+func (r *storeMembershipRepositoryImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.StoreMembership, error) {
+	return r.list(ctx, "user_id = ?", userID)
+}
+
+func (r *storeMembershipRepositoryImpl) list(ctx context.Context, where string, id uuid.UUID) ([]*entity.StoreMembership, error) {
+	var membershipModels []model.StoreMembershipModel
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(&membershipModels).
+		Where(where, id).
+		Order("created_at ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := r.roles.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]*entity.StoreMembership, 0, len(membershipModels))
+	for i := range membershipModels {
+		membership, err := model.ToStoreMembershipEntity(&membershipModels[i], catalog)
+		if err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, membership)
+	}
+
+	return memberships, nil
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"github.com/MuriloFlores/order-manager/internal/common"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
@@ -14,11 +16,14 @@ import (
 )
 
 type userRepositoryImpl struct {
-	db *bun.DB
+	db    *bun.DB
+	roles ports.RoleCatalogProvider
 }
 
-func NewUserRepository(db *bun.DB) ports.UserRepository {
-	return &userRepositoryImpl{db: db}
+// NewUserRepository validates the roles of every loaded user against the
+// role catalog, so a user never carries a role the catalog does not know.
+func NewUserRepository(db *bun.DB, roles ports.RoleCatalogProvider) ports.UserRepository {
+	return &userRepositoryImpl{db: db, roles: roles}
 }
 
 func (r *userRepositoryImpl) Save(ctx context.Context, user *entity.User) error {
@@ -40,12 +45,47 @@ func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email vo.Email) (*
 		Where("email = ?", email).
 		Scan(ctx)
 
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	catalog, err := r.roles.Catalog(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return model.ToEntity(userModel)
+	return model.ToEntity(userModel, catalog)
+
+}
+
+func (r *userRepositoryImpl) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	userModel := new(model.UserModel)
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model(userModel).
+		Where("username = ?", username).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	catalog, err := r.roles.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	return model.ToEntity(userModel, catalog)
 }
 
 func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
@@ -59,10 +99,19 @@ func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entit
 		Scan(ctx)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
 		return nil, err
 	}
 
-	return model.ToEntity(userModel)
+	catalog, err := r.roles.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ToEntity(userModel, catalog)
 }
 
 func (r *userRepositoryImpl) GetUsersInfo(ctx context.Context, roles []vo.Role, pagination common.Pagination) (*common.PaginatedResult[*entity.User], error) {
@@ -89,7 +138,8 @@ func (r *userRepositoryImpl) GetUsersInfo(ctx context.Context, roles []vo.Role,
 		sortCol = "name"
 	}
 
-	query := db.NewSelect().Model(&userModels)
+	// anonymized accounts only remain so references to them hold
+	query := db.NewSelect().Model(&userModels).Where("deleted_at IS NULL")
 
 	if pagination.Search != "" {
 		searchTerm := "%" + pagination.Search + "%"
@@ -113,9 +163,14 @@ func (r *userRepositoryImpl) GetUsersInfo(ctx context.Context, roles []vo.Role,
 		return nil, err
 	}
 
+	catalog, err := r.roles.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	entities := make([]*entity.User, 0, len(userModels))
 	for _, userModel := range userModels {
-		enty, err := model.ToEntity(&userModel)
+		enty, err := model.ToEntity(&userModel, catalog)
 		if err != nil {
 			return nil, err
 		}
@@ -135,3 +190,33 @@ func (r *userRepositoryImpl) Update(ctx context.Context, user *entity.User) erro
 	_, err := db.NewUpdate().Model(userModel).Exec(ctx)
 	return err
 }
+
+type tokenVersionRepositoryImpl struct {
+	db *bun.DB
+}
+
+func NewTokenVersionRepository(db *bun.DB) ports.TokenVersionRepository {
+	return &tokenVersionRepositoryImpl{db: db}
+}
+
+func (r *tokenVersionRepositoryImpl) GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error) {
+	var version int
+
+	db := database.GetDB(ctx, r.db)
+
+	err := db.NewSelect().
+		Model((*model.UserModel)(nil)).
+		Column("token_version").
+		Where("id = ?", userID).
+		Scan(ctx, &version)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, entity.ErrUserNotFound
+		}
+
+		return 0, err
+	}
+
+	return version, nil
+}
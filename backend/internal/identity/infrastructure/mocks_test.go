@@ -0,0 +1,108 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockLogger implements ports.Logger for testing
+type MockLogger struct {
+	mock.Mock
+}
+
+func (m *MockLogger) Info(msg string, keysAndValues ...any) {}
+
+func (m *MockLogger) Error(msg string, err error, keysAndValues ...any) {}
+
+func (m *MockLogger) Debug(msg string, keysAndValues ...any) {}
+
+// memorySigningKeyRepository implements ports.SigningKeyRepository in memory,
+// which keeps keyring tests focused on rotation instead of call expectations.
+type memorySigningKeyRepository struct {
+	mu   sync.Mutex
+	keys map[string]*entity.SigningKey
+}
+
+func newMemorySigningKeyRepository() *memorySigningKeyRepository {
+	return &memorySigningKeyRepository{keys: make(map[string]*entity.SigningKey)}
+}
+
+func (r *memorySigningKeyRepository) Save(ctx context.Context, key *entity.SigningKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys[key.ID()] = key
+	return nil
+}
+
+func (r *memorySigningKeyRepository) FindAll(ctx context.Context) ([]*entity.SigningKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]*entity.SigningKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		keys = append(keys, entity.RestoreSigningKey(key.ID(), key.Algorithm(), key.PrivateKey(), key.CreatedAt(), key.RetiredAt()))
+	}
+
+	return keys, nil
+}
+
+func (r *memorySigningKeyRepository) DeleteRetiredBefore(ctx context.Context, cutoff time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, key := range r.keys {
+		if key.IsRetired() && key.RetiredAt().Before(cutoff) {
+			delete(r.keys, id)
+		}
+	}
+
+	return nil
+}
+
+// MockRoleRepository implements ports.RoleRepository for testing
+type MockRoleRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepository) Save(ctx context.Context, role *entity.Role) error {
+	args := m.Called(ctx, role)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) Update(ctx context.Context, role *entity.Role) error {
+	args := m.Called(ctx, role)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) Delete(ctx context.Context, name vo.Role) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) FindByName(ctx context.Context, name vo.Role) (*entity.Role, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) List(ctx context.Context, storeID *uuid.UUID) ([]*entity.Role, error) {
+	args := m.Called(ctx, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) CountUsers(ctx context.Context, name vo.Role) (int, error) {
+	args := m.Called(ctx, name)
+	return args.Int(0), args.Error(1)
+}
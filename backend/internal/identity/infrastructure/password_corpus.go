@@ -0,0 +1,128 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+)
+
+//go:generate go run ../../../cmd/passwordcorpus -in data/common_passwords.txt -out data/common_passwords.bin
+
+//go:embed data/common_passwords.bin
+var embeddedPasswordCorpus []byte
+
+var ErrInvalidPasswordCorpus = errors.New("invalid password corpus file")
+
+// maxCorpusEntries bounds the allocation made from the count in the header.
+const maxCorpusEntries = 1 << 27
+
+// corpusMagic opens every corpus file so a truncated or unrelated file is
+// refused instead of silently matching nothing.
+var corpusMagic = []byte("PWC1")
+
+// hashPrefixCorpus keeps the first 8 bytes of the SHA-256 of each password,
+// sorted, and answers lookups with a binary search. At 64 bits false
+// positives stay negligible even for lists with billions of entries, a
+// million-entry breach list costs 8 MB and no plain text is ever loaded.
+type hashPrefixCorpus struct {
+	prefixes []uint64
+}
+
+// EmbeddedPasswordCorpus returns the corpus built into the binary from
+// data/common_passwords.txt.
+func EmbeddedPasswordCorpus() (vo.PasswordCorpus, error) {
+	return LoadPasswordCorpus(bytes.NewReader(embeddedPasswordCorpus))
+}
+
+// LoadPasswordCorpusFile reads a corpus written by WritePasswordCorpus, for
+// lists too large to embed.
+func LoadPasswordCorpusFile(path string) (vo.PasswordCorpus, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening password corpus: %w", err)
+	}
+	defer file.Close()
+
+	return LoadPasswordCorpus(file)
+}
+
+func LoadPasswordCorpus(r io.Reader) (vo.PasswordCorpus, error) {
+	reader := bufio.NewReader(r)
+
+	magic := make([]byte, len(corpusMagic))
+	if _, err := io.ReadFull(reader, magic); err != nil || !bytes.Equal(magic, corpusMagic) {
+		return nil, ErrInvalidPasswordCorpus
+	}
+
+	var count uint32
+	if err := binary.Read(reader, binary.BigEndian, &count); err != nil || count > maxCorpusEntries {
+		return nil, ErrInvalidPasswordCorpus
+	}
+
+	prefixes := make([]uint64, count)
+	if err := binary.Read(reader, binary.BigEndian, prefixes); err != nil {
+		return nil, ErrInvalidPasswordCorpus
+	}
+
+	if !slices.IsSorted(prefixes) {
+		return nil, ErrInvalidPasswordCorpus
+	}
+
+	return &hashPrefixCorpus{prefixes: prefixes}, nil
+}
+
+// WritePasswordCorpus builds a corpus file from a newline-separated password
+// list. Empty lines are skipped and duplicates collapse.
+func WritePasswordCorpus(w io.Writer, passwords io.Reader) error {
+	var prefixes []uint64
+
+	scanner := bufio.NewScanner(passwords)
+	for scanner.Scan() {
+		password := strings.TrimRight(scanner.Text(), "\r")
+		if password == "" {
+			continue
+		}
+
+		prefixes = append(prefixes, corpusPrefix(password))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading password list: %w", err)
+	}
+
+	slices.Sort(prefixes)
+	prefixes = slices.Compact(prefixes)
+
+	if _, err := w.Write(corpusMagic); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, binary.BigEndian, uint32(len(prefixes))); err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, prefixes)
+}
+
+// Contains ignores case, so "Password1!" is caught by a list holding
+// "password1!". Breach lists are mostly lowercase and capitalizing the first
+// letter is the usual way to satisfy the complexity rule.
+func (c *hashPrefixCorpus) Contains(plainText string) bool {
+	_, found := slices.BinarySearch(c.prefixes, corpusPrefix(plainText))
+	return found
+}
+
+func corpusPrefix(password string) uint64 {
+	sum := sha256.Sum256([]byte(strings.ToLower(password)))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,64 @@
+package infrastructure
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddedPasswordCorpus(t *testing.T) {
+	corpus, err := EmbeddedPasswordCorpus()
+	assert.NoError(t, err)
+
+	assert.True(t, corpus.Contains("Password1!"))
+	assert.True(t, corpus.Contains("P@ssw0rd"))
+	assert.True(t, corpus.Contains("123456"))
+	assert.False(t, corpus.Contains("correct-Horse-battery-42"))
+}
+
+func TestWritePasswordCorpus_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	err := WritePasswordCorpus(&buf, strings.NewReader("hunter2\n\nletmein\r\nhunter2\n"))
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "corpus.bin")
+	assert.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+	corpus, err := LoadPasswordCorpusFile(path)
+	assert.NoError(t, err)
+
+	assert.True(t, corpus.Contains("hunter2"))
+	assert.True(t, corpus.Contains("LetMeIn"))
+	assert.False(t, corpus.Contains("hunter3"))
+	assert.Len(t, corpus.(*hashPrefixCorpus).prefixes, 2)
+}
+
+func TestLoadPasswordCorpus_Invalid(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, WritePasswordCorpus(&buf, strings.NewReader("hunter2\nletmein\n")))
+	valid := buf.Bytes()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"Empty", nil},
+		{"Wrong Magic", append([]byte("XXXX"), valid[4:]...)},
+		{"Truncated", valid[:len(valid)-3]},
+		{"Oversized Count", append(append([]byte{}, corpusMagic...), 0xff, 0xff, 0xff, 0xff)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadPasswordCorpus(bytes.NewReader(tt.data))
+			assert.ErrorIs(t, err, ErrInvalidPasswordCorpus)
+		})
+	}
+
+	_, err := LoadPasswordCorpusFile(filepath.Join(t.TempDir(), "missing.bin"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+)
+
+// cachedRoleCatalog rebuilds the catalog from the repository at most once
+// per ttl. A short ttl bounds how long another instance keeps authorizing
+// with permissions that were just removed from a custom role.
+type cachedRoleCatalog struct {
+	repo ports.RoleRepository
+	ttl  time.Duration
+
+	mu       sync.Mutex
+	catalog  vo.RoleCatalog
+	loadedAt time.Time
+	loaded   bool
+}
+
+func NewCachedRoleCatalog(repo ports.RoleRepository, ttl time.Duration) ports.RoleCatalogProvider {
+	return &cachedRoleCatalog{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+func (c *cachedRoleCatalog) Catalog(ctx context.Context) (vo.RoleCatalog, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded && time.Since(c.loadedAt) < c.ttl {
+		return c.catalog, nil
+	}
+
+	roles, err := c.repo.List(ctx, nil)
+	if err != nil {
+		return vo.RoleCatalog{}, fmt.Errorf("loading role catalog: %w", err)
+	}
+
+	catalog := vo.SystemRoleCatalog()
+	for _, role := range roles {
+		if role.IsSystem() {
+			continue
+		}
+
+		catalog = catalog.With(role.Name(), role.Permissions())
+	}
+
+	c.catalog = catalog
+	c.loadedAt = time.Now()
+	c.loaded = true
+
+	return catalog, nil
+}
+
+func (c *cachedRoleCatalog) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.loaded = false
+}
@@ -0,0 +1,64 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedRoleCatalog(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	admin, _ := entity.RestoreRole("ADMIN", nil, "", nil, true, now, now)
+	cashier, _ := entity.NewRole("CASHIER", uuid.New(), "", []vo.Permission{vo.PermOrdersWrite}, now)
+
+	t.Run("Adds custom roles to the system catalog", func(t *testing.T) {
+		repo := new(MockRoleRepository)
+		repo.On("List", ctx, (*uuid.UUID)(nil)).Return([]*entity.Role{admin, cashier}, nil).Once()
+
+		catalog, err := NewCachedRoleCatalog(repo, time.Minute).Catalog(ctx)
+
+		assert.NoError(t, err)
+		assert.True(t, catalog.GrantsOf("CASHIER").Has(vo.PermOrdersWrite))
+		assert.True(t, catalog.GrantsOf(vo.AdminRole).Has(vo.PermRolesManage))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Serves from cache until invalidated", func(t *testing.T) {
+		repo := new(MockRoleRepository)
+		repo.On("List", ctx, (*uuid.UUID)(nil)).Return([]*entity.Role{cashier}, nil).Twice()
+
+		provider := NewCachedRoleCatalog(repo, time.Minute)
+		_, _ = provider.Catalog(ctx)
+		_, _ = provider.Catalog(ctx)
+		provider.Invalidate()
+		_, _ = provider.Catalog(ctx)
+
+		repo.AssertNumberOfCalls(t, "List", 2)
+	})
+
+	t.Run("Reloads after ttl", func(t *testing.T) {
+		repo := new(MockRoleRepository)
+		repo.On("List", ctx, (*uuid.UUID)(nil)).Return([]*entity.Role{}, nil).Twice()
+
+		provider := NewCachedRoleCatalog(repo, 0)
+		_, _ = provider.Catalog(ctx)
+		_, _ = provider.Catalog(ctx)
+
+		repo.AssertNumberOfCalls(t, "List", 2)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		repo := new(MockRoleRepository)
+		repo.On("List", ctx, (*uuid.UUID)(nil)).Return(nil, assert.AnError)
+
+		_, err := NewCachedRoleCatalog(repo, time.Minute).Catalog(ctx)
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
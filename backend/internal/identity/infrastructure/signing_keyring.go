@@ -0,0 +1,395 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	SigningAlgorithmEdDSA = "EdDSA"
+	SigningAlgorithmRS256 = "RS256"
+
+	rsaKeyBits = 2048
+
+	// missingKeyReloadInterval bounds how often tokens with an unknown kid
+	// reload the keyring, so made up kids cannot hammer the repository.
+	missingKeyReloadInterval = 5 * time.Second
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrNoSigningKey         = errors.New("no active signing key")
+)
+
+type keyringKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// SigningKeyring holds the keys that sign and validate access tokens. The
+// newest key that is not retired signs; retired keys keep validating for the
+// overlap window, which must be at least the access token TTL so that a
+// rotation never invalidates a token that has not expired yet. A key rotated
+// by another instance signs before the next Refresh here, so an unknown kid
+// reloads the keyring, at most once per missingKeyReloadInterval.
+type SigningKeyring struct {
+	repo          ports.SigningKeyRepository
+	logger        ports.Logger
+	algorithm     string
+	rotateEvery   time.Duration
+	overlap       time.Duration
+	reloadMissing time.Duration
+
+	mu         sync.RWMutex
+	active     *keyringKey
+	keys       map[string]*keyringKey
+	lastReload time.Time
+
+	// reloadMu lets a single caller reload for a missing kid at a time
+	reloadMu sync.Mutex
+}
+
+// NewSigningKeyring loads the keyring, generating the first key when the
+// repository is empty.
+func NewSigningKeyring(
+	ctx context.Context,
+	repo ports.SigningKeyRepository,
+	logger ports.Logger,
+	algorithm string,
+	rotateEvery time.Duration,
+	overlap time.Duration,
+) (*SigningKeyring, error) {
+	if _, err := signingMethod(algorithm); err != nil {
+		return nil, err
+	}
+
+	k := &SigningKeyring{
+		repo:          repo,
+		logger:        logger,
+		algorithm:     algorithm,
+		rotateEvery:   rotateEvery,
+		overlap:       overlap,
+		reloadMissing: missingKeyReloadInterval,
+		keys:          make(map[string]*keyringKey),
+	}
+
+	if err := k.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// Refresh prunes keys past their overlap window, rotates when the active key
+// is older than the rotation interval and reloads the keyring. Reloading is
+// also how an instance picks up keys rotated by another one.
+func (k *SigningKeyring) Refresh(ctx context.Context) error {
+	now := time.Now()
+
+	if err := k.repo.DeleteRetiredBefore(ctx, now.Add(-k.overlap)); err != nil {
+		return fmt.Errorf("pruning retired signing keys: %w", err)
+	}
+
+	keys, err := k.repo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("finding signing keys: %w", err)
+	}
+
+	active := newestActive(keys)
+	if active == nil || now.Sub(active.CreatedAt()) >= k.rotateEvery {
+		keys, err = k.rotate(ctx, keys, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.load(keys)
+}
+
+// Rotate replaces the active key right away, e.g. when it may have leaked.
+// Tokens signed by the previous key stay valid for the overlap window.
+func (k *SigningKeyring) Rotate(ctx context.Context) error {
+	keys, err := k.repo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("finding signing keys: %w", err)
+	}
+
+	keys, err = k.rotate(ctx, keys, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return k.load(keys)
+}
+
+// Run refreshes the keyring every interval until ctx is done. Failures are
+// logged and retried on the next tick; the loaded keys keep being used.
+func (k *SigningKeyring) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.Refresh(ctx); err != nil {
+				k.logger.Error("failed to refresh signing keyring", err)
+			}
+		}
+	}
+}
+
+// JWKS returns the public half of every key that still validates tokens.
+func (k *SigningKeyring) JWKS() dto.JWKS {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	set := dto.JWKS{Keys: make([]dto.JWK, 0, len(ids))}
+	for _, id := range ids {
+		key := k.keys[id]
+
+		jwk := dto.JWK{
+			Kid: key.id,
+			Use: "sig",
+			Alg: key.method.Alg(),
+		}
+
+		switch public := key.public.(type) {
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		default:
+			continue
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set
+}
+
+func (k *SigningKeyring) signingKey() (*keyringKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if k.active == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	return k.active, nil
+}
+
+func (k *SigningKeyring) verificationKey(id string) (*keyringKey, bool) {
+	if key, ok := k.loadedKey(id); ok {
+		return key, true
+	}
+
+	if err := k.reloadForMissing(context.Background()); err != nil {
+		k.logger.Error("failed to reload signing keyring", err, "kid", id)
+		return nil, false
+	}
+
+	return k.loadedKey(id)
+}
+
+func (k *SigningKeyring) loadedKey(id string) (*keyringKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok := k.keys[id]
+	return key, ok
+}
+
+// reloadForMissing reloads the keyring without pruning or rotating, unless it
+// was loaded less than reloadMissing ago.
+func (k *SigningKeyring) reloadForMissing(ctx context.Context) error {
+	k.reloadMu.Lock()
+	defer k.reloadMu.Unlock()
+
+	k.mu.RLock()
+	lastReload := k.lastReload
+	k.mu.RUnlock()
+
+	if time.Since(lastReload) < k.reloadMissing {
+		return nil
+	}
+
+	keys, err := k.repo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("finding signing keys: %w", err)
+	}
+
+	return k.load(keys)
+}
+
+// rotate saves a new key before retiring the current ones, so a failure
+// half-way never leaves the keyring without an active key.
+func (k *SigningKeyring) rotate(ctx context.Context, keys []*entity.SigningKey, now time.Time) ([]*entity.SigningKey, error) {
+	privateKey, err := generatePrivateKey(k.algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("generating signing key: %w", err)
+	}
+
+	next := entity.NewSigningKey(k.algorithm, privateKey, now)
+	if err := k.repo.Save(ctx, next); err != nil {
+		return nil, fmt.Errorf("saving signing key: %w", err)
+	}
+
+	for _, key := range keys {
+		if key.IsRetired() {
+			continue
+		}
+
+		key.Retire(now)
+		if err := k.repo.Save(ctx, key); err != nil {
+			return nil, fmt.Errorf("retiring signing key: %w", err)
+		}
+	}
+
+	k.logger.Info("signing key rotated", "kid", next.ID(), "algorithm", next.Algorithm())
+	return append(keys, next), nil
+}
+
+func (k *SigningKeyring) load(keys []*entity.SigningKey) error {
+	loaded := make(map[string]*keyringKey, len(keys))
+	for _, key := range keys {
+		parsed, err := parseSigningKey(key)
+		if err != nil {
+			return fmt.Errorf("parsing signing key %s: %w", key.ID(), err)
+		}
+
+		loaded[key.ID()] = parsed
+	}
+
+	var active *keyringKey
+	if newest := newestActive(keys); newest != nil {
+		active = loaded[newest.ID()]
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys = loaded
+	k.active = active
+	k.lastReload = time.Now()
+
+	return nil
+}
+
+// newestActive picks the signer when concurrent rotations on different
+// instances left more than one key unretired.
+func newestActive(keys []*entity.SigningKey) *entity.SigningKey {
+	var newest *entity.SigningKey
+	for _, key := range keys {
+		if key.IsRetired() {
+			continue
+		}
+
+		if newest == nil || key.CreatedAt().After(newest.CreatedAt()) {
+			newest = key
+		}
+	}
+
+	return newest
+}
+
+func signingMethod(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case SigningAlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	case SigningAlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
+
+func generatePrivateKey(algorithm string) ([]byte, error) {
+	var privateKey any
+
+	switch algorithm {
+	case SigningAlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	case SigningAlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, algorithm)
+	}
+
+	return x509.MarshalPKCS8PrivateKey(privateKey)
+}
+
+func parseSigningKey(key *entity.SigningKey) (*keyringKey, error) {
+	method, err := signingMethod(key.Algorithm())
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(key.PrivateKey())
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	switch signer.(type) {
+	case ed25519.PrivateKey:
+		if key.Algorithm() != SigningAlgorithmEdDSA {
+			return nil, ErrUnsupportedAlgorithm
+		}
+	case *rsa.PrivateKey:
+		if key.Algorithm() != SigningAlgorithmRS256 {
+			return nil, ErrUnsupportedAlgorithm
+		}
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	return &keyringKey{
+		id:      key.ID(),
+		method:  method,
+		private: signer,
+		public:  signer.Public(),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningKeyring(t *testing.T) {
+	ctx := context.Background()
+
+	email, _ := vo.NewEmail("test@test.com")
+	pass, _ := vo.RestorePassword("hash")
+	user, _ := entity.NewUser(email, "user", pass, []vo.Role{vo.AdminRole})
+
+	t.Run("Unsupported Algorithm", func(t *testing.T) {
+		_, err := NewSigningKeyring(ctx, newMemorySigningKeyRepository(), new(MockLogger), "HS256", time.Hour, time.Minute)
+		assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+	})
+
+	t.Run("Generates First Key", func(t *testing.T) {
+		repo := newMemorySigningKeyRepository()
+		keyring, err := NewSigningKeyring(ctx, repo, new(MockLogger), SigningAlgorithmEdDSA, time.Hour, time.Minute)
+		assert.NoError(t, err)
+
+		jwks := keyring.JWKS()
+		assert.Len(t, jwks.Keys, 1)
+		assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+		assert.Equal(t, "Ed25519", jwks.Keys[0].Crv)
+		assert.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+		assert.Equal(t, "sig", jwks.Keys[0].Use)
+		assert.NotEmpty(t, jwks.Keys[0].X)
+
+		second, err := NewSigningKeyring(ctx, repo, new(MockLogger), SigningAlgorithmEdDSA, time.Hour, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, jwks, second.JWKS(), "a second instance must share the stored key")
+	})
+
+	t.Run("Rotation Keeps Previous Key During Overlap", func(t *testing.T) {
+		keyring := newTestKeyring(t, SigningAlgorithmEdDSA)
+		tm := NewJWTTokenManager(keyring, time.Minute)
+
+		before, _, err := tm.GenerateTokens(ctx, user)
+		assert.NoError(t, err)
+
+		assert.NoError(t, keyring.Rotate(ctx))
+		assert.Len(t, keyring.JWKS().Keys, 2)
+
+		_, err = tm.ValidateAccessToken(before)
+		assert.NoError(t, err)
+
+		after, _, err := tm.GenerateTokens(ctx, user)
+		assert.NoError(t, err)
+
+		_, err = tm.ValidateAccessToken(after)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Retired Key Is Dropped After Overlap", func(t *testing.T) {
+		keyring, err := NewSigningKeyring(ctx, newMemorySigningKeyRepository(), new(MockLogger), SigningAlgorithmEdDSA, time.Hour, time.Millisecond)
+		assert.NoError(t, err)
+		tm := NewJWTTokenManager(keyring, time.Minute)
+
+		before, _, _ := tm.GenerateTokens(ctx, user)
+
+		assert.NoError(t, keyring.Rotate(ctx))
+		time.Sleep(time.Millisecond * 10)
+		assert.NoError(t, keyring.Refresh(ctx))
+
+		assert.Len(t, keyring.JWKS().Keys, 1)
+
+		_, err = tm.ValidateAccessToken(before)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Refresh Rotates When Key Is Due", func(t *testing.T) {
+		keyring, err := NewSigningKeyring(ctx, newMemorySigningKeyRepository(), new(MockLogger), SigningAlgorithmEdDSA, time.Millisecond, time.Minute)
+		assert.NoError(t, err)
+
+		first := keyring.JWKS().Keys[0].Kid
+
+		time.Sleep(time.Millisecond * 10)
+		assert.NoError(t, keyring.Refresh(ctx))
+
+		active, err := keyring.signingKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, first, active.id)
+		assert.Len(t, keyring.JWKS().Keys, 2)
+	})
+
+	t.Run("Unknown Kid Reloads Keys Rotated By Another Instance", func(t *testing.T) {
+		repo := newMemorySigningKeyRepository()
+		first, err := NewSigningKeyring(ctx, repo, new(MockLogger), SigningAlgorithmEdDSA, time.Hour, time.Minute)
+		assert.NoError(t, err)
+		second, err := NewSigningKeyring(ctx, repo, new(MockLogger), SigningAlgorithmEdDSA, time.Hour, time.Minute)
+		assert.NoError(t, err)
+
+		assert.NoError(t, first.Rotate(ctx))
+		token, _, err := NewJWTTokenManager(first, time.Minute).GenerateTokens(ctx, user)
+		assert.NoError(t, err)
+
+		tm := NewJWTTokenManager(second, time.Minute)
+
+		_, err = tm.ValidateAccessToken(token)
+		assert.ErrorIs(t, err, ErrInvalidToken, "reloads are rate limited right after loading")
+
+		second.reloadMissing = 0
+		_, err = tm.ValidateAccessToken(token)
+		assert.NoError(t, err)
+		assert.Len(t, second.JWKS().Keys, 2)
+	})
+
+	t.Run("RSA Public Key", func(t *testing.T) {
+		keyring := newTestKeyring(t, SigningAlgorithmRS256)
+
+		jwks := keyring.JWKS()
+		assert.Len(t, jwks.Keys, 1)
+		assert.Equal(t, "RSA", jwks.Keys[0].Kty)
+		assert.Equal(t, "RS256", jwks.Keys[0].Alg)
+		assert.Equal(t, "AQAB", jwks.Keys[0].E)
+		assert.NotEmpty(t, jwks.Keys[0].N)
+	})
+}
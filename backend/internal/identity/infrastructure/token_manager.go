@@ -8,7 +8,7 @@ import (
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
-	"github.com/MuriloFlores/order-manager/internal/identity/ports/auth"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
@@ -21,57 +21,120 @@ var (
 )
 
 type jwtCustomClaims struct {
-	UserID string   `json:"user_id"`
-	Roles  []string `json:"roles"`
+	UserID          string   `json:"user_id"`
+	Roles           []string `json:"roles"`
+	EmailVerified   bool     `json:"email_verified"`
+	TokenVersion    int      `json:"token_version"`
+	StoreID         string   `json:"store_id,omitempty"`
+	ImpersonatorID  string   `json:"impersonator_id,omitempty"`
+	ImpersonationID string   `json:"impersonation_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// jwtTokenManager signs access tokens with the active key of the keyring and
+// stamps its kid in the header, so validation keeps working across rotations.
 type jwtTokenManager struct {
-	secretKey      []byte
+	keys           *SigningKeyring
 	accessTokenTTL time.Duration
 }
 
-func NewJWTTokenManager(secretKey string, accessTokenTTL time.Duration) security.TokenManager {
+func NewJWTTokenManager(keys *SigningKeyring, accessTokenTTL time.Duration) security.TokenManager {
 	return &jwtTokenManager{
-		secretKey:      []byte(secretKey),
+		keys:           keys,
 		accessTokenTTL: accessTokenTTL,
 	}
 }
 
 func (m *jwtTokenManager) GenerateTokens(ctx context.Context, user *entity.User) (string, string, error) {
+	return m.generate(user, "")
+}
+
+func (m *jwtTokenManager) GenerateStoreTokens(ctx context.Context, user *entity.User, storeID uuid.UUID) (string, string, error) {
+	return m.generate(user, storeID.String())
+}
+
+// GenerateImpersonationToken issues an access token that acts as user and
+// expires with the impersonation. No refresh token is issued, so the
+// impersonation cannot outlive its time box.
+func (m *jwtTokenManager) GenerateImpersonationToken(ctx context.Context, user *entity.User, impersonation *entity.Impersonation) (string, error) {
+	storeID := ""
+	if impersonation.StoreID() != nil {
+		storeID = impersonation.StoreID().String()
+	}
+
+	claims := m.claimsFor(user, storeID, impersonation.ExpiresAt())
+	claims.ImpersonatorID = impersonation.ImpersonatorID().String()
+	claims.ImpersonationID = impersonation.ID().String()
+
+	return m.sign(claims)
+}
+
+func (m *jwtTokenManager) generate(user *entity.User, storeID string) (string, string, error) {
+	accessToken, err := m.sign(m.claimsFor(user, storeID, time.Now().Add(m.accessTokenTTL)))
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, uuid.New().String(), nil
+}
+
+func (m *jwtTokenManager) claimsFor(user *entity.User, storeID string, expiresAt time.Time) jwtCustomClaims {
 	rolesStr := make([]string, 0, len(user.Roles()))
 	for _, role := range user.Roles() {
 		rolesStr = append(rolesStr, role.String())
 	}
 
 	claims := jwtCustomClaims{
-		UserID: user.ID().String(),
-		Roles:  rolesStr,
+		UserID:        user.ID().String(),
+		Roles:         rolesStr,
+		EmailVerified: user.EmailVerified(),
+		TokenVersion:  user.TokenVersion(),
+		StoreID:       storeID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.accessTokenTTL)),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "store-manager",
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return claims
+}
 
-	accessToken, err := token.SignedString(m.secretKey)
+func (m *jwtTokenManager) sign(claims jwtCustomClaims) (string, error) {
+	key, err := m.keys.signingKey()
 	if err != nil {
-		return "", "", ErrInSigningProcess
+		return "", ErrInSigningProcess
 	}
 
-	return accessToken, uuid.New().String(), nil
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.id
+
+	accessToken, err := token.SignedString(key.private)
+	if err != nil {
+		return "", ErrInSigningProcess
+	}
+
+	return accessToken, nil
 }
 
 func (m *jwtTokenManager) ValidateAccessToken(tokenString string) (*dto.UserClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &jwtCustomClaims{}, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		key, ok := m.keys.verificationKey(kid)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		if t.Method.Alg() != key.method.Alg() {
 			return nil, ErrUnexpectedMethod
 		}
 
-		return m.secretKey, nil
-	})
+		return key.public, nil
+	}, jwt.WithValidMethods([]string{SigningAlgorithmEdDSA, SigningAlgorithmRS256}))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -93,7 +156,7 @@ func (m *jwtTokenManager) ValidateAccessToken(tokenString string) (*dto.UserClai
 
 	roles := make([]vo.Role, 0, len(claims.Roles))
 	for _, role := range claims.Roles {
-		restRole, err := vo.NewRole(role)
+		restRole, err := vo.ParseRoleName(role)
 		if err != nil {
 			return nil, err
 		}
@@ -101,8 +164,51 @@ func (m *jwtTokenManager) ValidateAccessToken(tokenString string) (*dto.UserClai
 		roles = append(roles, restRole)
 	}
 
+	var storeID *uuid.UUID
+	if claims.StoreID != "" {
+		parsed, err := uuid.Parse(claims.StoreID)
+		if err != nil {
+			return nil, ErrInvalidToken
+		}
+
+		storeID = &parsed
+	}
+
+	impersonatorID, err := parseOptionalUUID(claims.ImpersonatorID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	impersonationID, err := parseOptionalUUID(claims.ImpersonationID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	// Both are stamped together; a token carrying only one was not issued here.
+	if (impersonatorID == nil) != (impersonationID == nil) {
+		return nil, ErrInvalidToken
+	}
+
 	return &dto.UserClaims{
-		UserID: userID,
-		Roles:  roles,
+		UserID:          userID,
+		Roles:           roles,
+		EmailVerified:   claims.EmailVerified,
+		TokenVersion:    claims.TokenVersion,
+		StoreID:         storeID,
+		ImpersonatorID:  impersonatorID,
+		ImpersonationID: impersonationID,
 	}, nil
 }
+
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
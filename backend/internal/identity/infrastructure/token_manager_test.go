@@ -7,13 +7,24 @@ import (
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
+func newTestKeyring(t *testing.T, algorithm string) *SigningKeyring {
+	t.Helper()
+
+	keyring, err := NewSigningKeyring(context.Background(), newMemorySigningKeyRepository(), new(MockLogger), algorithm, time.Hour, time.Minute)
+	assert.NoError(t, err)
+
+	return keyring
+}
+
 func TestJWTTokenManager(t *testing.T) {
-	secret := "super-secret-key-for-testing-123"
 	ttl := time.Second * 2
-	tm := NewJWTTokenManager(secret, ttl)
+	keyring := newTestKeyring(t, SigningAlgorithmEdDSA)
+	tm := NewJWTTokenManager(keyring, ttl)
 
 	email, _ := vo.NewEmail("test@test.com")
 	pass, _ := vo.RestorePassword("hash")
@@ -28,10 +39,53 @@ func TestJWTTokenManager(t *testing.T) {
 		assert.Equal(t, user.ID(), claims.UserID)
 		assert.Len(t, claims.Roles, 1)
 		assert.Equal(t, vo.AdminRole, claims.Roles[0])
+		assert.Equal(t, 0, claims.TokenVersion)
+		assert.Nil(t, claims.StoreID)
+		assert.False(t, claims.IsImpersonated())
+	})
+
+	t.Run("Store Token Carries Active Store", func(t *testing.T) {
+		storeID := uuid.New()
+
+		access, _, err := tm.GenerateStoreTokens(context.Background(), user, storeID)
+		assert.NoError(t, err)
+
+		claims, err := tm.ValidateAccessToken(access)
+		assert.NoError(t, err)
+		assert.Equal(t, &storeID, claims.StoreID)
+	})
+
+	t.Run("Impersonation Token Names Both Users", func(t *testing.T) {
+		adminID, storeID := uuid.New(), uuid.New()
+		impersonation, err := entity.NewImpersonation(adminID, user.ID(), &storeID, "ticket 42", time.Minute, time.Now())
+		assert.NoError(t, err)
+
+		access, err := tm.GenerateImpersonationToken(context.Background(), user, impersonation)
+		assert.NoError(t, err)
+
+		claims, err := tm.ValidateAccessToken(access)
+		assert.NoError(t, err)
+		assert.True(t, claims.IsImpersonated())
+		assert.Equal(t, user.ID(), claims.UserID)
+		assert.Equal(t, &adminID, claims.ImpersonatorID)
+		assert.Equal(t, impersonation.ID(), *claims.ImpersonationID)
+		assert.Equal(t, &storeID, claims.StoreID)
+	})
+
+	t.Run("Token Carries Token Version", func(t *testing.T) {
+		revoked, _ := entity.NewUser(email, "user", pass, []vo.Role{vo.AdminRole})
+		revoked.RevokeSessions()
+
+		access, _, err := tm.GenerateTokens(context.Background(), revoked)
+		assert.NoError(t, err)
+
+		claims, err := tm.ValidateAccessToken(access)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, claims.TokenVersion)
 	})
 
 	t.Run("Expired Token", func(t *testing.T) {
-		tmShort := NewJWTTokenManager(secret, time.Millisecond)
+		tmShort := NewJWTTokenManager(keyring, time.Millisecond)
 		access, _, _ := tmShort.GenerateTokens(context.Background(), user)
 
 		time.Sleep(time.Millisecond * 10)
@@ -42,9 +96,37 @@ func TestJWTTokenManager(t *testing.T) {
 
 	t.Run("Invalid Signature", func(t *testing.T) {
 		access, _, _ := tm.GenerateTokens(context.Background(), user)
-		tmOther := NewJWTTokenManager("other-secret", ttl)
+		tmOther := NewJWTTokenManager(newTestKeyring(t, SigningAlgorithmEdDSA), ttl)
 
 		_, err := tmOther.ValidateAccessToken(access)
 		assert.ErrorIs(t, err, ErrInvalidToken)
 	})
+
+	t.Run("RS256 Keyring", func(t *testing.T) {
+		tmRSA := NewJWTTokenManager(newTestKeyring(t, SigningAlgorithmRS256), ttl)
+
+		access, _, err := tmRSA.GenerateTokens(context.Background(), user)
+		assert.NoError(t, err)
+
+		claims, err := tmRSA.ValidateAccessToken(access)
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID(), claims.UserID)
+	})
+
+	t.Run("Rejects HS256 Token", func(t *testing.T) {
+		kid := keyring.JWKS().Keys[0].Kid
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtCustomClaims{
+			UserID: user.ID().String(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			},
+		})
+		token.Header["kid"] = kid
+
+		forged, err := token.SignedString([]byte("guessed-secret"))
+		assert.NoError(t, err)
+
+		_, err = tm.ValidateAccessToken(forged)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
 }
@@ -0,0 +1,205 @@
+package infrastructure
+
+import (
+	"errors"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	emailVerificationPurpose = "email_verification"
+	accountUnlockPurpose     = "account_unlock"
+	emailChangePurpose       = "email_change"
+	magicLinkPurpose         = "magic_link"
+)
+
+type jwtVerificationClaims struct {
+	UserID      string `json:"user_id"`
+	Email       string `json:"email"`
+	Purpose     string `json:"purpose"`
+	LockedUntil int64  `json:"locked_until,omitempty"`
+	NewEmail    string `json:"new_email,omitempty"`
+	LinkID      string `json:"link_id,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type jwtVerificationTokenManager struct {
+	secretKey []byte
+	ttl       time.Duration
+}
+
+func NewJWTVerificationTokenManager(secretKey string, ttl time.Duration) security.VerificationTokenManager {
+	return &jwtVerificationTokenManager{
+		secretKey: []byte(secretKey),
+		ttl:       ttl,
+	}
+}
+
+func (m *jwtVerificationTokenManager) GenerateVerificationToken(user *entity.User) (string, error) {
+	return m.sign(jwtVerificationClaims{
+		UserID:  user.ID().String(),
+		Email:   user.Email().String(),
+		Purpose: emailVerificationPurpose,
+	})
+}
+
+func (m *jwtVerificationTokenManager) ValidateVerificationToken(tokenString string) (uuid.UUID, vo.Email, error) {
+	claims, err := m.parse(tokenString, emailVerificationPurpose)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return uuid.Nil, "", ErrInvalidToken
+	}
+
+	email, err := vo.NewEmail(claims.Email)
+	if err != nil {
+		return uuid.Nil, "", ErrInvalidToken
+	}
+
+	return userID, email, nil
+}
+
+func (m *jwtVerificationTokenManager) GenerateUnlockToken(user *entity.User) (string, error) {
+	if user.LockedUntil() == nil {
+		return "", ErrInvalidToken
+	}
+
+	return m.sign(jwtVerificationClaims{
+		UserID:      user.ID().String(),
+		Email:       user.Email().String(),
+		Purpose:     accountUnlockPurpose,
+		LockedUntil: user.LockedUntil().Unix(),
+	})
+}
+
+func (m *jwtVerificationTokenManager) ValidateUnlockToken(tokenString string) (uuid.UUID, time.Time, error) {
+	claims, err := m.parse(tokenString, accountUnlockPurpose)
+	if err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil || claims.LockedUntil == 0 {
+		return uuid.Nil, time.Time{}, ErrInvalidToken
+	}
+
+	return userID, time.Unix(claims.LockedUntil, 0), nil
+}
+
+func (m *jwtVerificationTokenManager) GenerateEmailChangeToken(user *entity.User, newEmail vo.Email) (string, error) {
+	return m.sign(jwtVerificationClaims{
+		UserID:   user.ID().String(),
+		Email:    user.Email().String(),
+		Purpose:  emailChangePurpose,
+		NewEmail: newEmail.String(),
+	})
+}
+
+func (m *jwtVerificationTokenManager) ValidateEmailChangeToken(tokenString string) (uuid.UUID, vo.Email, vo.Email, error) {
+	claims, err := m.parse(tokenString, emailChangePurpose)
+	if err != nil {
+		return uuid.Nil, "", "", err
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return uuid.Nil, "", "", ErrInvalidToken
+	}
+
+	currentEmail, err := vo.NewEmail(claims.Email)
+	if err != nil {
+		return uuid.Nil, "", "", ErrInvalidToken
+	}
+
+	newEmail, err := vo.NewEmail(claims.NewEmail)
+	if err != nil {
+		return uuid.Nil, "", "", ErrInvalidToken
+	}
+
+	return userID, currentEmail, newEmail, nil
+}
+
+func (m *jwtVerificationTokenManager) GenerateMagicLinkToken(user *entity.User, linkID uuid.UUID) (string, error) {
+	return m.sign(jwtVerificationClaims{
+		UserID:  user.ID().String(),
+		Email:   user.Email().String(),
+		Purpose: magicLinkPurpose,
+		LinkID:  linkID.String(),
+	})
+}
+
+func (m *jwtVerificationTokenManager) ValidateMagicLinkToken(tokenString string) (uuid.UUID, vo.Email, uuid.UUID, error) {
+	claims, err := m.parse(tokenString, magicLinkPurpose)
+	if err != nil {
+		return uuid.Nil, "", uuid.Nil, err
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return uuid.Nil, "", uuid.Nil, ErrInvalidToken
+	}
+
+	email, err := vo.NewEmail(claims.Email)
+	if err != nil {
+		return uuid.Nil, "", uuid.Nil, ErrInvalidToken
+	}
+
+	linkID, err := uuid.Parse(claims.LinkID)
+	if err != nil {
+		return uuid.Nil, "", uuid.Nil, ErrInvalidToken
+	}
+
+	return userID, email, linkID, nil
+}
+
+func (m *jwtVerificationTokenManager) sign(claims jwtVerificationClaims) (string, error) {
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.ttl)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Issuer:    "store-manager",
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	signed, err := token.SignedString(m.secretKey)
+	if err != nil {
+		return "", ErrInSigningProcess
+	}
+
+	return signed, nil
+}
+
+// parse checks the signature, the expiry and the purpose, so a token issued
+// for one flow is never accepted by another.
+func (m *jwtVerificationTokenManager) parse(tokenString string, purpose string) (*jwtVerificationClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &jwtVerificationClaims{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrUnexpectedMethod
+		}
+
+		return m.secretKey, nil
+	})
+
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(*jwtVerificationClaims)
+	if !ok || !token.Valid || claims.Purpose != purpose {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
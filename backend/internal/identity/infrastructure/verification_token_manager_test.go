@@ -0,0 +1,141 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWTVerificationTokenManager(t *testing.T) {
+	secret := "verification-secret-for-testing"
+	vm := NewJWTVerificationTokenManager(secret, time.Minute)
+
+	email, _ := vo.NewEmail("test@test.com")
+	pass, _ := vo.RestorePassword("hash")
+	user, _ := entity.NewUser(email, "user", pass, []vo.Role{vo.EmployeeRole})
+
+	t.Run("Generate and Validate Token", func(t *testing.T) {
+		token, err := vm.GenerateVerificationToken(user)
+		assert.NoError(t, err)
+
+		userID, tokenEmail, err := vm.ValidateVerificationToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID(), userID)
+		assert.Equal(t, email, tokenEmail)
+	})
+
+	t.Run("Expired Token", func(t *testing.T) {
+		vmShort := NewJWTVerificationTokenManager(secret, time.Millisecond)
+		token, _ := vmShort.GenerateVerificationToken(user)
+
+		time.Sleep(time.Millisecond * 10)
+
+		_, _, err := vmShort.ValidateVerificationToken(token)
+		assert.ErrorIs(t, err, ErrExpiredToken)
+	})
+
+	t.Run("Access Token Is Not A Verification Token", func(t *testing.T) {
+		tm := NewJWTTokenManager(newTestKeyring(t, SigningAlgorithmEdDSA), time.Minute)
+		access, _, _ := tm.GenerateTokens(context.Background(), user)
+
+		_, _, err := vm.ValidateVerificationToken(access)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestJWTVerificationTokenManager_UnlockToken(t *testing.T) {
+	vm := NewJWTVerificationTokenManager("verification-secret-for-testing", time.Minute)
+
+	email, _ := vo.NewEmail("test@test.com")
+	pass, _ := vo.RestorePassword("hash")
+	user, _ := entity.NewUser(email, "user", pass, []vo.Role{vo.EmployeeRole})
+
+	t.Run("Requires A Lockout", func(t *testing.T) {
+		_, err := vm.GenerateUnlockToken(user)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Carries The Lockout", func(t *testing.T) {
+		now := time.Now()
+		for range 5 {
+			user.RecordFailedLogin(5, time.Minute, now)
+		}
+
+		token, err := vm.GenerateUnlockToken(user)
+		assert.NoError(t, err)
+
+		userID, lockedUntil, err := vm.ValidateUnlockToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID(), userID)
+		assert.Equal(t, user.LockedUntil().Unix(), lockedUntil.Unix())
+	})
+
+	t.Run("Verification Token Is Not An Unlock Token", func(t *testing.T) {
+		token, _ := vm.GenerateVerificationToken(user)
+
+		_, _, err := vm.ValidateUnlockToken(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestJWTVerificationTokenManager_EmailChangeToken(t *testing.T) {
+	vm := NewJWTVerificationTokenManager("verification-secret-for-testing", time.Minute)
+
+	email, _ := vo.NewEmail("test@test.com")
+	newEmail, _ := vo.NewEmail("new@test.com")
+	pass, _ := vo.RestorePassword("hash")
+	user, _ := entity.NewUser(email, "user", pass, []vo.Role{vo.EmployeeRole})
+
+	t.Run("Carries Both Addresses", func(t *testing.T) {
+		token, err := vm.GenerateEmailChangeToken(user, newEmail)
+		assert.NoError(t, err)
+
+		userID, currentEmail, tokenNewEmail, err := vm.ValidateEmailChangeToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID(), userID)
+		assert.Equal(t, email, currentEmail)
+		assert.Equal(t, newEmail, tokenNewEmail)
+	})
+
+	t.Run("Verification Token Is Not An Email Change Token", func(t *testing.T) {
+		token, _ := vm.GenerateVerificationToken(user)
+
+		_, _, _, err := vm.ValidateEmailChangeToken(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestJWTVerificationTokenManager_MagicLinkToken(t *testing.T) {
+	vm := NewJWTVerificationTokenManager("verification-secret-for-testing", time.Minute)
+
+	email, _ := vo.NewEmail("test@test.com")
+	pass, _ := vo.RestorePassword("hash")
+	user, _ := entity.NewUser(email, "user", pass, []vo.Role{vo.EmployeeRole})
+
+	t.Run("Carries The Link", func(t *testing.T) {
+		linkID := uuid.New()
+		token, err := vm.GenerateMagicLinkToken(user, linkID)
+		assert.NoError(t, err)
+
+		userID, tokenEmail, tokenLinkID, err := vm.ValidateMagicLinkToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID(), userID)
+		assert.Equal(t, email, tokenEmail)
+		assert.Equal(t, linkID, tokenLinkID)
+	})
+
+	t.Run("Unlock Token Is Not A Magic Link Token", func(t *testing.T) {
+		for range 5 {
+			user.RecordFailedLogin(5, time.Minute, time.Now())
+		}
+		token, _ := vm.GenerateUnlockToken(user)
+
+		_, _, _, err := vm.ValidateMagicLinkToken(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
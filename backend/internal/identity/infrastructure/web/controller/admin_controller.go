@@ -1,10 +1,12 @@
 package controller
 
 import (
+	"bytes"
 	"net/http"
 	"strconv"
 
 	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
 	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/middleware"
@@ -14,50 +16,205 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// maxUserImportSize bounds the body of a user import. A thousand rows, the
+// most one import takes, fit in it comfortably.
+const maxUserImportSize = 1 << 20
+
 type AdminController struct {
-	getUserInfo  admin.GetUsersInfo
-	changeStatus admin.ChangeUserStatusUseCase
-	changeRole   admin.ChangeUserRoleUseCase
-	rateLimit    ports.RateLimiterRepository
-	tokenManager security.TokenManager
+	getUserInfo    admin.GetUsersInfo
+	changeStatus   admin.ChangeUserStatusUseCase
+	changeRole     admin.ChangeUserRoleUseCase
+	resetMFA       admin.ResetUserMFAUseCase
+	setRoleMFA     admin.SetRoleMFARequirementUseCase
+	getMFARoles    admin.GetMFARequiredRolesUseCase
+	revokeAll      admin.RevokeUserSessionsUseCase
+	createAPIKey   admin.CreateAPIKeyUseCase
+	listAPIKeys    admin.ListAPIKeysUseCase
+	revokeAPIKey   admin.RevokeAPIKeyUseCase
+	createRole     admin.CreateRoleUseCase
+	listRoles      admin.ListRolesUseCase
+	updateRole     admin.UpdateRoleUseCase
+	deleteRole     admin.DeleteRoleUseCase
+	addMember      admin.AddStoreMemberUseCase
+	listMembers    admin.ListStoreMembersUseCase
+	updateMember   admin.UpdateStoreMemberUseCase
+	removeMember   admin.RemoveStoreMemberUseCase
+	invite         admin.InviteUserUseCase
+	listInvites    admin.ListInvitationsUseCase
+	revokeInvite   admin.RevokeInvitationUseCase
+	getPwdPolicy   admin.GetStorePasswordPolicyUseCase
+	setPwdPolicy   admin.SetStorePasswordPolicyUseCase
+	listAudit      admin.ListAuditEventsUseCase
+	unlockUser     admin.UnlockUserUseCase
+	impersonate    admin.StartImpersonationUseCase
+	endImpersonate admin.EndImpersonationUseCase
+	importUsers    admin.ImportUsersUseCase
+	exportUsers    admin.ExportUsersInfo
+	rateLimit      ports.RateLimiterRepository
+	tokenManager   security.TokenManager
+	tokenVersions  ports.TokenVersionRepository
+	impersonations ports.ImpersonationRepository
+	apiKeys        security.AuthenticateAPIKeyUseCase
+	roles          ports.RoleCatalogProvider
+	memberships    ports.StoreMembershipRepository
 }
 
 func NewAdminController(
 	getUserInfo admin.GetUsersInfo,
 	changeStatus admin.ChangeUserStatusUseCase,
 	changeRole admin.ChangeUserRoleUseCase,
+	resetMFA admin.ResetUserMFAUseCase,
+	setRoleMFA admin.SetRoleMFARequirementUseCase,
+	getMFARoles admin.GetMFARequiredRolesUseCase,
+	revokeAll admin.RevokeUserSessionsUseCase,
+	createAPIKey admin.CreateAPIKeyUseCase,
+	listAPIKeys admin.ListAPIKeysUseCase,
+	revokeAPIKey admin.RevokeAPIKeyUseCase,
+	createRole admin.CreateRoleUseCase,
+	listRoles admin.ListRolesUseCase,
+	updateRole admin.UpdateRoleUseCase,
+	deleteRole admin.DeleteRoleUseCase,
+	addMember admin.AddStoreMemberUseCase,
+	listMembers admin.ListStoreMembersUseCase,
+	updateMember admin.UpdateStoreMemberUseCase,
+	removeMember admin.RemoveStoreMemberUseCase,
+	invite admin.InviteUserUseCase,
+	listInvites admin.ListInvitationsUseCase,
+	revokeInvite admin.RevokeInvitationUseCase,
+	getPwdPolicy admin.GetStorePasswordPolicyUseCase,
+	setPwdPolicy admin.SetStorePasswordPolicyUseCase,
+	listAudit admin.ListAuditEventsUseCase,
+	unlockUser admin.UnlockUserUseCase,
+	impersonate admin.StartImpersonationUseCase,
+	endImpersonate admin.EndImpersonationUseCase,
+	importUsers admin.ImportUsersUseCase,
+	exportUsers admin.ExportUsersInfo,
 	rateLimit ports.RateLimiterRepository,
 	tokenManger security.TokenManager,
+	tokenVersions ports.TokenVersionRepository,
+	impersonations ports.ImpersonationRepository,
+	apiKeys security.AuthenticateAPIKeyUseCase,
+	roles ports.RoleCatalogProvider,
+	memberships ports.StoreMembershipRepository,
 ) *AdminController {
 	return &AdminController{
-		getUserInfo:  getUserInfo,
-		changeStatus: changeStatus,
-		changeRole:   changeRole,
-		rateLimit:    rateLimit,
-		tokenManager: tokenManger,
+		getUserInfo:    getUserInfo,
+		changeStatus:   changeStatus,
+		changeRole:     changeRole,
+		resetMFA:       resetMFA,
+		setRoleMFA:     setRoleMFA,
+		getMFARoles:    getMFARoles,
+		revokeAll:      revokeAll,
+		createAPIKey:   createAPIKey,
+		listAPIKeys:    listAPIKeys,
+		revokeAPIKey:   revokeAPIKey,
+		createRole:     createRole,
+		listRoles:      listRoles,
+		updateRole:     updateRole,
+		deleteRole:     deleteRole,
+		addMember:      addMember,
+		listMembers:    listMembers,
+		updateMember:   updateMember,
+		removeMember:   removeMember,
+		invite:         invite,
+		listInvites:    listInvites,
+		revokeInvite:   revokeInvite,
+		getPwdPolicy:   getPwdPolicy,
+		setPwdPolicy:   setPwdPolicy,
+		listAudit:      listAudit,
+		unlockUser:     unlockUser,
+		impersonate:    impersonate,
+		endImpersonate: endImpersonate,
+		importUsers:    importUsers,
+		exportUsers:    exportUsers,
+		rateLimit:      rateLimit,
+		tokenManager:   tokenManger,
+		tokenVersions:  tokenVersions,
+		impersonations: impersonations,
+		apiKeys:        apiKeys,
+		roles:          roles,
+		memberships:    memberships,
 	}
 }
 
 func (h *AdminController) RegisterRoutes(engine *gin.Engine) {
-	allowedRoles := []vo.Role{
-		vo.ManagerRole,
-		vo.AdminRole,
-	}
-
 	adminRoutes := engine.Group("/admin")
-	adminRoutes.Use(middleware.RequireAuth(h.tokenManager))
+	adminRoutes.Use(middleware.CaptureClientInfo())
+	adminRoutes.Use(middleware.RequireAuth(h.tokenManager, h.tokenVersions, h.impersonations, h.apiKeys))
+	adminRoutes.Use(middleware.RequireCSRF())
+	adminRoutes.Use(middleware.RequireVerifiedEmail())
 	adminRoutes.Use(middleware.RateLimit(h.rateLimit))
-	adminRoutes.Use(middleware.VerifyRole(allowedRoles...))
 	{
-		adminRoutes.GET("/users", h.GetUsersInfo)
-		adminRoutes.PATCH("/:id/status", h.ChangeUserStatus)
-		adminRoutes.PUT("/:id/roles", h.ChangeUserRoles)
+		adminRoutes.GET("/users", middleware.RequirePermission(h.roles, vo.PermUsersRead), h.GetUsersInfo)
+		adminRoutes.GET("/users/export", middleware.RequirePermission(h.roles, vo.PermUsersRead), h.ExportUsersInfo)
+		adminRoutes.POST("/users/import", middleware.RequirePermission(h.roles, vo.PermMembersManage), middleware.ForbidImpersonation(), h.ImportUsers)
+		adminRoutes.PATCH("/:id/status", middleware.RequirePermission(h.roles, vo.PermUsersStatusWrite), middleware.ForbidImpersonation(), h.ChangeUserStatus)
+		adminRoutes.PUT("/:id/roles", middleware.RequirePermission(h.roles, vo.PermUsersRolesWrite), middleware.ForbidImpersonation(), h.ChangeUserRoles)
+		adminRoutes.DELETE("/:id/mfa", middleware.RequirePermission(h.roles, vo.PermUsersMFAReset), middleware.ForbidImpersonation(), h.ResetUserMFA)
+		adminRoutes.DELETE("/:id/sessions", middleware.RequirePermission(h.roles, vo.PermUsersSessionsRevoke), middleware.ForbidImpersonation(), h.RevokeUserSessions)
+		adminRoutes.POST("/:id/unlock", middleware.RequirePermission(h.roles, vo.PermUsersUnlock), h.UnlockUser)
+		adminRoutes.POST("/:id/impersonate", middleware.RequirePermission(h.roles, vo.PermUsersImpersonate), middleware.ForbidImpersonation(), h.StartImpersonation)
+		adminRoutes.DELETE("/impersonations/:id", middleware.RequirePermission(h.roles, vo.PermUsersImpersonate), h.EndImpersonation)
+		adminRoutes.GET("/mfa/roles", middleware.RequirePermission(h.roles, vo.PermMFAPolicyRead), h.GetMFARequiredRoles)
+		adminRoutes.PUT("/mfa/roles/:role", middleware.RequirePermission(h.roles, vo.PermMFAPolicyWrite), middleware.ForbidImpersonation(), h.SetRoleMFARequirement)
+		adminRoutes.GET("/audit", middleware.RequirePermission(h.roles, vo.PermAuditRead), h.ListAuditEvents)
+	}
+
+	// Impersonation tokens can read what the user can, but not manage keys,
+	// roles, members or policies on their behalf.
+	apiKeyRoutes := adminRoutes.Group("/api-keys")
+	apiKeyRoutes.Use(middleware.RequirePermission(h.roles, vo.PermAPIKeysManage))
+	apiKeyRoutes.Use(middleware.ForbidImpersonation())
+	{
+		apiKeyRoutes.POST("", h.CreateAPIKey)
+		apiKeyRoutes.GET("", h.ListAPIKeys)
+		apiKeyRoutes.DELETE("/:id", h.RevokeAPIKey)
+	}
+
+	roleRoutes := adminRoutes.Group("/roles")
+	roleRoutes.Use(middleware.RequirePermission(h.roles, vo.PermRolesManage))
+	roleRoutes.Use(middleware.ForbidImpersonation())
+	{
+		roleRoutes.POST("", h.CreateRole)
+		roleRoutes.GET("", h.ListRoles)
+		roleRoutes.PUT("/:name", h.UpdateRole)
+		roleRoutes.DELETE("/:name", h.DeleteRole)
+	}
+
+	// Only routes of a store count the roles of the caller's membership in
+	// it; everything above is checked against global roles alone.
+	storeRoutes := adminRoutes.Group("/stores/:store_id")
+	storeRoutes.Use(middleware.ResolveStoreRoles(h.memberships))
+
+	memberRoutes := storeRoutes.Group("/members")
+	memberRoutes.Use(middleware.RequirePermission(h.roles, vo.PermMembersManage))
+	memberRoutes.Use(middleware.ForbidImpersonation())
+	{
+		memberRoutes.POST("", h.AddStoreMember)
+		memberRoutes.GET("", h.ListStoreMembers)
+		memberRoutes.PUT("/:user_id", h.UpdateStoreMember)
+		memberRoutes.DELETE("/:user_id", h.RemoveStoreMember)
+	}
+
+	invitationRoutes := storeRoutes.Group("/invitations")
+	invitationRoutes.Use(middleware.RequirePermission(h.roles, vo.PermMembersManage))
+	invitationRoutes.Use(middleware.ForbidImpersonation())
+	{
+		invitationRoutes.POST("", h.InviteUser)
+		invitationRoutes.GET("", h.ListInvitations)
+		invitationRoutes.DELETE("/:id", h.RevokeInvitation)
+	}
+
+	passwordPolicyRoutes := storeRoutes.Group("/password-policy")
+	{
+		passwordPolicyRoutes.GET("", middleware.RequirePermission(h.roles, vo.PermPasswordPolicyRead), h.GetStorePasswordPolicy)
+		passwordPolicyRoutes.PUT("", middleware.RequirePermission(h.roles, vo.PermPasswordPolicyWrite), middleware.ForbidImpersonation(), h.SetStorePasswordPolicy)
 	}
 }
 
 // GetUsersInfo returns paginated user information
 // @Summary List Users
-// @Description Returns a paginated list of users filtered by role
+// @Description Returns a paginated list of users filtered by role. Refused to API keys, which are bound to a single store
 // @Tags Admin
 // @Security BearerAuth
 // @Produce json
@@ -67,7 +224,7 @@ func (h *AdminController) RegisterRoutes(engine *gin.Engine) {
 // @Param search query string false "Search query"
 // @Param sort query string false "Sort field (default name)"
 // @Param direction query string false "Sort direction (ASC/DESC, default DESC)"
-// @Success 200 {object} _common.PaginatedResult[entity.User] "Paginated user data"
+// @Success 200 {object} _common.PaginatedResult[dto.AdminUserInfo] "Paginated user data, including lockout state"
 // @Failure 401 {object} map[string]string "error: unauthorized"
 // @Router /admin/users [get]
 func (h *AdminController) GetUsersInfo(c *gin.Context) {
@@ -93,6 +250,104 @@ func (h *AdminController) GetUsersInfo(c *gin.Context) {
 	c.JSON(http.StatusOK, info)
 }
 
+// ExportUsersInfo downloads the user listing as CSV
+// @Summary Export Users
+// @Description Returns every user matching the filters of the user listing as a CSV file, in the format the import reads. Roles are separated by ";". Refused to API keys, which are bound to a single store
+// @Tags Admin
+// @Security BearerAuth
+// @Produce text/csv
+// @Param roles query []string false "Filter by roles"
+// @Param search query string false "Search query"
+// @Param sort query string false "Sort field (default name)"
+// @Param direction query string false "Sort direction (ASC/DESC, default DESC)"
+// @Success 200 {file} file "users.csv"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 422 {object} map[string]string "error: invalid role"
+// @Router /admin/users/export [get]
+func (h *AdminController) ExportUsersInfo(c *gin.Context) {
+	users, err := h.exportUsers.Execute(
+		c.Request.Context(),
+		c.DefaultQuery("search", ""),
+		c.DefaultQuery("sort", "name"),
+		c.DefaultQuery("direction", "DESC"),
+		c.QueryArray("roles"),
+	)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := helper.WriteUsersCSV(&buf, users); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="users.csv"`)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// ImportUsers creates users from a CSV file
+// @Summary Import Users
+// @Description Creates the users of a CSV file with the columns email, username, roles (separated by ";") and store. Users with a store become members of it with the roles, under the checks of adding a member; users without one get the roles globally, which also needs users:roles:write. Every row is validated and reported; nothing is created unless all rows are valid. Created users have no password and are emailed a login link
+// @Tags Admin
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV file"
+// @Param dry_run query bool false "Only validate the file (default false)"
+// @Success 200 {object} dto.UserImportReport "Dry run with every row valid"
+// @Success 201 {object} dto.UserImportReport "Users created"
+// @Failure 400 {object} map[string]string "error: file missing"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 422 {object} dto.UserImportReport "Rows with errors, nothing created"
+// @Router /admin/users/import [post]
+func (h *AdminController) ImportUsers(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUserImportSize)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+	defer file.Close()
+
+	rows, err := helper.ParseUserImportCSV(file)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	report, err := h.importUsers.Execute(c.Request.Context(), claims.UserID, rows, dryRun)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	switch {
+	case report.Imported:
+		c.JSON(http.StatusCreated, report)
+	case report.Invalid > 0:
+		c.JSON(http.StatusUnprocessableEntity, report)
+	default:
+		c.JSON(http.StatusOK, report)
+	}
+}
+
 // ChangeUserStatus activates or deactivates a user
 // @Summary Change User Status
 // @Description Activates or deactivates a user by ID
@@ -105,6 +360,7 @@ func (h *AdminController) GetUsersInfo(c *gin.Context) {
 // @Success 200 {object} map[string]string "status: status updated"
 // @Failure 400 {object} map[string]string "error: invalid input"
 // @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: api keys not allowed or privilege escalation"
 // @Failure 404 {object} map[string]string "error: user not found"
 // @Router /admin/{id}/status [patch]
 func (h *AdminController) ChangeUserStatus(c *gin.Context) {
@@ -119,7 +375,13 @@ func (h *AdminController) ChangeUserStatus(c *gin.Context) {
 		return
 	}
 
-	if err := h.changeStatus.Execute(c.Request.Context(), id, input.Status); err != nil {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.changeStatus.Execute(c.Request.Context(), claims.UserID, id, input.Status); err != nil {
 		helper.HandleError(c, err)
 		return
 	}
@@ -129,7 +391,7 @@ func (h *AdminController) ChangeUserStatus(c *gin.Context) {
 
 // ChangeUserRoles updates user's roles
 // @Summary Change User Roles
-// @Description Updates the list of roles for a user by ID
+// @Description Updates the list of roles for a user by ID. Callers can only grant or revoke roles whose permissions they hold themselves. Only system roles can be held globally, custom roles are granted through store memberships
 // @Tags Admin
 // @Security BearerAuth
 // @Accept json
@@ -139,11 +401,18 @@ func (h *AdminController) ChangeUserStatus(c *gin.Context) {
 // @Success 200 {object} map[string]string "roles: roles updated"
 // @Failure 400 {object} map[string]string "error: invalid input"
 // @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
 // @Failure 404 {object} map[string]string "error: user not found"
 // @Router /admin/{id}/roles [put]
 func (h *AdminController) ChangeUserRoles(c *gin.Context) {
 	id := c.Param("id")
 
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
 	var input struct {
 		Roles []string `json:"roles" binding:"required"`
 	}
@@ -153,10 +422,759 @@ func (h *AdminController) ChangeUserRoles(c *gin.Context) {
 		return
 	}
 
-	if err := h.changeRole.Execute(c.Request.Context(), id, input.Roles); err != nil {
+	if err := h.changeRole.Execute(c.Request.Context(), claims.UserID, id, input.Roles); err != nil {
 		helper.HandleError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"roles": "roles updated"})
 }
+
+// ResetUserMFA removes a user's second factor
+// @Summary Reset User MFA
+// @Description Deletes the user's TOTP secret and recovery codes, e.g. after a lost device
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} map[string]string "mfa: mfa reset"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Router /admin/{id}/mfa [delete]
+func (h *AdminController) ResetUserMFA(c *gin.Context) {
+	id := c.Param("id")
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.resetMFA.Execute(c.Request.Context(), claims.UserID, id); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"mfa": "mfa reset"})
+}
+
+// GetMFARequiredRoles lists the roles that must use MFA
+// @Summary List MFA Required Roles
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string][]string "roles"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Router /admin/mfa/roles [get]
+func (h *AdminController) GetMFARequiredRoles(c *gin.Context) {
+	roles, err := h.getMFARoles.Execute(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"roles": roles})
+}
+
+// SetRoleMFARequirement requires or releases MFA for a role
+// @Summary Set Role MFA Requirement
+// @Description Users with a role that requires MFA must enroll on their next login
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param role path string true "Role"
+// @Param requirement body object{required=bool} true "Whether MFA is required"
+// @Success 200 {object} map[string]string "mfa: requirement updated"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 422 {object} map[string]string "error: invalid role"
+// @Router /admin/mfa/roles/{role} [put]
+func (h *AdminController) SetRoleMFARequirement(c *gin.Context) {
+	role := c.Param("role")
+
+	var input struct {
+		Required *bool `json:"required" binding:"required"`
+	}
+
+	if err := c.ShouldBind(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.setRoleMFA.Execute(c.Request.Context(), role, *input.Required); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"mfa": "requirement updated"})
+}
+
+// RevokeUserSessions logs a user out of every device
+// @Summary Revoke User Sessions
+// @Description Revokes every session of the user by ID. Access tokens already issued stop working right away
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} map[string]string "sessions: sessions revoked"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Router /admin/{id}/sessions [delete]
+func (h *AdminController) RevokeUserSessions(c *gin.Context) {
+	id := c.Param("id")
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.revokeAll.Execute(c.Request.Context(), claims.UserID, id); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sessions": "sessions revoked"})
+}
+
+// UnlockUser lifts a user's lockout
+// @Summary Unlock User
+// @Description Releases a locked account before the lockout expires and clears its failed login attempts
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} map[string]string "message: user unlocked"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: api keys not allowed or privilege escalation"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Router /admin/{id}/unlock [post]
+func (h *AdminController) UnlockUser(c *gin.Context) {
+	id := c.Param("id")
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.unlockUser.Execute(c.Request.Context(), claims.UserID, id); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "user unlocked"})
+}
+
+// StartImpersonation lets an admin act as another user
+// @Summary Start Impersonation
+// @Description Issues a short-lived access token acting as the user, optionally inside one of their stores, so support can see what they see. The token names the admin as impersonator, comes without a refresh token and cannot change the user's password, MFA, profile, sessions or roles. Start and end are recorded in the audit log
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param startImpersonationInput body dto.StartImpersonationInput true "Reason and optional store"
+// @Success 201 {object} dto.ImpersonationResult
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: user cannot be impersonated or beyond your permissions"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Router /admin/{id}/impersonate [post]
+func (h *AdminController) StartImpersonation(c *gin.Context) {
+	id := c.Param("id")
+
+	var input dto.StartImpersonationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	result, err := h.impersonate.Execute(c.Request.Context(), claims.UserID, id, input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// EndImpersonation ends an impersonation before it expires
+// @Summary End Impersonation
+// @Description Ends an impersonation; its token is rejected from the next request on. Call it with the admin's own session, not with the impersonation token
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Impersonation ID"
+// @Success 200 {object} map[string]string "message: impersonation ended"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: impersonation not found or already ended"
+// @Router /admin/impersonations/{id} [delete]
+func (h *AdminController) EndImpersonation(c *gin.Context) {
+	id := c.Param("id")
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.endImpersonate.Execute(c.Request.Context(), claims.UserID, id); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "impersonation ended"})
+}
+
+// CreateAPIKey issues a scoped API key bound to a store
+// @Summary Create API Key
+// @Description Creates an API key for an integration or script. The key is only returned in this response; store it safely. Callers must manage API keys in the key's store and hold there the permission behind every scope
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param createAPIKeyInput body dto.CreateAPIKeyInput true "Key name, store, scopes and optional expiry"
+// @Success 201 {object} dto.CreatedAPIKey
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 422 {object} map[string]string "error: invalid scope or expiry"
+// @Router /admin/api-keys [post]
+func (h *AdminController) CreateAPIKey(c *gin.Context) {
+	var input dto.CreateAPIKeyInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	created, err := h.createAPIKey.Execute(c.Request.Context(), claims.UserID, input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// ListAPIKeys lists API keys without their secrets
+// @Summary List API Keys
+// @Description Lists API keys, newest first, optionally filtered by store
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param store_id query string false "Store ID"
+// @Success 200 {array} dto.APIKeyInfo
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Router /admin/api-keys [get]
+func (h *AdminController) ListAPIKeys(c *gin.Context) {
+	keys, err := h.listAPIKeys.Execute(c.Request.Context(), c.Query("store_id"))
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, keys)
+}
+
+// RevokeAPIKey permanently disables an API key
+// @Summary Revoke API Key
+// @Description Revokes an API key by ID; requests using it are rejected immediately
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "API Key ID"
+// @Success 200 {object} map[string]string "message: api key revoked"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: api key not found"
+// @Failure 409 {object} map[string]string "error: api key already revoked"
+// @Router /admin/api-keys/{id} [delete]
+func (h *AdminController) RevokeAPIKey(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.revokeAPIKey.Execute(c.Request.Context(), claims.UserID, c.Param("id")); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "api key revoked"})
+}
+
+// CreateRole defines a custom role for a store
+// @Summary Create Role
+// @Description Creates a store-defined role. Its permissions cannot exceed those of the caller, and its name cannot be taken by another store
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param createRoleInput body dto.CreateRoleInput true "Role name, store, description and permissions"
+// @Success 201 {object} dto.RoleInfo
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 409 {object} map[string]string "error: role already exists in this or another store"
+// @Failure 422 {object} map[string]string "error: invalid name or permission"
+// @Router /admin/roles [post]
+func (h *AdminController) CreateRole(c *gin.Context) {
+	var input dto.CreateRoleInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	role, err := h.createRole.Execute(c.Request.Context(), claims.UserID, input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, role)
+}
+
+// ListRoles lists the role catalog
+// @Summary List Roles
+// @Description Lists the system roles and, when store_id is given, the custom roles of that store
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param store_id query string false "Store ID"
+// @Success 200 {array} dto.RoleInfo
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Router /admin/roles [get]
+func (h *AdminController) ListRoles(c *gin.Context) {
+	roles, err := h.listRoles.Execute(c.Request.Context(), c.Query("store_id"))
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+// UpdateRole changes the permissions of a custom role
+// @Summary Update Role
+// @Description Replaces the description and permissions of a custom role. System roles cannot be changed
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param name path string true "Role name"
+// @Param updateRoleInput body dto.UpdateRoleInput true "Description and permissions"
+// @Success 200 {object} dto.RoleInfo
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: system role or privilege escalation"
+// @Failure 404 {object} map[string]string "error: role not found"
+// @Router /admin/roles/{name} [put]
+func (h *AdminController) UpdateRole(c *gin.Context) {
+	var input dto.UpdateRoleInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	role, err := h.updateRole.Execute(c.Request.Context(), claims.UserID, c.Param("name"), input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, role)
+}
+
+// DeleteRole removes a custom role nobody holds
+// @Summary Delete Role
+// @Description Deletes a custom role. Users holding it must be moved to another role first
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param name path string true "Role name"
+// @Success 200 {object} map[string]string "message: role deleted"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: system role"
+// @Failure 404 {object} map[string]string "error: role not found"
+// @Failure 409 {object} map[string]string "error: role in use"
+// @Router /admin/roles/{name} [delete]
+func (h *AdminController) DeleteRole(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.deleteRole.Execute(c.Request.Context(), claims.UserID, c.Param("name")); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "role deleted"})
+}
+
+// AddStoreMember gives a user roles in one store
+// @Summary Add Store Member
+// @Description Makes a user a member of a store with system roles or the store's own custom roles. The caller must manage members in that store and hold every permission of the granted roles
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Param addStoreMemberInput body dto.AddStoreMemberInput true "User and roles"
+// @Success 201 {object} dto.StoreMemberInfo
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Failure 409 {object} map[string]string "error: already a member"
+// @Failure 422 {object} map[string]string "error: invalid role"
+// @Router /admin/stores/{store_id}/members [post]
+func (h *AdminController) AddStoreMember(c *gin.Context) {
+	var input dto.AddStoreMemberInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	member, err := h.addMember.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"), input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, member)
+}
+
+// ListStoreMembers lists the members of a store
+// @Summary List Store Members
+// @Description Lists the members of a store with their roles and status
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Success 200 {array} dto.StoreMemberInfo
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: store outside actor permissions"
+// @Router /admin/stores/{store_id}/members [get]
+func (h *AdminController) ListStoreMembers(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	members, err := h.listMembers.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"))
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, members)
+}
+
+// UpdateStoreMember changes the roles or status of a store member
+// @Summary Update Store Member
+// @Description Replaces the roles of a member and, when status is given, suspends or reactivates the membership. Suspended members cannot switch into or act in the store
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Param user_id path string true "User ID"
+// @Param updateStoreMemberInput body dto.UpdateStoreMemberInput true "Roles and optional status (ACTIVE, SUSPENDED)"
+// @Success 200 {object} dto.StoreMemberInfo
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 404 {object} map[string]string "error: membership not found"
+// @Router /admin/stores/{store_id}/members/{user_id} [put]
+func (h *AdminController) UpdateStoreMember(c *gin.Context) {
+	var input dto.UpdateStoreMemberInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	member, err := h.updateMember.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"), c.Param("user_id"), input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, member)
+}
+
+// RemoveStoreMember removes a user from a store
+// @Summary Remove Store Member
+// @Description Deletes the membership of a user in a store. Sessions switched into the store lose its roles on their next request
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Param user_id path string true "User ID"
+// @Success 200 {object} map[string]string "message: member removed"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 404 {object} map[string]string "error: membership not found"
+// @Router /admin/stores/{store_id}/members/{user_id} [delete]
+func (h *AdminController) RemoveStoreMember(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.removeMember.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"), c.Param("user_id")); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "member removed"})
+}
+
+// InviteUser invites someone by email to join a store
+// @Summary Invite User
+// @Description Emails a single-use invite link. Accepting it creates an account that is a member of the store with the given roles; a registered user accepts it from their account and only gets the membership. The caller must manage members in that store and hold every permission of the invited roles
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Param inviteUserInput body dto.InviteUserInput true "Email and roles"
+// @Success 201 {object} dto.InvitationInfo
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 409 {object} map[string]string "error: user is already a member of this store"
+// @Failure 422 {object} map[string]string "error: invalid role"
+// @Router /admin/stores/{store_id}/invitations [post]
+func (h *AdminController) InviteUser(c *gin.Context) {
+	var input dto.InviteUserInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	invitation, err := h.invite.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"), input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// ListInvitations lists the invitations of a store
+// @Summary List Invitations
+// @Description Lists the invitations sent for a store, including accepted, revoked and expired ones
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Success 200 {array} dto.InvitationInfo
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: store outside actor permissions"
+// @Router /admin/stores/{store_id}/invitations [get]
+func (h *AdminController) ListInvitations(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	invitations, err := h.listInvites.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"))
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+// RevokeInvitation cancels a pending invitation
+// @Summary Revoke Invitation
+// @Description Revokes a pending invitation so its link can no longer be accepted
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Param id path string true "Invitation ID"
+// @Success 200 {object} map[string]string "message: invitation revoked"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 404 {object} map[string]string "error: invitation not found"
+// @Failure 409 {object} map[string]string "error: invitation not pending"
+// @Router /admin/stores/{store_id}/invitations/{id} [delete]
+func (h *AdminController) RevokeInvitation(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.revokeInvite.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"), c.Param("id")); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "invitation revoked"})
+}
+
+// GetStorePasswordPolicy returns the password policy of a store
+// @Summary Get Store Password Policy
+// @Description Returns how many former passwords the members of a store cannot reuse. A store without a policy reports 0 and the server default applies
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Success 200 {object} dto.StorePasswordPolicyInfo
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: store outside actor permissions"
+// @Failure 422 {object} map[string]string "error: invalid store"
+// @Router /admin/stores/{store_id}/password-policy [get]
+func (h *AdminController) GetStorePasswordPolicy(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	policy, err := h.getPwdPolicy.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"))
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// SetStorePasswordPolicy sets the password policy of a store
+// @Summary Set Store Password Policy
+// @Description Sets how many former passwords (0 to 24, counting the current one) the members of a store cannot reuse. Users in several stores follow the largest value
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param store_id path string true "Store ID"
+// @Param setStorePasswordPolicyInput body dto.SetStorePasswordPolicyInput true "History size"
+// @Success 200 {object} dto.StorePasswordPolicyInfo
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: privilege escalation"
+// @Failure 422 {object} map[string]string "error: invalid history size"
+// @Router /admin/stores/{store_id}/password-policy [put]
+func (h *AdminController) SetStorePasswordPolicy(c *gin.Context) {
+	var input dto.SetStorePasswordPolicyInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	policy, err := h.setPwdPolicy.Execute(c.Request.Context(), claims.UserID, c.Param("store_id"), input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// ListAuditEvents returns the audit log
+// @Summary List Audit Events
+// @Description Returns security-relevant events, newest first: logins, failed logins, lockouts, token rotations, password changes and role, status and membership changes
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param actor_id query string false "User who performed the action"
+// @Param target_id query string false "User the action was performed on"
+// @Param action query string false "Event action, e.g. auth.login.failed"
+// @Param from query string false "Start of the range, inclusive (RFC 3339)"
+// @Param to query string false "End of the range, exclusive (RFC 3339)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 10)"
+// @Success 200 {object} _common.PaginatedResult[dto.AuditEventInfo] "Paginated audit events"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 422 {object} map[string]string "error: invalid audit filter"
+// @Router /admin/audit [get]
+func (h *AdminController) ListAuditEvents(c *gin.Context) {
+	query := dto.AuditQuery{
+		ActorID:  c.Query("actor_id"),
+		TargetID: c.Query("target_id"),
+		Action:   c.Query("action"),
+		From:     c.Query("from"),
+		To:       c.Query("to"),
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	pagination := common.NewPagination(page, pageSize, "", "occurred_at", "DESC")
+
+	events, err := h.listAudit.Execute(c.Request.Context(), query, pagination)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
@@ -1,9 +1,11 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
 	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/middleware"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
@@ -19,13 +21,27 @@ const (
 )
 
 type AuthController struct {
-	tokenManager     security.TokenManager
-	loginUC          security.LoginUseCase
-	refreshTokenUC   security.RotateRefreshTokenUseCase
-	forgotPassword   security.ForgotPasswordUseCase
-	changePasswordUC security.ChangePasswordUseCase
-	logoutUC         security.LogoutUseCase
-	rateLimit        ports.RateLimiterRepository
+	tokenManager         security.TokenManager
+	loginUC              security.LoginUseCase
+	refreshTokenUC       security.RotateRefreshTokenUseCase
+	forgotPassword       security.ForgotPasswordUseCase
+	resetPasswordUC      security.ResetPasswordUseCase
+	changePasswordUC     security.ChangePasswordUseCase
+	logoutUC             security.LogoutUseCase
+	verifyEmailUC        security.VerifyEmailUseCase
+	unlockAccountUC      security.UnlockAccountUseCase
+	resendVerificationUC security.ResendVerificationEmailUseCase
+	verifyMFALoginUC     security.VerifyMFALoginUseCase
+	startMFAUC           security.StartMFAEnrollmentUseCase
+	confirmMFAUC         security.ConfirmMFAEnrollmentUseCase
+	switchStoreUC        security.SwitchStoreUseCase
+	requestMagicLinkUC   security.RequestMagicLinkUseCase
+	consumeMagicLinkUC   security.ConsumeMagicLinkUseCase
+	rateLimit            ports.RateLimiterRepository
+	resendRateLimit      ports.RateLimiterRepository
+	tokenVersions        ports.TokenVersionRepository
+	impersonations       ports.ImpersonationRepository
+	apiKeys              security.AuthenticateAPIKeyUseCase
 }
 
 func NewLoginController(
@@ -33,46 +49,96 @@ func NewLoginController(
 	loginUC security.LoginUseCase,
 	refreshToken security.RotateRefreshTokenUseCase,
 	forgotPassword security.ForgotPasswordUseCase,
+	resetPassword security.ResetPasswordUseCase,
 	changePasswordUseCase security.ChangePasswordUseCase,
 	logout security.LogoutUseCase,
+	verifyEmail security.VerifyEmailUseCase,
+	unlockAccount security.UnlockAccountUseCase,
+	resendVerification security.ResendVerificationEmailUseCase,
+	verifyMFALogin security.VerifyMFALoginUseCase,
+	startMFA security.StartMFAEnrollmentUseCase,
+	confirmMFA security.ConfirmMFAEnrollmentUseCase,
+	switchStore security.SwitchStoreUseCase,
+	requestMagicLink security.RequestMagicLinkUseCase,
+	consumeMagicLink security.ConsumeMagicLinkUseCase,
 	rateLimit ports.RateLimiterRepository,
+	resendRateLimit ports.RateLimiterRepository,
+	tokenVersions ports.TokenVersionRepository,
+	impersonations ports.ImpersonationRepository,
+	apiKeys security.AuthenticateAPIKeyUseCase,
 ) *AuthController {
 	return &AuthController{
-		tokenManager:     tokenManager,
-		loginUC:          loginUC,
-		refreshTokenUC:   refreshToken,
-		forgotPassword:   forgotPassword,
-		changePasswordUC: changePasswordUseCase,
-		logoutUC:         logout,
-		rateLimit:        rateLimit,
+		tokenManager:         tokenManager,
+		loginUC:              loginUC,
+		refreshTokenUC:       refreshToken,
+		forgotPassword:       forgotPassword,
+		resetPasswordUC:      resetPassword,
+		changePasswordUC:     changePasswordUseCase,
+		logoutUC:             logout,
+		verifyEmailUC:        verifyEmail,
+		unlockAccountUC:      unlockAccount,
+		resendVerificationUC: resendVerification,
+		verifyMFALoginUC:     verifyMFALogin,
+		startMFAUC:           startMFA,
+		confirmMFAUC:         confirmMFA,
+		switchStoreUC:        switchStore,
+		requestMagicLinkUC:   requestMagicLink,
+		consumeMagicLinkUC:   consumeMagicLink,
+		rateLimit:            rateLimit,
+		resendRateLimit:      resendRateLimit,
+		tokenVersions:        tokenVersions,
+		impersonations:       impersonations,
+		apiKeys:              apiKeys,
 	}
 }
 
 func (h *AuthController) RegisterRoutes(router *gin.RouterGroup) {
 	authRoutes := router.Group("/auth")
+	authRoutes.Use(middleware.CaptureClientInfo())
 	authRoutes.Use(middleware.RateLimit(h.rateLimit))
 	{
 		authRoutes.POST("/login", h.Login)
-		authRoutes.GET("/refresh", h.RefreshToken)
+		authRoutes.POST("/login/mfa", h.VerifyMFALogin)
+		authRoutes.POST("/refresh", h.RefreshToken)
 		authRoutes.POST("/forgot-password", h.ForgotPassword)
+		authRoutes.POST("/reset-password", h.ResetPassword)
+		authRoutes.GET("/verify-email", h.VerifyEmail)
+		authRoutes.GET("/unlock", h.UnlockAccount)
+		authRoutes.POST("/verify-email/resend", middleware.RateLimit(h.resendRateLimit), h.ResendVerificationEmail)
+		authRoutes.POST("/magic-link", middleware.RateLimit(h.resendRateLimit), h.RequestMagicLink)
+		authRoutes.GET("/magic-link/consume", h.ConsumeMagicLink)
 	}
 
+	// Switching stores rotates the refresh token, whose cookie is only sent
+	// below /auth/refresh, so the route lives there instead of under
+	// /private/auth.
+	authRoutes.POST("/refresh/store",
+		middleware.RequireAuth(h.tokenManager, h.tokenVersions, h.impersonations, h.apiKeys),
+		middleware.RequireCSRF(),
+		middleware.ForbidImpersonation(),
+		h.SwitchStore,
+	)
+
 	authPrivates := authRoutes.Group("/private/auth")
-	authPrivates.Use(middleware.RequireAuth(h.tokenManager))
+	authPrivates.Use(middleware.RequireAuth(h.tokenManager, h.tokenVersions, h.impersonations, h.apiKeys))
+	authPrivates.Use(middleware.RequireCSRF())
 	{
-		authPrivates.GET("/logout", h.Logout)
-		authPrivates.POST("/change-password", h.ChangePassword)
+		authPrivates.POST("/logout", h.Logout)
+		authPrivates.POST("/change-password", middleware.ForbidImpersonation(), h.ChangePassword)
+		authPrivates.POST("/mfa/enroll", middleware.ForbidImpersonation(), h.StartMFAEnrollment)
+		authPrivates.POST("/mfa/confirm", middleware.ForbidImpersonation(), h.ConfirmMFAEnrollment)
 	}
 }
 
 // Login handles user authentication
 // @Summary User Login
-// @Description Authenticates user and sets session cookies
+// @Description Authenticates user and sets session cookies, or returns the tokens in the body when the X-Token-Delivery header is "body". When the user has MFA enabled, or a role that requires it, no cookies are set and an MFA challenge token is returned instead
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param loginRequest body dto.LoginRequest true "Login Credentials"
 // @Success 200 {object} map[string]string "message: login successfully"
+// @Success 202 {object} map[string]interface{} "mfa_required, mfa_challenge_token, mfa_enrollment"
 // @Failure 400 {object} map[string]string "error: invalid input"
 // @Failure 401 {object} map[string]string "error: invalid credentials"
 // @Router /auth/login [post]
@@ -84,44 +150,181 @@ func (h *AuthController) Login(c *gin.Context) {
 		return
 	}
 
-	loginResult, err := h.loginUC.Execute(c.Request.Context(), &input)
+	loginResult, err := h.loginUC.Execute(c.Request.Context(), &input, helper.ExtractClientInfo(c))
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	respondWithLogin(c, loginResult)
+}
+
+// RequestMagicLink emails a single-use login link
+// @Summary Request Magic Link
+// @Description Emails a short-lived link that logs the user in without a password. The response is the same whether or not the address is registered
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param magicLinkInput body object{email=string} true "Email to send the link to"
+// @Success 200 {object} map[string]string "message: if the email is registered, a login link was sent"
+// @Failure 400 {object} map[string]string "error: invalid email"
+// @Failure 429 {object} map[string]string "error: too many requests"
+// @Router /auth/magic-link [post]
+func (h *AuthController) RequestMagicLink(c *gin.Context) {
+	var magicLinkInput struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+
+	if err := c.ShouldBind(&magicLinkInput); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.requestMagicLinkUC.Execute(c.Request.Context(), magicLinkInput.Email); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "if the email is registered, a login link was sent"})
+}
+
+// ConsumeMagicLink logs the user in from an emailed link
+// @Summary Consume Magic Link
+// @Description Exchanges the token of a magic link for session cookies, like a password login. The link works once. When the user has MFA enabled, or a role that requires it, an MFA challenge token is returned instead
+// @Tags Auth
+// @Produce json
+// @Param token query string true "Magic link token"
+// @Success 200 {object} map[string]string "message: login successfully"
+// @Success 202 {object} map[string]interface{} "mfa_required, mfa_challenge_token, mfa_enrollment"
+// @Failure 400 {object} map[string]string "error: token is required"
+// @Failure 401 {object} map[string]string "error: invalid, expired or already used login link"
+// @Failure 429 {object} map[string]string "error: user is blocked"
+// @Router /auth/magic-link/consume [get]
+func (h *AuthController) ConsumeMagicLink(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	loginResult, err := h.consumeMagicLinkUC.Execute(c.Request.Context(), token, helper.ExtractClientInfo(c))
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	respondWithLogin(c, loginResult)
+}
+
+// VerifyMFALogin completes a login that requires a second factor
+// @Summary MFA Login
+// @Description Exchanges the MFA challenge token and a TOTP or recovery code for session cookies. Recovery codes are returned once when the login also confirmed a pending enrollment
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param mfaLoginInput body object{mfa_challenge_token=string,code=string} true "Challenge token and code"
+// @Success 200 {object} map[string]interface{} "message: login successfully"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: invalid mfa code or challenge"
+// @Router /auth/login/mfa [post]
+func (h *AuthController) VerifyMFALogin(c *gin.Context) {
+	var mfaLoginInput struct {
+		ChallengeToken string `json:"mfa_challenge_token" binding:"required"`
+		Code           string `json:"code" binding:"required"`
+	}
+
+	if err := c.ShouldBind(&mfaLoginInput); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	loginResult, err := h.verifyMFALoginUC.Execute(c.Request.Context(), mfaLoginInput.ChallengeToken, mfaLoginInput.Code, helper.ExtractClientInfo(c))
 	if err != nil {
 		helper.HandleError(c, err)
 		return
 	}
 
-	c.SetCookie(AccessTokenKey, loginResult.AccessToken, AccessTokenDuration, "/", "", false, true)
-	c.SetCookie(RefreshTokenKey, loginResult.RefreshToken, RefreshTokenDuration, "/auth/refresh", "", false, true)
+	response := gin.H{"message": "login successfully"}
+	if len(loginResult.RecoveryCodes) > 0 {
+		response["recovery_codes"] = loginResult.RecoveryCodes
+	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "login successfully"})
+	respondWithSession(c, loginResult.AccessToken, loginResult.RefreshToken, response)
 }
 
 // RefreshToken rotates tokens using refresh cookie
 // @Summary Refresh Tokens
-// @Description Rotates Access and Refresh tokens
+// @Description Rotates Access and Refresh tokens. Clients using body delivery send the refresh token in the X-Refresh-Token header
 // @Tags Auth
 // @Produce json
 // @Success 200 {object} map[string]string "message: refresh token successfully"
 // @Failure 400 {object} map[string]string "error: refresh_token cookie not found"
-// @Failure 401 {object} map[string]string "error: invalid session"
-// @Router /auth/refresh [get]
+// @Failure 401 {object} map[string]string "error: invalid session or refresh token reuse detected"
+// @Router /auth/refresh [post]
 func (h *AuthController) RefreshToken(c *gin.Context) {
-	refreshToken, err := c.Cookie("refresh_token")
+	refreshToken, ok := extractRefreshToken(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "refresh_token cookie not found"})
+		return
+	}
+
+	tokens, err := h.refreshTokenUC.Execute(c.Request.Context(), refreshToken, helper.ExtractClientInfo(c))
 	if err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenReused) || errors.Is(err, entity.ErrSessionRevoked) {
+			clearSessionCookies(c)
+		}
+
+		helper.HandleError(c, err)
+		return
+	}
+
+	respondWithSession(c, tokens.AccessToken, tokens.RefreshToken, gin.H{"message": "refresh token successfully"})
+}
+
+// SwitchStore changes the active store of the session
+// @Summary Switch Active Store
+// @Description Rotates the session into a store the user is an active member of and re-issues the tokens with the store in the claims, so the roles of that membership apply. An empty store_id returns to the global roles. Clients using body delivery send the refresh token in the X-Refresh-Token header
+// @Tags Auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param switchStoreInput body dto.SwitchStoreInput true "Store to switch into"
+// @Success 200 {object} map[string]string "message: store switched successfully"
+// @Failure 400 {object} map[string]string "error: refresh_token cookie not found"
+// @Failure 401 {object} map[string]string "error: invalid session or refresh token reuse detected"
+// @Failure 403 {object} map[string]string "error: not an active member of this store"
+// @Router /auth/refresh/store [post]
+func (h *AuthController) SwitchStore(c *gin.Context) {
+	var input dto.SwitchStoreInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	refreshToken, ok := extractRefreshToken(c)
+	if !ok {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "refresh_token cookie not found"})
 		return
 	}
 
-	tokens, err := h.refreshTokenUC.Execute(c.Request.Context(), refreshToken)
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
 	if err != nil {
 		helper.HandleError(c, err)
 		return
 	}
 
-	c.SetCookie(AccessTokenKey, tokens.AccessToken, AccessTokenDuration, "/", "", false, true)
-	c.SetCookie(RefreshTokenKey, tokens.RefreshToken, RefreshTokenDuration, "/auth/refresh", "", false, true)
+	tokens, err := h.switchStoreUC.Execute(c.Request.Context(), claims.UserID, input.StoreID, refreshToken, helper.ExtractClientInfo(c))
+	if err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenReused) || errors.Is(err, entity.ErrSessionRevoked) {
+			clearSessionCookies(c)
+		}
+
+		helper.HandleError(c, err)
+		return
+	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "refresh token successfully"})
+	respondWithSession(c, tokens.AccessToken, tokens.RefreshToken, gin.H{"message": "store switched successfully"})
 }
 
 // Logout clears session cookies
@@ -131,18 +334,17 @@ func (h *AuthController) RefreshToken(c *gin.Context) {
 // @Security BearerAuth
 // @Produce json
 // @Success 200 {object} map[string]string "message: logout successfully"
-// @Router /private/auth/logout [get]
+// @Router /private/auth/logout [post]
 func (h *AuthController) Logout(c *gin.Context) {
-	refreshToken, err := c.Cookie(RefreshTokenKey)
-	if err == nil {
+	refreshToken, ok := extractRefreshToken(c)
+	if ok {
 		if err := h.logoutUC.Execute(c.Request.Context(), refreshToken); err != nil {
 			helper.HandleError(c, err)
 			return
 		}
 	}
 
-	c.SetCookie(AccessTokenKey, "", -1, "/", "", false, true)
-	c.SetCookie(RefreshTokenKey, "", -1, "/auth/refresh", "", false, true)
+	clearSessionCookies(c)
 
 	c.JSON(http.StatusOK, gin.H{"message": "logout successfully"})
 }
@@ -175,9 +377,121 @@ func (h *AuthController) ForgotPassword(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "email sent successfully"})
 }
 
+// ResetPassword sets a new password using the forgot-password OTP
+// @Summary Reset Password
+// @Description Validates the emailed OTP, sets the new password and revokes every session
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param resetPasswordInput body object{email=string,otp=string,new_password=string} true "Email, OTP and new password"
+// @Success 200 {object} map[string]string "message: password successfully reset"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: invalid or expired otp"
+// @Failure 422 {object} map[string]string "error: validation failed"
+// @Router /auth/reset-password [post]
+func (h *AuthController) ResetPassword(c *gin.Context) {
+	var resetPasswordInput struct {
+		Email       string `json:"email" binding:"required,email"`
+		OTP         string `json:"otp" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required,min=8"`
+	}
+
+	if err := c.ShouldBind(&resetPasswordInput); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err := h.resetPasswordUC.Execute(c.Request.Context(), resetPasswordInput.Email, resetPasswordInput.OTP, resetPasswordInput.NewPassword)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "password successfully reset"})
+}
+
+// VerifyEmail confirms the user's email address
+// @Summary Verify Email
+// @Description Marks the email as verified using the token sent by email
+// @Tags Auth
+// @Produce json
+// @Param token query string true "Verification token"
+// @Success 200 {object} map[string]string "message: email verified successfully"
+// @Failure 400 {object} map[string]string "error: token is required"
+// @Failure 401 {object} map[string]string "error: invalid or expired token"
+// @Router /auth/verify-email [get]
+func (h *AuthController) VerifyEmail(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	if err := h.verifyEmailUC.Execute(c.Request.Context(), token); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "email verified successfully"})
+}
+
+// UnlockAccount lifts a lockout from the link in the lock notice
+// @Summary Unlock Account
+// @Description Unlocks the account using the token emailed when it was locked. The link only works for the lockout it was sent for
+// @Tags Auth
+// @Produce json
+// @Param token query string true "Unlock token"
+// @Success 200 {object} map[string]string "message: account unlocked"
+// @Failure 400 {object} map[string]string "error: token is required"
+// @Failure 401 {object} map[string]string "error: invalid or already used unlock link"
+// @Router /auth/unlock [get]
+func (h *AuthController) UnlockAccount(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	if err := h.unlockAccountUC.Execute(c.Request.Context(), token); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "account unlocked"})
+}
+
+// ResendVerificationEmail sends a new verification link
+// @Summary Resend Verification Email
+// @Description Sends a new verification link if the address belongs to an unverified user
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param resendInput body object{email=string} true "Email to verify"
+// @Success 200 {object} map[string]string "message: verification email sent"
+// @Failure 400 {object} map[string]string "error: invalid email"
+// @Failure 429 {object} map[string]string "error: too many requests"
+// @Router /auth/verify-email/resend [post]
+func (h *AuthController) ResendVerificationEmail(c *gin.Context) {
+	var resendInput struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+
+	if err := c.ShouldBind(&resendInput); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.resendVerificationUC.Execute(c.Request.Context(), resendInput.Email); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "verification email sent"})
+}
+
 // ChangePassword changes user's password
 // @Summary Change Password
-// @Description Updates user's password with a new one
+// @Description Updates user's password with a new one and ends every session, including this one. Accounts created without a password, such as imported ones, set their first one with an empty old_password
 // @Tags Auth
 // @Security BearerAuth
 // @Accept json
@@ -186,10 +500,11 @@ func (h *AuthController) ForgotPassword(c *gin.Context) {
 // @Success 200 {object} map[string]string "message: password successfully changed"
 // @Failure 400 {object} map[string]string "error: invalid input"
 // @Failure 401 {object} map[string]string "error: unauthorized or invalid old password"
+// @Failure 403 {object} map[string]string "message: forbidden: invalid csrf token"
 // @Router /private/auth/change-password [post]
 func (h *AuthController) ChangePassword(c *gin.Context) {
 	var changePasswordInput struct {
-		OldPassword string `json:"old_password" binding:"required"`
+		OldPassword string `json:"old_password"`
 		NewPassword string `json:"new_password" binding:"required,min=8"`
 	}
 
@@ -210,5 +525,71 @@ func (h *AuthController) ChangePassword(c *gin.Context) {
 		return
 	}
 
+	clearSessionCookies(c)
+
 	c.JSON(http.StatusOK, gin.H{"message": "password successfully changed"})
 }
+
+// StartMFAEnrollment generates a new TOTP secret for the user
+// @Summary Start MFA Enrollment
+// @Description Generates a pending TOTP secret and its otpauth:// URI. MFA is only enabled after the confirm step
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.MFAEnrollment
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 409 {object} map[string]string "error: mfa already enabled"
+// @Router /private/auth/mfa/enroll [post]
+func (h *AuthController) StartMFAEnrollment(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	enrollment, err := h.startMFAUC.Execute(c.Request.Context(), claims.UserID)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, enrollment)
+}
+
+// ConfirmMFAEnrollment enables MFA with the first code from the authenticator
+// @Summary Confirm MFA Enrollment
+// @Description Enables MFA and returns single-use recovery codes, which are shown only once
+// @Tags Auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param confirmInput body object{code=string} true "TOTP code"
+// @Success 200 {object} map[string][]string "recovery_codes"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: invalid mfa code"
+// @Failure 404 {object} map[string]string "error: mfa not enrolled"
+// @Router /private/auth/mfa/confirm [post]
+func (h *AuthController) ConfirmMFAEnrollment(c *gin.Context) {
+	var confirmInput struct {
+		Code string `json:"code" binding:"required"`
+	}
+
+	if err := c.ShouldBind(&confirmInput); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	recoveryCodes, err := h.confirmMFAUC.Execute(c.Request.Context(), claims.UserID, confirmInput.Code)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": recoveryCodes})
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// TokenDeliveryHeader lets clients that cannot keep cookies, such as
+	// mobile apps and CLI tools, ask for the tokens in the response body and
+	// send them back as "Authorization: Bearer" and X-Refresh-Token headers.
+	TokenDeliveryHeader = "X-Token-Delivery"
+	TokenDeliveryBody   = "body"
+	RefreshTokenHeader  = "X-Refresh-Token"
+)
+
+// respondWithSession delivers a new pair of tokens either in the response
+// body or, by default, in HttpOnly cookies together with a fresh CSRF token.
+func respondWithSession(c *gin.Context, accessToken, refreshToken string, body gin.H) {
+	if c.GetHeader(TokenDeliveryHeader) == TokenDeliveryBody {
+		body["access_token"] = accessToken
+		body["refresh_token"] = refreshToken
+		body["token_type"] = "Bearer"
+		body["expires_in"] = AccessTokenDuration
+
+		c.JSON(http.StatusOK, body)
+		return
+	}
+
+	if err := setSessionCookies(c, accessToken, refreshToken); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, body)
+}
+
+// respondWithLogin finishes a first-factor login: with the MFA challenge when
+// a second factor is still due, otherwise with the new session.
+func respondWithLogin(c *gin.Context, loginResult *dto.LoginResult) {
+	if loginResult.MFARequired() {
+		c.JSON(http.StatusAccepted, gin.H{
+			"mfa_required":        true,
+			"mfa_challenge_token": loginResult.MFAChallengeToken,
+			"mfa_enrollment":      loginResult.MFAEnrollment,
+		})
+		return
+	}
+
+	respondWithSession(c, loginResult.AccessToken, loginResult.RefreshToken, gin.H{"message": "login successfully"})
+}
+
+// setSessionCookies stores the tokens in HttpOnly cookies. The CSRF cookie is
+// readable by scripts on purpose: the frontend echoes it in the X-CSRF-Token
+// header on unsafe requests. The refresh token is only ever sent by the
+// frontend itself, so it is kept off every cross-site request; the access
+// token stays Lax so following a link into the app keeps the user logged in.
+func setSessionCookies(c *gin.Context, accessToken, refreshToken string) error {
+	csrfToken, err := helper.GenerateCSRFToken()
+	if err != nil {
+		return err
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(AccessTokenKey, accessToken, AccessTokenDuration, "/", "", false, true)
+	c.SetSameSite(http.SameSiteStrictMode)
+	c.SetCookie(RefreshTokenKey, refreshToken, RefreshTokenDuration, "/auth/refresh", "", false, true)
+	helper.SetCSRFCookie(c, csrfToken, RefreshTokenDuration)
+
+	return nil
+}
+
+func clearSessionCookies(c *gin.Context) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(AccessTokenKey, "", -1, "/", "", false, true)
+	c.SetSameSite(http.SameSiteStrictMode)
+	c.SetCookie(RefreshTokenKey, "", -1, "/auth/refresh", "", false, true)
+	helper.SetCSRFCookie(c, "", -1)
+}
+
+// extractRefreshToken reads the refresh token from its cookie or, for
+// clients using body delivery, from the X-Refresh-Token header.
+func extractRefreshToken(c *gin.Context) (string, bool) {
+	if token, err := c.Cookie(RefreshTokenKey); err == nil && token != "" {
+		return token, true
+	}
+
+	if token := c.GetHeader(RefreshTokenHeader); token != "" {
+		return token, true
+	}
+
+	return "", false
+}
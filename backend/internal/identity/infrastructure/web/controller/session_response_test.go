@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func cookiesByName(rec *httptest.ResponseRecorder) map[string]*http.Cookie {
+	cookies := make(map[string]*http.Cookie)
+	for _, cookie := range rec.Result().Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+
+	return cookies
+}
+
+func TestSetSessionCookies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+
+	err := setSessionCookies(c, "access", "refresh")
+	assert.NoError(t, err)
+
+	cookies := cookiesByName(rec)
+
+	access := cookies[AccessTokenKey]
+	if assert.NotNil(t, access) {
+		assert.Equal(t, "access", access.Value)
+		assert.Equal(t, "/", access.Path)
+		assert.True(t, access.HttpOnly)
+		assert.Equal(t, http.SameSiteLaxMode, access.SameSite)
+		assert.Equal(t, AccessTokenDuration, access.MaxAge)
+	}
+
+	refresh := cookies[RefreshTokenKey]
+	if assert.NotNil(t, refresh) {
+		assert.Equal(t, "refresh", refresh.Value)
+		assert.Equal(t, "/auth/refresh", refresh.Path)
+		assert.True(t, refresh.HttpOnly)
+		assert.Equal(t, http.SameSiteStrictMode, refresh.SameSite)
+		assert.Equal(t, RefreshTokenDuration, refresh.MaxAge)
+	}
+
+	csrf := cookies[helper.CSRFCookieKey]
+	if assert.NotNil(t, csrf) {
+		assert.NotEmpty(t, csrf.Value)
+		assert.False(t, csrf.HttpOnly, "the frontend must be able to read the csrf token")
+		assert.Equal(t, http.SameSiteLaxMode, csrf.SameSite)
+		assert.Equal(t, RefreshTokenDuration, csrf.MaxAge)
+	}
+}
+
+func TestClearSessionCookies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+
+	clearSessionCookies(c)
+
+	cookies := cookiesByName(rec)
+	for _, name := range []string{AccessTokenKey, RefreshTokenKey, helper.CSRFCookieKey} {
+		if assert.NotNil(t, cookies[name], name) {
+			assert.Empty(t, cookies[name].Value, name)
+			assert.Negative(t, cookies[name].MaxAge, name)
+		}
+	}
+	assert.Equal(t, "/auth/refresh", cookies[RefreshTokenKey].Path)
+}
+
+func TestExtractRefreshToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		cookie    string
+		header    string
+		wantToken string
+		wantOK    bool
+	}{
+		{name: "Cookie", cookie: "from-cookie", wantToken: "from-cookie", wantOK: true},
+		{name: "Header", header: "from-header", wantToken: "from-header", wantOK: true},
+		{name: "Cookie Wins Over Header", cookie: "from-cookie", header: "from-header", wantToken: "from-cookie", wantOK: true},
+		{name: "Nothing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: RefreshTokenKey, Value: tt.cookie})
+			}
+			if tt.header != "" {
+				req.Header.Set(RefreshTokenHeader, tt.header)
+			}
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = req
+
+			token, ok := extractRefreshToken(c)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantToken, token)
+		})
+	}
+}
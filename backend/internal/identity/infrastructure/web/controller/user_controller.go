@@ -2,7 +2,9 @@ package controller
 
 import (
 	"net/http"
+	"strconv"
 
+	"github.com/MuriloFlores/order-manager/internal/common"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
 	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/middleware"
@@ -13,44 +15,100 @@ import (
 )
 
 type UserController struct {
-	createUserUC user.CreateUserUseCase
-	getMyInfoUC  user.MyInfoUseCase
-	tokenManager security.TokenManager
-	rateLimit    ports.RateLimiterRepository
+	createUserUC        user.CreateUserUseCase
+	getMyInfoUC         user.MyInfoUseCase
+	updateProfileUC     user.UpdateProfileUseCase
+	confirmEmailUC      user.ConfirmEmailChangeUseCase
+	deleteAccountUC     user.DeleteAccountUseCase
+	exportMyDataUC      user.ExportMyDataUseCase
+	listSessionsUC      user.ListSessionsUseCase
+	revokeSessionUC     user.RevokeSessionUseCase
+	revokeAllSessionsUC user.RevokeAllSessionsUseCase
+	listLoginHistoryUC  user.ListLoginHistoryUseCase
+	listMyStoresUC      user.ListMyStoresUseCase
+	acceptInvitationUC  user.AcceptInvitationUseCase
+	acceptAsMemberUC    user.AcceptInvitationAsMemberUseCase
+	tokenManager        security.TokenManager
+	rateLimit           ports.RateLimiterRepository
+	tokenVersions       ports.TokenVersionRepository
+	impersonations      ports.ImpersonationRepository
+	apiKeys             security.AuthenticateAPIKeyUseCase
 }
 
 func NewUserHandle(
 	createUser user.CreateUserUseCase,
 	getMyInfoUC user.MyInfoUseCase,
+	updateProfile user.UpdateProfileUseCase,
+	confirmEmailChange user.ConfirmEmailChangeUseCase,
+	deleteAccount user.DeleteAccountUseCase,
+	exportMyData user.ExportMyDataUseCase,
+	listSessions user.ListSessionsUseCase,
+	revokeSession user.RevokeSessionUseCase,
+	revokeAllSessions user.RevokeAllSessionsUseCase,
+	listLoginHistory user.ListLoginHistoryUseCase,
+	listMyStores user.ListMyStoresUseCase,
+	acceptInvitation user.AcceptInvitationUseCase,
+	acceptAsMember user.AcceptInvitationAsMemberUseCase,
 	tokenManager security.TokenManager,
 	rateLimit ports.RateLimiterRepository,
+	tokenVersions ports.TokenVersionRepository,
+	impersonations ports.ImpersonationRepository,
+	apiKeys security.AuthenticateAPIKeyUseCase,
 ) *UserController {
 	return &UserController{
-		createUserUC: createUser,
-		getMyInfoUC:  getMyInfoUC,
-		tokenManager: tokenManager,
-		rateLimit:    rateLimit,
+		createUserUC:        createUser,
+		getMyInfoUC:         getMyInfoUC,
+		updateProfileUC:     updateProfile,
+		confirmEmailUC:      confirmEmailChange,
+		deleteAccountUC:     deleteAccount,
+		exportMyDataUC:      exportMyData,
+		listSessionsUC:      listSessions,
+		revokeSessionUC:     revokeSession,
+		revokeAllSessionsUC: revokeAllSessions,
+		listLoginHistoryUC:  listLoginHistory,
+		listMyStoresUC:      listMyStores,
+		acceptInvitationUC:  acceptInvitation,
+		acceptAsMemberUC:    acceptAsMember,
+		tokenManager:        tokenManager,
+		rateLimit:           rateLimit,
+		tokenVersions:       tokenVersions,
+		impersonations:      impersonations,
+		apiKeys:             apiKeys,
 	}
 }
 
 func (h *UserController) RegisterRoutes(router *gin.Engine) {
 	userRoutes := router.Group("/user")
+	userRoutes.Use(middleware.CaptureClientInfo())
 	userRoutes.Use(middleware.RateLimit(h.rateLimit))
 	{
 		userRoutes.POST("/", h.CreateUser)
+		userRoutes.POST("/invitations/accept", h.AcceptInvitation)
+		userRoutes.GET("/email/confirm", h.ConfirmEmailChange)
 	}
 
 	privateRoutes := router.Group("/private/user")
+	privateRoutes.Use(middleware.CaptureClientInfo())
 	userRoutes.Use(middleware.RateLimit(h.rateLimit))
-	privateRoutes.Use(middleware.RequireAuth(h.tokenManager))
+	privateRoutes.Use(middleware.RequireAuth(h.tokenManager, h.tokenVersions, h.impersonations, h.apiKeys))
+	privateRoutes.Use(middleware.RequireCSRF())
 	{
 		privateRoutes.GET("/me", h.MyInfo)
+		privateRoutes.PATCH("/me", middleware.ForbidImpersonation(), h.UpdateProfile)
+		privateRoutes.DELETE("/me", middleware.ForbidImpersonation(), h.DeleteAccount)
+		privateRoutes.GET("/me/export", middleware.ForbidImpersonation(), h.ExportMyData)
+		privateRoutes.GET("/sessions", h.ListSessions)
+		privateRoutes.DELETE("/sessions", middleware.ForbidImpersonation(), h.RevokeAllSessions)
+		privateRoutes.DELETE("/sessions/:id", middleware.ForbidImpersonation(), h.RevokeSession)
+		privateRoutes.GET("/login-history", h.ListLoginHistory)
+		privateRoutes.GET("/stores", h.ListMyStores)
+		privateRoutes.POST("/invitations/accept", middleware.ForbidImpersonation(), h.AcceptInvitationAsMember)
 	}
 }
 
 // CreateUser creates a new user
 // @Summary Create User
-// @Description Creates a new user with the provided information
+// @Description Creates a new user with the provided information. Self-registered users hold no roles; staff accounts are created through store invitations
 // @Tags User
 // @Accept json
 // @Produce json
@@ -75,9 +133,74 @@ func (h *UserController) CreateUser(c *gin.Context) {
 	c.JSON(http.StatusCreated, gin.H{"message": "user created successfully"})
 }
 
+// AcceptInvitation creates an account from a store invitation
+// @Summary Accept Invitation
+// @Description Creates a user for the invited email and makes it a member of the inviting store with the invited roles. The email is considered verified
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param acceptInvitationInput body dto.AcceptInvitationInput true "Invite token and account information"
+// @Success 201 {object} map[string]string "message: invitation accepted"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: invalid or expired invitation"
+// @Failure 409 {object} map[string]string "error: email already registered, accept from the account instead"
+// @Failure 422 {object} map[string]string "error: validation failed"
+// @Router /user/invitations/accept [post]
+func (h *UserController) AcceptInvitation(c *gin.Context) {
+	var input dto.AcceptInvitationInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.acceptInvitationUC.Execute(c.Request.Context(), input); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"message": "invitation accepted"})
+}
+
+// AcceptInvitationAsMember joins a store from an invitation sent to the caller
+// @Summary Accept Invitation As Existing User
+// @Description Makes the authenticated user a member of the inviting store with the invited roles. The invitation must have been sent to the user's email; the account itself is not changed
+// @Tags User
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param acceptInvitationAsMemberInput body dto.AcceptInvitationAsMemberInput true "Invite token"
+// @Success 201 {object} map[string]string "message: invitation accepted"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: invalid or expired invitation"
+// @Failure 403 {object} map[string]string "error: not allowed while impersonating"
+// @Failure 409 {object} map[string]string "error: already a member of this store"
+// @Router /private/user/invitations/accept [post]
+func (h *UserController) AcceptInvitationAsMember(c *gin.Context) {
+	var input dto.AcceptInvitationAsMemberInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.acceptAsMemberUC.Execute(c.Request.Context(), claims.UserID, input); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"message": "invitation accepted"})
+}
+
 // MyInfo returns the current authenticated user's information
 // @Summary Get Current User Info
-// @Description Returns the profile information of the currently authenticated user
+// @Description Returns the profile information of the currently authenticated user. While an admin impersonates the user, impersonated_by holds the ID of that admin
 // @Tags User
 // @Security BearerAuth
 // @Produce json
@@ -98,5 +221,268 @@ func (h *UserController) MyInfo(c *gin.Context) {
 		return
 	}
 
+	userData.ImpersonatedBy = claims.ImpersonatorID
+
 	c.JSON(http.StatusOK, userData)
 }
+
+// UpdateProfile changes the current user's profile
+// @Summary Update Current User Profile
+// @Description Changes only the fields sent. A new username applies at once; a new email is sent a confirmation link and replaces the current one only once confirmed. The current address is told about the requested change
+// @Tags User
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param updateProfileInput body dto.UpdateProfileInput true "Profile fields to change"
+// @Success 200 {object} dto.UpdateProfileResult "Updated profile and the email waiting for confirmation, if any"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 409 {object} map[string]string "error: username taken or email already registered"
+// @Failure 422 {object} map[string]string "error: validation failed"
+// @Router /private/user/me [patch]
+func (h *UserController) UpdateProfile(c *gin.Context) {
+	var input dto.UpdateProfileInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	result, err := h.updateProfileUC.Execute(c.Request.Context(), claims.UserID, input)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteAccount erases the current user's personal data
+// @Summary Delete Account
+// @Description Anonymizes the account: email, username and password are scrubbed, MFA and password history are deleted and every session is revoked. The account cannot be recovered. Records that refer to the user, such as the audit log, keep its ID
+// @Tags User
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param deleteAccountInput body dto.DeleteAccountInput true "Current password"
+// @Success 200 {object} map[string]string "message: account deleted"
+// @Failure 400 {object} map[string]string "error: invalid input"
+// @Failure 401 {object} map[string]string "error: invalid credentials"
+// @Router /private/user/me [delete]
+func (h *UserController) DeleteAccount(c *gin.Context) {
+	var input dto.DeleteAccountInput
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.deleteAccountUC.Execute(c.Request.Context(), claims.UserID, input.Password); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	clearSessionCookies(c)
+
+	c.JSON(http.StatusOK, gin.H{"message": "account deleted"})
+}
+
+// ExportMyData returns everything stored about the current user
+// @Summary Export Personal Data
+// @Description Returns a JSON archive of the profile, MFA status, sessions, store memberships, invitations received and audit events of the current user. Password hashes and MFA secrets are not included
+// @Tags User
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.PersonalDataExport "Personal data archive"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: not allowed while impersonating"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Router /private/user/me/export [get]
+func (h *UserController) ExportMyData(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	export, err := h.exportMyDataUC.Execute(c.Request.Context(), claims.UserID)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="personal-data.json"`)
+	c.JSON(http.StatusOK, export)
+}
+
+// ConfirmEmailChange applies a requested email change
+// @Summary Confirm Email Change
+// @Description Replaces the user's email with the address the confirmation link was sent to. Links issued before a later change no longer work
+// @Tags User
+// @Produce json
+// @Param token query string true "Email change token"
+// @Success 200 {object} map[string]string "message: email changed successfully"
+// @Failure 400 {object} map[string]string "error: token is required"
+// @Failure 401 {object} map[string]string "error: invalid or outdated email change link"
+// @Failure 409 {object} map[string]string "error: email already registered"
+// @Router /user/email/confirm [get]
+func (h *UserController) ConfirmEmailChange(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	if err := h.confirmEmailUC.Execute(c.Request.Context(), token); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "email changed successfully"})
+}
+
+// ListSessions returns the active sessions of the current user
+// @Summary List Sessions
+// @Description Lists the devices where the current user is logged in, most recently used first
+// @Tags User
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} dto.SessionInfo "Active sessions"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Router /private/user/sessions [get]
+func (h *UserController) ListSessions(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	sessions, err := h.listSessionsUC.Execute(c.Request.Context(), claims.UserID)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// ListLoginHistory returns the login attempts of the current user
+// @Summary List Login History
+// @Description Lists successful and failed logins of the current user with their IP address, user agent and device, newest first
+// @Tags User
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Items per page (default 10)"
+// @Success 200 {object} _common.PaginatedResult[dto.LoginAttemptInfo] "Paginated login attempts"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Router /private/user/login-history [get]
+func (h *UserController) ListLoginHistory(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	pagination := common.NewPagination(page, pageSize, "", "occurred_at", "DESC")
+
+	attempts, err := h.listLoginHistoryUC.Execute(c.Request.Context(), claims.UserID, pagination)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempts)
+}
+
+// RevokeSession ends one session of the current user
+// @Summary Revoke Session
+// @Description Revokes the refresh tokens of one session. Access tokens already issued stay valid until they expire
+// @Tags User
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 200 {object} map[string]string "message: session revoked"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: session not found"
+// @Router /private/user/sessions/{id} [delete]
+func (h *UserController) RevokeSession(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.revokeSessionUC.Execute(c.Request.Context(), claims.UserID, c.Param("id")); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "session revoked"})
+}
+
+// RevokeAllSessions logs the current user out everywhere
+// @Summary Log Out Everywhere
+// @Description Revokes every session of the current user, including this one, and clears the session cookies. Access tokens already issued stop working right away
+// @Tags User
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]string "message: logged out from all sessions"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Router /private/user/sessions [delete]
+func (h *UserController) RevokeAllSessions(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	if err := h.revokeAllSessionsUC.Execute(c.Request.Context(), claims.UserID); err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	clearSessionCookies(c)
+
+	c.JSON(http.StatusOK, gin.H{"message": "logged out from all sessions"})
+}
+
+// ListMyStores returns the store memberships of the current user
+// @Summary List My Stores
+// @Description Lists the stores the current user is a member of, with the roles held in each. Active memberships can be switched into with /auth/refresh/store
+// @Tags User
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} dto.StoreMemberInfo "Store memberships"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Router /private/user/stores [get]
+func (h *UserController) ListMyStores(c *gin.Context) {
+	claims, err := helper.ExtractUserClaims(c.Request.Context())
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	stores, err := h.listMyStoresUC.Execute(c.Request.Context(), claims.UserID)
+	if err != nil {
+		helper.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, stores)
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/gin-gonic/gin"
+)
+
+// jwksMaxAge is short so that validators pick up a rotated key quickly; they
+// are still expected to refetch the set when they see an unknown kid.
+const jwksMaxAge = "public, max-age=300"
+
+type WellKnownController struct {
+	keys security.PublicKeySet
+}
+
+func NewWellKnownController(keys security.PublicKeySet) *WellKnownController {
+	return &WellKnownController{
+		keys: keys,
+	}
+}
+
+func (h *WellKnownController) RegisterRoutes(router *gin.Engine) {
+	router.GET("/.well-known/jwks.json", h.JWKS)
+}
+
+// JWKS publishes the keys that validate access tokens
+// @Summary JSON Web Key Set
+// @Description Returns the public keys of the access token keyring, including retired keys that still validate unexpired tokens
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} dto.JWKS
+// @Router /.well-known/jwks.json [get]
+func (h *WellKnownController) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", jwksMaxAge)
+	c.JSON(http.StatusOK, h.keys.JWKS())
+}
@@ -0,0 +1,13 @@
+package helper
+
+import (
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/gin-gonic/gin"
+)
+
+func ExtractClientInfo(c *gin.Context) dto.ClientInfo {
+	return dto.ClientInfo{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
@@ -5,12 +5,30 @@ import (
 	"errors"
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 )
 
-var ErrUserNotInContext = errors.New("user claims not found in context")
+type contextKey string
 
+const (
+	UserClaimsKey   contextKey = "user_claims"
+	APIKeyClaimsKey contextKey = "api_key_claims"
+)
+
+var (
+	ErrUserNotInContext   = errors.New("user claims not found in context")
+	ErrAPIKeyNotInContext = errors.New("api key claims not found in context")
+)
+
+// ExtractUserClaims returns the authenticated user. Requests authenticated by
+// an API key get entity.ErrAPIKeyNotAllowed, so routes that act on behalf of
+// a person reject them with 403 instead of failing as a missing context.
 func ExtractUserClaims(ctx context.Context) (*dto.UserClaims, error) {
-	val := ctx.Value("user_claims")
+	if _, ok := ctx.Value(APIKeyClaimsKey).(*dto.APIKeyClaims); ok {
+		return nil, entity.ErrAPIKeyNotAllowed
+	}
+
+	val := ctx.Value(UserClaimsKey)
 	if val == nil {
 		return nil, ErrUserNotInContext
 	}
@@ -22,3 +40,12 @@ func ExtractUserClaims(ctx context.Context) (*dto.UserClaims, error) {
 
 	return claims, nil
 }
+
+func ExtractAPIKeyClaims(ctx context.Context) (*dto.APIKeyClaims, error) {
+	claims, ok := ctx.Value(APIKeyClaimsKey).(*dto.APIKeyClaims)
+	if !ok {
+		return nil, ErrAPIKeyNotInContext
+	}
+
+	return claims, nil
+}
@@ -15,12 +15,37 @@ func HandleError(c *gin.Context, err error) {
 	// 1. Entidade Improcessável (422) - Erros de Validação e Regras de Domínio
 	case errors.Is(err, vo.ErrPasswordTooShort),
 		errors.Is(err, vo.ErrLowPasswordComplexity),
+		errors.Is(err, vo.ErrPasswordTooLong),
+		errors.Is(err, vo.ErrBreachedPassword),
+		errors.Is(err, vo.ErrPasswordSimilarToIdentity),
+		errors.Is(err, vo.ErrPasswordReused),
 		errors.Is(err, vo.ErrEmptyPassword),
 		errors.Is(err, vo.ErrInvalidRole),
 		errors.Is(err, vo.ErrEmptyRole),
 		errors.Is(err, vo.ErrEmptyEmail),
 		errors.Is(err, vo.ErrInvalidEmail),
 		errors.Is(err, vo.ErrInvalidOTPFormat),
+		errors.Is(err, vo.ErrInvalidAPIKeyScope),
+		errors.Is(err, entity.ErrAPIKeyNameRequired),
+		errors.Is(err, entity.ErrAPIKeyStoreRequired),
+		errors.Is(err, entity.ErrAPIKeyScopesRequired),
+		errors.Is(err, entity.ErrAPIKeyExpiryInPast),
+		errors.Is(err, vo.ErrInvalidPermission),
+		errors.Is(err, entity.ErrRoleStoreRequired),
+		errors.Is(err, entity.ErrRolePermissionsRequired),
+		errors.Is(err, vo.ErrInvalidMembershipStatus),
+		errors.Is(err, entity.ErrMembershipStoreRequired),
+		errors.Is(err, entity.ErrMembershipRolesRequired),
+		errors.Is(err, entity.ErrRoleNotInStore),
+		errors.Is(err, entity.ErrCustomRoleNotGlobal),
+		errors.Is(err, entity.ErrInvalidHistorySize),
+		errors.Is(err, entity.ErrInvalidAuditFilter),
+		errors.Is(err, entity.ErrInvalidAuditRange),
+		errors.Is(err, vo.ErrInvalidAuditAction),
+		errors.Is(err, entity.ErrImpersonationReason),
+		errors.Is(err, entity.ErrInvalidUserImport),
+		errors.Is(err, entity.ErrUserImportEmpty),
+		errors.Is(err, entity.ErrUserImportTooLarge),
 		errors.Is(err, entity.ErrEmptyUsername):
 		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
 
@@ -28,18 +53,64 @@ func HandleError(c *gin.Context, err error) {
 	case errors.Is(err, entity.ErrInvalidCredentials),
 		errors.Is(err, entity.ErrInvalidOldPassword),
 		errors.Is(err, entity.ErrUserIsDeactivated),
+		errors.Is(err, entity.ErrInvalidVerificationToken),
+		errors.Is(err, entity.ErrInvalidUnlockToken),
+		errors.Is(err, entity.ErrInvalidEmailChangeToken),
+		errors.Is(err, entity.ErrInvalidMagicLink),
+		errors.Is(err, entity.ErrInvalidOTP),
+		errors.Is(err, entity.ErrInvalidMFACode),
+		errors.Is(err, entity.ErrMFAChallengeNotFound),
+		errors.Is(err, entity.ErrRefreshTokenReused),
+		errors.Is(err, entity.ErrSessionRevoked),
+		errors.Is(err, entity.ErrInvalidAPIKey),
+		errors.Is(err, entity.ErrInvalidInvitation),
+		errors.Is(err, vo.ErrInvalidInviteToken),
 		errors.Is(err, infrastructure.ErrInvalidToken),
 		errors.Is(err, infrastructure.ErrExpiredToken),
 		errors.Is(err, infrastructure.ErrUnexpectedMethod):
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 
-	// 3. Não Encontrado (404)
+	// 3. Proibido (403) - Usuário autenticado, mas sem acesso
+	case errors.Is(err, entity.ErrEmailNotVerified),
+		errors.Is(err, entity.ErrAPIKeyNotAllowed),
+		errors.Is(err, entity.ErrPrivilegeEscalation),
+		errors.Is(err, entity.ErrSystemRoleImmutable),
+		errors.Is(err, entity.ErrImpersonationNotAllowed),
+		errors.Is(err, entity.ErrImpersonationForbidden),
+		errors.Is(err, entity.ErrNotStoreMember):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+
+	// 4. Não Encontrado (404)
 	case errors.Is(err, entity.ErrUserNotFound),
 		errors.Is(err, entity.ErrSessionNotFound),
-		errors.Is(err, entity.ErrOTPNotFound):
+		errors.Is(err, entity.ErrOTPNotFound),
+		errors.Is(err, entity.ErrMFANotEnrolled),
+		errors.Is(err, entity.ErrAPIKeyNotFound),
+		errors.Is(err, entity.ErrRoleNotFound),
+		errors.Is(err, entity.ErrMembershipNotFound),
+		errors.Is(err, entity.ErrInvitationNotFound),
+		errors.Is(err, entity.ErrImpersonationNotFound):
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 
-	// 4. Catch-all para Erros Internos (500)
+	// 5. Conflito (409)
+	case errors.Is(err, entity.ErrMFAAlreadyEnabled),
+		errors.Is(err, entity.ErrAPIKeyRevoked),
+		errors.Is(err, entity.ErrRoleAlreadyExists),
+		errors.Is(err, entity.ErrRoleNameReserved),
+		errors.Is(err, entity.ErrRoleInUse),
+		errors.Is(err, entity.ErrMembershipAlreadyExists),
+		errors.Is(err, entity.ErrInvitationNotPending),
+		errors.Is(err, entity.ErrUsernameTaken),
+		errors.Is(err, entity.ErrEmailAlreadyRegistered):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+
+	// 6. Muitas Requisições (429)
+	case errors.Is(err, entity.ErrUserBlocked),
+		errors.Is(err, entity.ErrOTPExhausted),
+		errors.Is(err, entity.ErrOTPCooldown):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+
+	// 7. Catch-all para Erros Internos (500)
 	default:
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error, please try again later"})
 	}
@@ -0,0 +1,147 @@
+package helper
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+)
+
+// RoleSeparator splits the roles of a user within a single CSV cell.
+const RoleSeparator = ";"
+
+// userCSVHeader is the header of exported files. Its first columns are those
+// an import reads, so an export can be edited and imported elsewhere.
+var userCSVHeader = []string{"email", "username", "roles", "id", "active", "email_verified", "locked"}
+
+// ParseUserImportCSV reads the users of an import file. Columns are found by
+// their header, case-insensitively, in any order: email and username are
+// required, roles and store are optional and any other column is ignored.
+func ParseUserImportCSV(r io.Reader) ([]dto.UserImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, entity.ErrUserImportEmpty
+		}
+
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserImport, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// spreadsheets often save UTF-8 files with a byte order mark
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns["email"]; !ok {
+		return nil, entity.ErrInvalidUserImport
+	}
+
+	if _, ok := columns["username"]; !ok {
+		return nil, entity.ErrInvalidUserImport
+	}
+
+	cell := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return plainCell(strings.TrimSpace(record[i]))
+	}
+
+	var rows []dto.UserImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", entity.ErrInvalidUserImport, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, dto.UserImportRow{
+			Line:     line,
+			Email:    cell(record, "email"),
+			Username: cell(record, "username"),
+			Roles:    splitRoles(cell(record, "roles")),
+			StoreID:  cell(record, "store"),
+		})
+	}
+
+	return rows, nil
+}
+
+// WriteUsersCSV writes users in the format ParseUserImportCSV reads.
+func WriteUsersCSV(w io.Writer, users []dto.AdminUserInfo) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(userCSVHeader); err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		record := []string{
+			csvCell(user.Email),
+			csvCell(user.Username),
+			csvCell(strings.Join(user.Roles, RoleSeparator)),
+			user.ID.String(),
+			strconv.FormatBool(user.Active),
+			strconv.FormatBool(user.EmailVerified),
+			strconv.FormatBool(user.Locked),
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func splitRoles(value string) []string {
+	roles := make([]string, 0)
+	for _, role := range strings.Split(value, RoleSeparator) {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// formulaPrefixes start a value spreadsheets would run as a formula.
+const formulaPrefixes = "=+-@\t\r"
+
+// csvCell keeps spreadsheets from running a value chosen by a user, such as
+// a username, as a formula when the export is opened.
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+// plainCell undoes csvCell, so an exported file imports the values it was
+// made from.
+func plainCell(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+
+	return value
+}
@@ -0,0 +1,150 @@
+package helper
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUserImportCSV(t *testing.T) {
+	storeID := uuid.New().String()
+
+	tests := []struct {
+		name     string
+		input    string
+		wantRows []dto.UserImportRow
+		wantErr  error
+	}{
+		{
+			name:  "Columns In Any Order With Extra Ones",
+			input: "Store,notes,USERNAME,roles,Email\n" + storeID + ",hi,ana,EMPLOYEE; MANAGER ,ana@test.com\n",
+			wantRows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{"EMPLOYEE", "MANAGER"}, StoreID: storeID},
+			},
+		},
+		{
+			name:  "Optional Columns Missing And Short Records",
+			input: "email,username,roles\nana@test.com,ana\nbia@test.com,bia,\n",
+			wantRows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{}},
+				{Line: 3, Email: "bia@test.com", Username: "bia", Roles: []string{}},
+			},
+		},
+		{
+			name:  "Byte Order Mark",
+			input: "\ufeffemail,username\nana@test.com,ana\n",
+			wantRows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{}},
+			},
+		},
+		{
+			name:  "Lines Count Quoted Line Breaks",
+			input: "email,username\n\"ana@test.com\",\"a\nna\"\nbia@test.com,bia\n",
+			wantRows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "a\nna", Roles: []string{}},
+				{Line: 4, Email: "bia@test.com", Username: "bia", Roles: []string{}},
+			},
+		},
+		{
+			name:    "Missing Username Column",
+			input:   "email,roles\nana@test.com,EMPLOYEE\n",
+			wantErr: entity.ErrInvalidUserImport,
+		},
+		{
+			name:    "Malformed Quotes",
+			input:   "email,username\n\"ana@test.com,ana\n",
+			wantErr: entity.ErrInvalidUserImport,
+		},
+		{
+			name:    "Empty File",
+			input:   "",
+			wantErr: entity.ErrUserImportEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseUserImportCSV(strings.NewReader(tt.input))
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, rows)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRows, rows)
+		})
+	}
+}
+
+func TestWriteUsersCSV(t *testing.T) {
+	id := uuid.New()
+	users := []dto.AdminUserInfo{
+		{ID: id, Email: "ana@test.com", Username: "ana", Roles: []string{"EMPLOYEE", "MANAGER"}, Active: true, Locked: true},
+	}
+
+	var buf bytes.Buffer
+	err := WriteUsersCSV(&buf, users)
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"email,username,roles,id,active,email_verified,locked\n"+
+			"ana@test.com,ana,EMPLOYEE;MANAGER,"+id.String()+",true,false,true\n",
+		buf.String(),
+	)
+}
+
+func TestCSVCell(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "ana", want: "ana"},
+		{value: "", want: ""},
+		{value: "=HYPERLINK(\"x\")", want: "'=HYPERLINK(\"x\")"},
+		{value: "+1", want: "'+1"},
+		{value: "-1", want: "'-1"},
+		{value: "@SUM(A1)", want: "'@SUM(A1)"},
+		{value: "\tx", want: "'\tx"},
+		{value: "a=b", want: "a=b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			escaped := csvCell(tt.value)
+
+			assert.Equal(t, tt.want, escaped)
+			assert.Equal(t, tt.value, plainCell(escaped))
+		})
+	}
+}
+
+func TestUserCSV_RoundTrip(t *testing.T) {
+	users := []dto.AdminUserInfo{
+		{ID: uuid.New(), Email: "ana@test.com", Username: "ana", Roles: []string{"EMPLOYEE"}},
+		{ID: uuid.New(), Email: "bia@test.com", Username: "=bia", Roles: []string{"MANAGER", "CASHIER"}, Active: true},
+		{ID: uuid.New(), Email: "caio@test.com", Username: "caio, jr", Roles: []string{}},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteUsersCSV(&buf, users))
+
+	rows, err := ParseUserImportCSV(&buf)
+	assert.NoError(t, err)
+
+	if assert.Len(t, rows, len(users)) {
+		for i, user := range users {
+			assert.Equal(t, i+2, rows[i].Line)
+			assert.Equal(t, user.Email, rows[i].Email)
+			assert.Equal(t, user.Username, rows[i].Username)
+			assert.Equal(t, user.Roles, rows[i].Roles)
+			assert.Empty(t, rows[i].StoreID)
+		}
+	}
+}
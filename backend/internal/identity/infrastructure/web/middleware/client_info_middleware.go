@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// CaptureClientInfo puts the IP address and user agent of the request in its
+// context, where use cases read them for the audit log.
+func CaptureClientInfo() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(dto.WithClientInfo(c.Request.Context(), helper.ExtractClientInfo(c)))
+		c.Next()
+	}
+}
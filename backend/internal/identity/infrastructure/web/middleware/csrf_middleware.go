@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireCSRF applies the double-submit pattern: the X-CSRF-Token header must
+// repeat the csrf_token cookie, which a cross-site page cannot read. It must
+// run after RequireAuth, and only checks unsafe methods of requests
+// authenticated by cookie, since browsers never attach a Bearer header on
+// their own. Sessions opened before the CSRF cookie existed are handed one on
+// their next request; an unsafe request is still refused until it echoes it.
+func RequireCSRF() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(helper.AuthMethodKey) != helper.AuthMethodCookie {
+			c.Next()
+			return
+		}
+
+		cookie, err := c.Cookie(helper.CSRFCookieKey)
+		if err != nil || cookie == "" {
+			token, err := helper.GenerateCSRFToken()
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+				return
+			}
+
+			helper.SetCSRFCookie(c, token, 0)
+		}
+
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		header := c.GetHeader(helper.CSRFHeaderKey)
+
+		if cookie == "" || subtle.ConstantTimeCompare([]byte(cookie), []byte(header)) != 1 {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: invalid csrf token"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// withAuthMethod stands in for RequireAuth.
+func withAuthMethod(method string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(helper.AuthMethodKey, method)
+		c.Next()
+	}
+}
+
+func csrfCookie(rec *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == helper.CSRFCookieKey {
+			return cookie
+		}
+	}
+
+	return nil
+}
+
+func TestRequireCSRF(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		method      string
+		authMethod  string
+		cookie      string
+		header      string
+		wantStatus  int
+		wantNewCSRF bool
+	}{
+		{name: "Safe Method Skips Check", method: http.MethodGet, authMethod: helper.AuthMethodCookie, cookie: "token", wantStatus: http.StatusOK},
+		{name: "Bearer Skips Check", method: http.MethodPost, authMethod: helper.AuthMethodBearer, wantStatus: http.StatusOK},
+		{name: "Matching Header", method: http.MethodPost, authMethod: helper.AuthMethodCookie, cookie: "token", header: "token", wantStatus: http.StatusOK},
+		{name: "Missing Header", method: http.MethodPost, authMethod: helper.AuthMethodCookie, cookie: "token", wantStatus: http.StatusForbidden},
+		{name: "Mismatched Header", method: http.MethodDelete, authMethod: helper.AuthMethodCookie, cookie: "token", header: "other", wantStatus: http.StatusForbidden},
+		{name: "Session Without Cookie - Safe Method Gets One", method: http.MethodGet, authMethod: helper.AuthMethodCookie, wantStatus: http.StatusOK, wantNewCSRF: true},
+		{name: "Session Without Cookie - Unsafe Method Refused", method: http.MethodPost, authMethod: helper.AuthMethodCookie, header: "guess", wantStatus: http.StatusForbidden, wantNewCSRF: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+			engine.Handle(tt.method, "/private", withAuthMethod(tt.authMethod), RequireCSRF(), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, "/private", nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: helper.CSRFCookieKey, Value: tt.cookie})
+			}
+			if tt.header != "" {
+				req.Header.Set(helper.CSRFHeaderKey, tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			issued := csrfCookie(rec)
+			if !tt.wantNewCSRF {
+				assert.Nil(t, issued)
+				return
+			}
+
+			if assert.NotNil(t, issued) {
+				assert.NotEmpty(t, issued.Value)
+				assert.NotEqual(t, tt.header, issued.Value)
+				assert.False(t, issued.HttpOnly)
+				assert.Equal(t, http.SameSiteLaxMode, issued.SameSite)
+			}
+		})
+	}
+}
+
+func TestExtractAccessToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		header     string
+		cookie     string
+		wantToken  string
+		wantMethod string
+		wantOK     bool
+	}{
+		{name: "Bearer Header", header: "Bearer abc", wantToken: "abc", wantMethod: helper.AuthMethodBearer, wantOK: true},
+		{name: "Scheme Is Case Insensitive", header: "bearer abc", wantToken: "abc", wantMethod: helper.AuthMethodBearer, wantOK: true},
+		{name: "Header Wins Over Cookie", header: "Bearer abc", cookie: "xyz", wantToken: "abc", wantMethod: helper.AuthMethodBearer, wantOK: true},
+		{name: "Cookie", cookie: "xyz", wantToken: "xyz", wantMethod: helper.AuthMethodCookie, wantOK: true},
+		{name: "Other Scheme Does Not Fall Back To Cookie", header: "Basic abc", cookie: "xyz"},
+		{name: "Empty Bearer", header: "Bearer  "},
+		{name: "Nothing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "access_token", Value: tt.cookie})
+			}
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = req
+
+			token, method, ok := extractAccessToken(c)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantToken, token)
+			assert.Equal(t, tt.wantMethod, method)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireVerifiedEmail lets API keys through: they have no email, and were
+// created by an admin who had to pass this check.
+func RequireVerifiedEmail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(helper.AuthMethodKey) == helper.AuthMethodAPIKey {
+			c.Next()
+			return
+		}
+
+		claims, err := helper.ExtractUserClaims(c.Request.Context())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "auth context missing or invalid token"})
+			return
+		}
+
+		if !claims.EmailVerified {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: email not verified"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+)
+
+// ForbidImpersonation closes a route to impersonation tokens. It guards the
+// actions support must never take on someone's behalf: changing their
+// credentials, MFA, profile, sessions or roles, deleting the account, and
+// starting another impersonation. API keys pass through; they cannot impersonate.
+func ForbidImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(helper.AuthMethodKey) == helper.AuthMethodAPIKey {
+			c.Next()
+			return
+		}
+
+		claims, err := helper.ExtractUserClaims(c.Request.Context())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "auth context missing or invalid token"})
+			return
+		}
+
+		if claims.IsImpersonated() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: " + entity.ErrImpersonationForbidden.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockStoreMembershipRepository implements ports.StoreMembershipRepository for testing
+type MockStoreMembershipRepository struct {
+	mock.Mock
+}
+
+func (m *MockStoreMembershipRepository) Save(ctx context.Context, membership *entity.StoreMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Update(ctx context.Context, membership *entity.StoreMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Delete(ctx context.Context, userID, storeID uuid.UUID) error {
+	args := m.Called(ctx, userID, storeID)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Find(ctx context.Context, userID, storeID uuid.UUID) (*entity.StoreMembership, error) {
+	args := m.Called(ctx, userID, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.StoreMembership), args.Error(1)
+}
+
+func (m *MockStoreMembershipRepository) ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.StoreMembership, error) {
+	args := m.Called(ctx, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StoreMembership), args.Error(1)
+}
+
+func (m *MockStoreMembershipRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.StoreMembership, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StoreMembership), args.Error(1)
+}
+
+// staticRoleCatalog implements ports.RoleCatalogProvider for testing
+type staticRoleCatalog struct {
+	catalog vo.RoleCatalog
+}
+
+func (c *staticRoleCatalog) Catalog(ctx context.Context) (vo.RoleCatalog, error) {
+	return c.catalog, nil
+}
+
+func (c *staticRoleCatalog) Invalidate() {}
+
+// MockTokenManager implements security.TokenManager for testing
+type MockTokenManager struct {
+	mock.Mock
+}
+
+func (m *MockTokenManager) GenerateTokens(ctx context.Context, user *entity.User) (string, string, error) {
+	args := m.Called(ctx, user)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *MockTokenManager) GenerateStoreTokens(ctx context.Context, user *entity.User, storeID uuid.UUID) (string, string, error) {
+	args := m.Called(ctx, user, storeID)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *MockTokenManager) GenerateImpersonationToken(ctx context.Context, user *entity.User, impersonation *entity.Impersonation) (string, error) {
+	args := m.Called(ctx, user, impersonation)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockTokenManager) ValidateAccessToken(tokenString string) (*dto.UserClaims, error) {
+	args := m.Called(tokenString)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UserClaims), args.Error(1)
+}
+
+// MockTokenVersionRepository implements ports.TokenVersionRepository for testing
+type MockTokenVersionRepository struct {
+	mock.Mock
+}
+
+func (m *MockTokenVersionRepository) GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+// MockImpersonationRepository implements ports.ImpersonationRepository for testing
+type MockImpersonationRepository struct {
+	mock.Mock
+}
+
+func (m *MockImpersonationRepository) Save(ctx context.Context, impersonation *entity.Impersonation) error {
+	args := m.Called(ctx, impersonation)
+	return args.Error(0)
+}
+
+func (m *MockImpersonationRepository) Find(ctx context.Context, id uuid.UUID) (*entity.Impersonation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Impersonation), args.Error(1)
+}
+
+func (m *MockImpersonationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockAuthenticateAPIKeyUseCase implements security.AuthenticateAPIKeyUseCase for testing
+type MockAuthenticateAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockAuthenticateAPIKeyUseCase) Execute(ctx context.Context, rawKey string) (*dto.APIKeyClaims, error) {
+	args := m.Called(ctx, rawKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.APIKeyClaims), args.Error(1)
+}
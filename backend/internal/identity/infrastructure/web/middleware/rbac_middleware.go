@@ -5,33 +5,50 @@ import (
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
-func VerifyRole(allowedRoles ...vo.Role) gin.HandlerFunc {
+// RequirePermission admits users whose roles grant permission in the role
+// catalog. API keys are admitted when they hold the scope named after the
+// permission, so a permission that has no matching scope stays closed to them.
+// Keys are bound to one store and only reach routes of that store: a route
+// without a :store_id reads across stores and is refused to every key.
+func RequirePermission(roles ports.RoleCatalogProvider, permission vo.Permission) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		apiKey, err := helper.ExtractAPIKeyClaims(c.Request.Context())
+		if err == nil {
+			scope, err := vo.NewAPIKeyScope(permission.String())
+			if err != nil || !apiKey.HasScope(scope) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: api key lacks scope " + permission.String()})
+				return
+			}
+
+			storeID, err := uuid.Parse(c.Param("store_id"))
+			if err != nil || storeID != apiKey.StoreID {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: api key is not bound to this store"})
+				return
+			}
+
+			c.Next()
+			return
+		}
+
 		claims, err := helper.ExtractUserClaims(c.Request.Context())
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "auth context missing or invalid token"})
 			return
 		}
 
-		allowedMap := make(map[vo.Role]bool)
-		for _, role := range allowedRoles {
-			allowedMap[role] = true
-		}
-
-		hasPermission := false
-
-		for _, userRole := range claims.Roles {
-			if allowedMap[userRole] {
-				hasPermission = true
-				break
-			}
+		catalog, err := roles.Catalog(c.Request.Context())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+			return
 		}
 
-		if !hasPermission {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: insufficient permissions"})
+		if !catalog.GrantsOf(claims.Roles...).Has(permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: missing permission " + permission.String()})
 			return
 		}
 
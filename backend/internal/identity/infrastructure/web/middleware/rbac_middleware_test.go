@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// withAPIKey stands in for RequireAuth authenticating an API key.
+func withAPIKey(claims *dto.APIKeyClaims) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(APIKeyClaimsKey, claims)
+		c.Set(helper.AuthMethodKey, helper.AuthMethodAPIKey)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), APIKeyClaimsKey, claims))
+		c.Next()
+	}
+}
+
+func TestRequirePermission_APIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	storeA := uuid.New()
+	storeB := uuid.New()
+	key := &dto.APIKeyClaims{KeyID: uuid.New(), Name: "erp sync", StoreID: storeA, Scopes: []vo.APIKeyScope{vo.ScopeUsersRead}}
+
+	roles := &staticRoleCatalog{catalog: vo.SystemRoleCatalog()}
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+	engine := gin.New()
+	engine.Use(withAPIKey(key))
+	engine.GET("/admin/users", RequirePermission(roles, vo.PermUsersRead), ok)
+	engine.GET("/admin/stores/:store_id/users", RequirePermission(roles, vo.PermUsersRead), ok)
+	engine.GET("/admin/stores/:store_id/members", RequirePermission(roles, vo.PermMembersManage), ok)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{name: "Route Of The Key Store", path: "/admin/stores/" + storeA.String() + "/users", wantStatus: http.StatusOK},
+		{name: "Route Of Another Store", path: "/admin/stores/" + storeB.String() + "/users", wantStatus: http.StatusForbidden},
+		{name: "Route Across Stores", path: "/admin/users", wantStatus: http.StatusForbidden},
+		{name: "Missing Scope", path: "/admin/stores/" + storeA.String() + "/members", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ResolveStoreRoles adds the roles of the user's membership in the active
+// store to the global roles of the claims, so RequirePermission checks what
+// the user may do in that store. It only applies on routes of that store,
+// whose :store_id is the active store: a membership grants nothing on global
+// routes or in other stores. The membership is read on every request:
+// removing or suspending a member takes effect before their token expires.
+// Sessions without an active store and API keys pass through untouched.
+func ResolveStoreRoles(memberships ports.StoreMembershipRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(helper.AuthMethodKey) == helper.AuthMethodAPIKey {
+			c.Next()
+			return
+		}
+
+		claims, err := helper.ExtractUserClaims(c.Request.Context())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "auth context missing or invalid token"})
+			return
+		}
+
+		if claims.StoreID == nil {
+			c.Next()
+			return
+		}
+
+		storeID, err := uuid.Parse(c.Param("store_id"))
+		if err != nil || storeID != *claims.StoreID {
+			c.Next()
+			return
+		}
+
+		membership, err := memberships.Find(c.Request.Context(), claims.UserID, *claims.StoreID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+			return
+		}
+
+		if membership == nil || !membership.IsActive() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "forbidden: not an active member of this store"})
+			return
+		}
+
+		resolved := *claims
+		resolved.Roles = make([]vo.Role, 0, len(claims.Roles)+len(membership.Roles()))
+		resolved.Roles = append(resolved.Roles, claims.Roles...)
+		for _, role := range membership.Roles() {
+			if !containsRole(resolved.Roles, role) {
+				resolved.Roles = append(resolved.Roles, role)
+			}
+		}
+
+		c.Set(UserClaimsKey, &resolved)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), UserClaimsKey, &resolved))
+
+		c.Next()
+	}
+}
+
+func containsRole(roles []vo.Role, role vo.Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
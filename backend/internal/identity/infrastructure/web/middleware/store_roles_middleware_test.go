@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// withClaims stands in for RequireAuth.
+func withClaims(claims *dto.UserClaims) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(UserClaimsKey, claims)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), UserClaimsKey, claims))
+		c.Next()
+	}
+}
+
+// newStoreRolesRouter mirrors the admin routes: a global route checked
+// against global roles and a store route that resolves the membership first.
+func newStoreRolesRouter(claims *dto.UserClaims, memberships *MockStoreMembershipRepository) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	roles := &staticRoleCatalog{catalog: vo.SystemRoleCatalog()}
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+	engine := gin.New()
+	adminRoutes := engine.Group("/admin", withClaims(claims))
+	adminRoutes.GET("/users", RequirePermission(roles, vo.PermUsersRead), ok)
+
+	storeRoutes := adminRoutes.Group("/stores/:store_id", ResolveStoreRoles(memberships))
+	storeRoutes.GET("/members", RequirePermission(roles, vo.PermMembersManage), ok)
+
+	return engine
+}
+
+func TestResolveStoreRoles(t *testing.T) {
+	userID := uuid.New()
+	storeA := uuid.New()
+	storeB := uuid.New()
+	manager, _ := entity.NewStoreMembership(userID, storeA, []vo.Role{vo.ManagerRole}, time.Now())
+	suspended, _ := entity.NewStoreMembership(userID, storeA, []vo.Role{vo.ManagerRole}, time.Now())
+	suspended.ChangeStatus(vo.MembershipSuspended, time.Now())
+
+	tests := []struct {
+		name       string
+		globalRole []vo.Role
+		path       string
+		membership *entity.StoreMembership
+		wantStatus int
+	}{
+		{
+			name:       "Membership Grants On Routes Of The Active Store",
+			path:       "/admin/stores/" + storeA.String() + "/members",
+			membership: manager,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Membership Grants Nothing On Global Routes",
+			path:       "/admin/users",
+			membership: manager,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Membership Grants Nothing In Another Store",
+			path:       "/admin/stores/" + storeB.String() + "/members",
+			membership: manager,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Suspended Membership",
+			path:       "/admin/stores/" + storeA.String() + "/members",
+			membership: suspended,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Global Roles Still Apply On Global Routes",
+			globalRole: []vo.Role{vo.ManagerRole},
+			path:       "/admin/users",
+			membership: manager,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &dto.UserClaims{UserID: userID, Roles: tt.globalRole, StoreID: &storeA}
+			memberships := new(MockStoreMembershipRepository)
+			memberships.On("Find", mock.Anything, userID, storeA).Return(tt.membership, nil)
+
+			rec := httptest.NewRecorder()
+			newStoreRolesRouter(claims, memberships).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.globalRole, claims.Roles, "the claims of the session must not change")
+		})
+	}
+}
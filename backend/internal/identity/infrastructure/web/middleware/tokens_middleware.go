@@ -2,20 +2,48 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strings"
 
-	"github.com/MuriloFlores/order-manager/internal/identity/ports/auth"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
 	"github.com/gin-gonic/gin"
 )
 
-type contextKey string
+const (
+	UserClaimsKey   = helper.UserClaimsKey
+	APIKeyClaimsKey = helper.APIKeyClaimsKey
 
-const UserClaimsKey contextKey = "user_claims"
+	// APIKeyHeader carries the key of integrations and scripts. It is kept
+	// apart from Authorization so a key is never mistaken for an access token.
+	APIKeyHeader = "X-API-Key"
+)
 
-func RequireAuth(manager security.TokenManager) gin.HandlerFunc {
+// RequireAuth accepts the access token from an "Authorization: Bearer" header,
+// for API clients, or from the access_token cookie, for browsers. It rejects
+// access tokens stamped with an older token version than the user's current
+// one, so revoking sessions takes effect immediately instead of when the
+// access token expires. Impersonation tokens are also rejected once their
+// impersonation has ended. Requests carrying an X-API-Key header are
+// authenticated as the key instead of as a user; see RequirePermission.
+func RequireAuth(
+	manager security.TokenManager,
+	versions ports.TokenVersionRepository,
+	impersonations ports.ImpersonationRepository,
+	apiKeys security.AuthenticateAPIKeyUseCase,
+) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tokenString, err := c.Cookie("access_token")
-		if err != nil {
+		if rawKey := c.GetHeader(APIKeyHeader); rawKey != "" {
+			authenticateAPIKey(c, apiKeys, rawKey)
+			return
+		}
+
+		tokenString, method, ok := extractAccessToken(c)
+		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
 			return
 		}
@@ -26,10 +54,91 @@ func RequireAuth(manager security.TokenManager) gin.HandlerFunc {
 			return
 		}
 
+		currentVersion, err := versions.GetTokenVersion(c.Request.Context(), userClaims.UserID)
+		if err != nil {
+			if errors.Is(err, entity.ErrUserNotFound) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid access token"})
+				return
+			}
+
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+			return
+		}
+
+		if currentVersion != userClaims.TokenVersion {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": entity.ErrSessionRevoked.Error()})
+			return
+		}
+
+		if userClaims.ImpersonationID != nil {
+			impersonation, err := impersonations.Find(c.Request.Context(), *userClaims.ImpersonationID)
+			if err != nil {
+				if errors.Is(err, entity.ErrImpersonationNotFound) {
+					c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": entity.ErrImpersonationNotFound.Error()})
+					return
+				}
+
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+				return
+			}
+
+			if impersonation.UserID() != userClaims.UserID || impersonation.ImpersonatorID() != *userClaims.ImpersonatorID {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid access token"})
+				return
+			}
+		}
+
 		c.Set(UserClaimsKey, userClaims)
+		c.Set(helper.AuthMethodKey, method)
+
+		ctx := context.WithValue(c.Request.Context(), UserClaimsKey, userClaims)
+		if userClaims.ImpersonatorID != nil {
+			ctx = dto.WithImpersonator(ctx, *userClaims.ImpersonatorID)
+		}
 
-		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), UserClaimsKey, userClaims))
+		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
 	}
 }
+
+func authenticateAPIKey(c *gin.Context, apiKeys security.AuthenticateAPIKeyUseCase, rawKey string) {
+	apiKeyClaims, err := apiKeys.Execute(c.Request.Context(), rawKey)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidAPIKey) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid api key"})
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+		return
+	}
+
+	c.Set(APIKeyClaimsKey, apiKeyClaims)
+	c.Set(helper.AuthMethodKey, helper.AuthMethodAPIKey)
+
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), APIKeyClaimsKey, apiKeyClaims))
+
+	c.Next()
+}
+
+// extractAccessToken prefers the Authorization header. A header that is
+// present but not a Bearer token is rejected instead of falling back to the
+// cookie, so a misconfigured client fails loudly.
+func extractAccessToken(c *gin.Context) (string, string, bool) {
+	if header := c.GetHeader("Authorization"); header != "" {
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			return "", "", false
+		}
+
+		return strings.TrimSpace(token), helper.AuthMethodBearer, true
+	}
+
+	token, err := c.Cookie("access_token")
+	if err != nil || token == "" {
+		return "", "", false
+	}
+
+	return token, helper.AuthMethodCookie, true
+}
@@ -0,0 +1,255 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/infrastructure/web/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type authMocks struct {
+	tokens         *MockTokenManager
+	versions       *MockTokenVersionRepository
+	impersonations *MockImpersonationRepository
+	apiKeys        *MockAuthenticateAPIKeyUseCase
+}
+
+func TestRequireAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	userID := uuid.New()
+	adminID := uuid.New()
+	impersonationID := uuid.New()
+	claims := &dto.UserClaims{UserID: userID, Roles: []vo.Role{vo.EmployeeRole}, TokenVersion: 2}
+	impersonated := &dto.UserClaims{UserID: userID, Roles: []vo.Role{vo.EmployeeRole}, TokenVersion: 2, ImpersonatorID: &adminID, ImpersonationID: &impersonationID}
+	impersonation := entity.RestoreImpersonation(impersonationID, adminID, userID, nil, "ticket 42", time.Now(), time.Now().Add(time.Minute))
+	foreignImpersonation := entity.RestoreImpersonation(impersonationID, adminID, uuid.New(), nil, "ticket 42", time.Now(), time.Now().Add(time.Minute))
+	apiKey := &dto.APIKeyClaims{KeyID: uuid.New(), Name: "erp sync", StoreID: uuid.New(), Scopes: []vo.APIKeyScope{vo.ScopeOrdersRead}}
+
+	tests := []struct {
+		name             string
+		header           map[string]string
+		cookie           string
+		setup            func(m authMocks)
+		wantStatus       int
+		wantMessage      string
+		wantMethod       string
+		wantImpersonator *uuid.UUID
+	}{
+		{
+			name:   "Bearer Token",
+			header: map[string]string{"Authorization": "Bearer access"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "access").Return(claims, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(2, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantMethod: helper.AuthMethodBearer,
+		},
+		{
+			name:   "Cookie Token",
+			cookie: "access",
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "access").Return(claims, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(2, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantMethod: helper.AuthMethodCookie,
+		},
+		{
+			name:   "Bearer Preferred Over Cookie",
+			header: map[string]string{"Authorization": "bearer header-token"},
+			cookie: "cookie-token",
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "header-token").Return(claims, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(2, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantMethod: helper.AuthMethodBearer,
+		},
+		{
+			name:       "Non Bearer Header Does Not Fall Back To Cookie",
+			header:     map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+			cookie:     "access",
+			setup:      func(m authMocks) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "Empty Bearer Token",
+			header:     map[string]string{"Authorization": "Bearer "},
+			setup:      func(m authMocks) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "No Credentials",
+			setup:      func(m authMocks) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Invalid Token",
+			header: map[string]string{"Authorization": "Bearer forged"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "forged").Return(nil, assert.AnError)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Stale Token Version",
+			header: map[string]string{"Authorization": "Bearer access"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "access").Return(claims, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(3, nil)
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantMessage: entity.ErrSessionRevoked.Error(),
+		},
+		{
+			name:   "User Gone",
+			header: map[string]string{"Authorization": "Bearer access"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "access").Return(claims, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(0, entity.ErrUserNotFound)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Token Version Lookup Error",
+			header: map[string]string{"Authorization": "Bearer access"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "access").Return(claims, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(0, assert.AnError)
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:   "Active Impersonation",
+			header: map[string]string{"Authorization": "Bearer impersonation"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "impersonation").Return(impersonated, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(2, nil)
+				m.impersonations.On("Find", mock.Anything, impersonationID).Return(impersonation, nil)
+			},
+			wantStatus:       http.StatusOK,
+			wantMethod:       helper.AuthMethodBearer,
+			wantImpersonator: &adminID,
+		},
+		{
+			name:   "Ended Impersonation",
+			header: map[string]string{"Authorization": "Bearer impersonation"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "impersonation").Return(impersonated, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(2, nil)
+				m.impersonations.On("Find", mock.Anything, impersonationID).Return(nil, entity.ErrImpersonationNotFound)
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantMessage: entity.ErrImpersonationNotFound.Error(),
+		},
+		{
+			name:   "Impersonation Of Another User",
+			header: map[string]string{"Authorization": "Bearer impersonation"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "impersonation").Return(impersonated, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(2, nil)
+				m.impersonations.On("Find", mock.Anything, impersonationID).Return(foreignImpersonation, nil)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Impersonation Lookup Error",
+			header: map[string]string{"Authorization": "Bearer impersonation"},
+			setup: func(m authMocks) {
+				m.tokens.On("ValidateAccessToken", "impersonation").Return(impersonated, nil)
+				m.versions.On("GetTokenVersion", mock.Anything, userID).Return(2, nil)
+				m.impersonations.On("Find", mock.Anything, impersonationID).Return(nil, assert.AnError)
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:   "API Key",
+			header: map[string]string{APIKeyHeader: "ak_live_key", "Authorization": "Bearer ignored"},
+			setup: func(m authMocks) {
+				m.apiKeys.On("Execute", mock.Anything, "ak_live_key").Return(apiKey, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantMethod: helper.AuthMethodAPIKey,
+		},
+		{
+			name:   "Invalid API Key",
+			header: map[string]string{APIKeyHeader: "ak_live_revoked"},
+			setup: func(m authMocks) {
+				m.apiKeys.On("Execute", mock.Anything, "ak_live_revoked").Return(nil, entity.ErrInvalidAPIKey)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "API Key Lookup Error",
+			header: map[string]string{APIKeyHeader: "ak_live_key"},
+			setup: func(m authMocks) {
+				m.apiKeys.On("Execute", mock.Anything, "ak_live_key").Return(nil, assert.AnError)
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := authMocks{
+				tokens:         new(MockTokenManager),
+				versions:       new(MockTokenVersionRepository),
+				impersonations: new(MockImpersonationRepository),
+				apiKeys:        new(MockAuthenticateAPIKeyUseCase),
+			}
+			tt.setup(m)
+
+			var gotMethod string
+			var gotImpersonator *uuid.UUID
+			var gotUser, gotKey bool
+
+			engine := gin.New()
+			engine.GET("/private", RequireAuth(m.tokens, m.versions, m.impersonations, m.apiKeys), func(c *gin.Context) {
+				gotMethod = c.GetString(helper.AuthMethodKey)
+				gotImpersonator = dto.ImpersonatorFromContext(c.Request.Context())
+				_, gotUser = c.Get(UserClaimsKey)
+				_, gotKey = c.Get(APIKeyClaimsKey)
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/private", nil)
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "access_token", Value: tt.cookie})
+			}
+
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantMessage != "" {
+				assert.Contains(t, rec.Body.String(), tt.wantMessage)
+			}
+
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantMethod, gotMethod)
+				assert.Equal(t, tt.wantImpersonator, gotImpersonator)
+				assert.Equal(t, tt.wantMethod == helper.AuthMethodAPIKey, gotKey)
+				assert.Equal(t, tt.wantMethod != helper.AuthMethodAPIKey, gotUser)
+			}
+
+			m.tokens.AssertExpectations(t)
+			m.versions.AssertExpectations(t)
+			m.impersonations.AssertExpectations(t)
+			m.apiKeys.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type CreateAPIKeyUseCase interface {
+	Execute(ctx context.Context, createdBy uuid.UUID, input dto.CreateAPIKeyInput) (*dto.CreatedAPIKey, error)
+}
+
+type ListAPIKeysUseCase interface {
+	Execute(ctx context.Context, storeID string) ([]dto.APIKeyInfo, error)
+}
+
+type RevokeAPIKeyUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, id string) error
+}
@@ -0,0 +1,12 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+)
+
+type ListAuditEventsUseCase interface {
+	Execute(ctx context.Context, query dto.AuditQuery, pagination common.Pagination) (*common.PaginatedResult[dto.AuditEventInfo], error)
+}
@@ -1,7 +1,11 @@
 package admin
 
-import "context"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
 
 type ChangeUserRoleUseCase interface {
-	Execute(ctx context.Context, id string, roles []string) error
+	Execute(ctx context.Context, actorID uuid.UUID, id string, roles []string) error
 }
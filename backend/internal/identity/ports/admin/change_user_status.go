@@ -1,7 +1,11 @@
 package admin
 
-import "context"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
 
 type ChangeUserStatusUseCase interface {
-	Execute(ctx context.Context, id string, active bool) error
+	Execute(ctx context.Context, actorID uuid.UUID, id string, active bool) error
 }
@@ -4,9 +4,15 @@ import (
 	"context"
 
 	"github.com/MuriloFlores/order-manager/internal/common"
-	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 )
 
 type GetUsersInfo interface {
-	Execute(ctx context.Context, pagination common.Pagination, roles []string) (*common.PaginatedResult[*entity.User], error)
+	Execute(ctx context.Context, pagination common.Pagination, roles []string) (*common.PaginatedResult[dto.AdminUserInfo], error)
+}
+
+// ExportUsersInfo returns every user of the GetUsersInfo listing matching the
+// same filters, without paging.
+type ExportUsersInfo interface {
+	Execute(ctx context.Context, search, sort, direction string, roles []string) ([]dto.AdminUserInfo, error)
 }
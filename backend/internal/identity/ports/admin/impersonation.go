@@ -0,0 +1,16 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type StartImpersonationUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, id string, input dto.StartImpersonationInput) (*dto.ImpersonationResult, error)
+}
+
+type EndImpersonationUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, id string) error
+}
@@ -0,0 +1,12 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type ImportUsersUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, rows []dto.UserImportRow, dryRun bool) (*dto.UserImportReport, error)
+}
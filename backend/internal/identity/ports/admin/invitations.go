@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type InviteUserUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string, input dto.InviteUserInput) (*dto.InvitationInfo, error)
+}
+
+type ListInvitationsUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string) ([]dto.InvitationInfo, error)
+}
+
+type RevokeInvitationUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string, id string) error
+}
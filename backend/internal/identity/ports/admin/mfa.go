@@ -0,0 +1,19 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ResetUserMFAUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, id string) error
+}
+
+type SetRoleMFARequirementUseCase interface {
+	Execute(ctx context.Context, role string, required bool) error
+}
+
+type GetMFARequiredRolesUseCase interface {
+	Execute(ctx context.Context) ([]string, error)
+}
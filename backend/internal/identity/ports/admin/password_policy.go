@@ -0,0 +1,16 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type GetStorePasswordPolicyUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string) (*dto.StorePasswordPolicyInfo, error)
+}
+
+type SetStorePasswordPolicyUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string, input dto.SetStorePasswordPolicyInput) (*dto.StorePasswordPolicyInfo, error)
+}
@@ -0,0 +1,11 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type RevokeUserSessionsUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, id string) error
+}
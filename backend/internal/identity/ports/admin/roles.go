@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type CreateRoleUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, input dto.CreateRoleInput) (*dto.RoleInfo, error)
+}
+
+type ListRolesUseCase interface {
+	Execute(ctx context.Context, storeID string) ([]dto.RoleInfo, error)
+}
+
+type UpdateRoleUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, name string, input dto.UpdateRoleInput) (*dto.RoleInfo, error)
+}
+
+type DeleteRoleUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, name string) error
+}
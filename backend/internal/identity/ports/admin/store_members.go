@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type AddStoreMemberUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string, input dto.AddStoreMemberInput) (*dto.StoreMemberInfo, error)
+}
+
+type ListStoreMembersUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string) ([]dto.StoreMemberInfo, error)
+}
+
+type UpdateStoreMemberUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string, userID string, input dto.UpdateStoreMemberInput) (*dto.StoreMemberInfo, error)
+}
+
+type RemoveStoreMemberUseCase interface {
+	Execute(ctx context.Context, actorID uuid.UUID, storeID string, userID string) error
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 )
@@ -9,4 +10,19 @@ import (
 type NotificationService interface {
 	SendChangePasswordEmail(ctx context.Context, toEmail vo.Email, resetToken vo.OTP) error
 	SendForgotPasswordEmail(ctx context.Context, toEmail vo.Email, resetToken vo.OTP) error
+	SendVerificationEmail(ctx context.Context, toEmail vo.Email, verificationToken string) error
+	SendInvitationEmail(ctx context.Context, toEmail vo.Email, inviteToken string) error
+	SendAccountLockedEmail(ctx context.Context, toEmail vo.Email, lockedUntil time.Time, unlockToken string) error
+	// SendEmailChangeConfirmation goes to the new address and
+	// SendEmailChangeNotice to the current one, so its owner learns of a
+	// change they did not ask for while the old address still works.
+	SendEmailChangeConfirmation(ctx context.Context, toEmail vo.Email, confirmationToken string) error
+	SendEmailChangeNotice(ctx context.Context, toEmail vo.Email, newEmail vo.Email) error
+	// SendNewDeviceLoginAlert tells the user about a successful login from a
+	// device and IP address they have not logged in from recently.
+	SendNewDeviceLoginAlert(ctx context.Context, toEmail vo.Email, device vo.DeviceFingerprint, ipAddress string, at time.Time) error
+	SendMagicLinkEmail(ctx context.Context, toEmail vo.Email, loginToken string) error
+	// SendAccountCreatedEmail welcomes a user an admin created for them, with
+	// a login link since the account has no password yet.
+	SendAccountCreatedEmail(ctx context.Context, toEmail vo.Email, username string, loginToken string) error
 }
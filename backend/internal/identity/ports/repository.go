@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/google/uuid"
@@ -14,20 +15,185 @@ type UserRepository interface {
 	Save(ctx context.Context, user *entity.User) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	FindByEmail(ctx context.Context, email vo.Email) (*entity.User, error)
+	FindByUsername(ctx context.Context, username string) (*entity.User, error)
 	GetUsersInfo(ctx context.Context, roles []vo.Role, pagination common.Pagination) (*common.PaginatedResult[*entity.User], error)
 	Update(ctx context.Context, user *entity.User) error
 }
 
+// TokenVersionRepository reads only the current token version of a user, so
+// it can be checked on every authenticated request.
+type TokenVersionRepository interface {
+	GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// RefreshTokenRepository keeps rotated tokens until they expire instead of
+// deleting them, so replaying one can be told apart from an unknown token.
+// Each token family is also a session, indexed per user with its metadata.
 type RefreshTokenRepository interface {
-	SaveRefreshToken(ctx context.Context, userID uuid.UUID, refreshToken string, expiresIn time.Duration) error
-	GetUserIDByRefreshToken(ctx context.Context, refreshToken string) (uuid.UUID, error)
+	SaveRefreshToken(ctx context.Context, refreshToken *entity.RefreshToken, expiresIn time.Duration) error
+	FindRefreshToken(ctx context.Context, refreshToken string) (*entity.RefreshToken, error)
+	// MarkRefreshTokenRotated reports whether the token had already been
+	// rotated, which makes concurrent rotations of the same token detectable.
+	MarkRefreshTokenRotated(ctx context.Context, refreshToken string) (bool, error)
 	DeleteRefreshToken(ctx context.Context, refreshToken string) error
+	RevokeFamily(ctx context.Context, userID uuid.UUID, familyID uuid.UUID) error
+	DeleteAllRefreshTokens(ctx context.Context, userID uuid.UUID) error
+	SaveSession(ctx context.Context, session *entity.Session, expiresIn time.Duration) error
+	FindSession(ctx context.Context, sessionID uuid.UUID) (*entity.Session, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
 }
 
+// OTPRepository starts the cooldown only when none is running and reports
+// whether it did, so concurrent requests cannot all get past it.
 type OTPRepository interface {
 	SaveOTP(ctx context.Context, email vo.Email, otp vo.OTP, expiresIn time.Duration) error
-	GetOTP(ctx context.Context, email vo.Email) (vo.OTP, error)
+	VerifyOTP(ctx context.Context, email vo.Email, otp vo.OTP) (bool, error)
+	IncrementOTPAttempts(ctx context.Context, email vo.Email) (int, error)
 	DeleteOTP(ctx context.Context, email vo.Email) error
+	StartOTPCooldown(ctx context.Context, email vo.Email, duration time.Duration) (bool, error)
+}
+
+type MFARepository interface {
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error)
+	Save(ctx context.Context, mfa *entity.UserMFA) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+type MFAPolicyRepository interface {
+	GetRequiredRoles(ctx context.Context) ([]vo.Role, error)
+	SetRoleRequirement(ctx context.Context, role vo.Role, required bool) error
+}
+
+type MFAChallengeRepository interface {
+	SaveChallenge(ctx context.Context, challenge string, userID uuid.UUID, expiresIn time.Duration) error
+	GetUserIDByChallenge(ctx context.Context, challenge string) (uuid.UUID, error)
+	DeleteChallenge(ctx context.Context, challenge string) error
+}
+
+// ImpersonationRepository keeps an impersonation only while it lasts, so the
+// auth middleware can reject its token as soon as it is ended. Delete reports
+// entity.ErrImpersonationNotFound when it was already gone, so a concurrent
+// end is only recorded once.
+type ImpersonationRepository interface {
+	Save(ctx context.Context, impersonation *entity.Impersonation) error
+	Find(ctx context.Context, id uuid.UUID) (*entity.Impersonation, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// SigningKeyRepository stores the access token keyring so every instance
+// signs with the same key and a restart does not invalidate issued tokens.
+// Private keys are encrypted at rest; FindAll returns them decrypted.
+type SigningKeyRepository interface {
+	Save(ctx context.Context, key *entity.SigningKey) error
+	FindAll(ctx context.Context) ([]*entity.SigningKey, error)
+	DeleteRetiredBefore(ctx context.Context, cutoff time.Time) error
+}
+
+// APIKeyRepository updates revocation and last use separately, so recording a
+// use can never undo a revocation that happened in between.
+type APIKeyRepository interface {
+	Save(ctx context.Context, key *entity.APIKey) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.APIKey, error)
+	FindByHash(ctx context.Context, hash string) (*entity.APIKey, error)
+	List(ctx context.Context, storeID *uuid.UUID) ([]*entity.APIKey, error)
+	UpdateRevokedAt(ctx context.Context, key *entity.APIKey) error
+	UpdateLastUsedAt(ctx context.Context, key *entity.APIKey) error
+}
+
+// RoleRepository stores the role catalog. List returns the system roles
+// together with the custom roles of storeID, or every role when it is nil.
+// CountUsers counts the users holding the role globally or in any store.
+type RoleRepository interface {
+	Save(ctx context.Context, role *entity.Role) error
+	Update(ctx context.Context, role *entity.Role) error
+	Delete(ctx context.Context, name vo.Role) error
+	FindByName(ctx context.Context, name vo.Role) (*entity.Role, error)
+	List(ctx context.Context, storeID *uuid.UUID) ([]*entity.Role, error)
+	CountUsers(ctx context.Context, name vo.Role) (int, error)
+}
+
+// RoleCatalogProvider serves the role catalog on every authorization check,
+// so implementations are expected to cache it. Invalidate drops the cached
+// copy after a change made by this instance; other instances pick changes up
+// when their cache expires.
+type RoleCatalogProvider interface {
+	Catalog(ctx context.Context) (vo.RoleCatalog, error)
+	Invalidate()
+}
+
+// StoreMembershipRepository stores the per-store roles of users. Find returns
+// nil when the user is not a member of the store.
+type StoreMembershipRepository interface {
+	Save(ctx context.Context, membership *entity.StoreMembership) error
+	Update(ctx context.Context, membership *entity.StoreMembership) error
+	Delete(ctx context.Context, userID, storeID uuid.UUID) error
+	Find(ctx context.Context, userID, storeID uuid.UUID) (*entity.StoreMembership, error)
+	ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.StoreMembership, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.StoreMembership, error)
+}
+
+// InvitationRepository stores staff invitations. UpdateAcceptedAt only
+// succeeds while the invitation is still pending and returns
+// entity.ErrInvalidInvitation otherwise, so two concurrent acceptances of the
+// same token cannot both create an account.
+type InvitationRepository interface {
+	Save(ctx context.Context, invitation *entity.Invitation) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Invitation, error)
+	FindByTokenHash(ctx context.Context, hash string) (*entity.Invitation, error)
+	ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.Invitation, error)
+	ListByEmail(ctx context.Context, email vo.Email) ([]*entity.Invitation, error)
+	// RedactEmail replaces the address on every invitation sent to email,
+	// when the person it belongs to asks to be forgotten.
+	RedactEmail(ctx context.Context, email vo.Email, replacement vo.Email) error
+	UpdateAcceptedAt(ctx context.Context, invitation *entity.Invitation) error
+	UpdateRevokedAt(ctx context.Context, invitation *entity.Invitation) error
+}
+
+// PasswordHistoryRepository keeps the former password hashes of each user.
+// ListRecent returns them newest first; Add trims the history of the user to
+// its keep most recent entries.
+type PasswordHistoryRepository interface {
+	Add(ctx context.Context, userID uuid.UUID, password vo.Password, keep int) error
+	ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]vo.Password, error)
+	DeleteAll(ctx context.Context, userID uuid.UUID) error
+}
+
+// StorePasswordPolicyRepository stores the password rules of each store.
+// Find returns nil when the store never set a policy. MaxHistorySizeForUser
+// returns the largest history size among the stores the user is an active
+// member of, or 0 when there is none.
+type StorePasswordPolicyRepository interface {
+	Save(ctx context.Context, policy *entity.StorePasswordPolicy) error
+	Find(ctx context.Context, storeID uuid.UUID) (*entity.StorePasswordPolicy, error)
+	MaxHistorySizeForUser(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// AuditEventRepository is append-only: events are never updated or deleted.
+// List returns the newest events first.
+type AuditEventRepository interface {
+	Append(ctx context.Context, event *entity.AuditEvent) error
+	List(ctx context.Context, filter dto.AuditFilter, pagination common.Pagination) (*common.PaginatedResult[*entity.AuditEvent], error)
+}
+
+// LoginHistoryRepository keeps the login attempts of each user. SeenFrom
+// reports whether the user logged in successfully from the same device and IP
+// address since the given time, and HasSucceeded whether they ever did, so a
+// first login is not mistaken for one from a new device.
+type LoginHistoryRepository interface {
+	Append(ctx context.Context, attempt *entity.LoginAttempt) error
+	List(ctx context.Context, userID uuid.UUID, pagination common.Pagination) (*common.PaginatedResult[*entity.LoginAttempt], error)
+	SeenFrom(ctx context.Context, userID uuid.UUID, device vo.DeviceFingerprint, ipAddress string, since time.Time) (bool, error)
+	HasSucceeded(ctx context.Context, userID uuid.UUID) (bool, error)
+	DeleteAll(ctx context.Context, userID uuid.UUID) error
+}
+
+// MagicLinkRepository remembers the login links that were sent and not used
+// yet. Consume removes the link in the same step it reads it, so two requests
+// racing with the same link cannot both log in; it returns
+// entity.ErrInvalidMagicLink when the link was used or has expired.
+type MagicLinkRepository interface {
+	Save(ctx context.Context, linkID uuid.UUID, userID uuid.UUID, expiresIn time.Duration) error
+	Consume(ctx context.Context, linkID uuid.UUID) (uuid.UUID, error)
 }
 
 type RateLimiterRepository interface {
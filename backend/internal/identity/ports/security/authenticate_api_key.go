@@ -0,0 +1,11 @@
+package security
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+)
+
+type AuthenticateAPIKeyUseCase interface {
+	Execute(ctx context.Context, rawKey string) (*dto.APIKeyClaims, error)
+}
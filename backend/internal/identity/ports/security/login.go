@@ -7,5 +7,5 @@ import (
 )
 
 type LoginUseCase interface {
-	Execute(ctx context.Context, input *dto.LoginRequest) (*dto.LoginResult, error)
+	Execute(ctx context.Context, input *dto.LoginRequest, client dto.ClientInfo) (*dto.LoginResult, error)
 }
@@ -0,0 +1,15 @@
+package security
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+)
+
+type RequestMagicLinkUseCase interface {
+	Execute(ctx context.Context, email string) error
+}
+
+type ConsumeMagicLinkUseCase interface {
+	Execute(ctx context.Context, token string, client dto.ClientInfo) (*dto.LoginResult, error)
+}
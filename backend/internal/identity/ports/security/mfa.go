@@ -0,0 +1,20 @@
+package security
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type StartMFAEnrollmentUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID) (*dto.MFAEnrollment, error)
+}
+
+type ConfirmMFAEnrollmentUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+}
+
+type VerifyMFALoginUseCase interface {
+	Execute(ctx context.Context, challenge string, code string, client dto.ClientInfo) (*dto.LoginResult, error)
+}
@@ -0,0 +1,7 @@
+package security
+
+import "context"
+
+type ResetPasswordUseCase interface {
+	Execute(ctx context.Context, email, otp, newPassword string) error
+}
@@ -7,5 +7,5 @@ import (
 )
 
 type RotateRefreshTokenUseCase interface {
-	Execute(ctx context.Context, refreshToken string) (*dto.LoginResult, error)
+	Execute(ctx context.Context, refreshToken string, client dto.ClientInfo) (*dto.LoginResult, error)
 }
@@ -0,0 +1,14 @@
+package security
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+// SwitchStoreUseCase rotates the session into another active store. An
+// empty storeID leaves the current store and returns to the global roles.
+type SwitchStoreUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, storeID string, refreshToken string, client dto.ClientInfo) (*dto.LoginResult, error)
+}
@@ -5,9 +5,22 @@ import (
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
 )
 
+// TokenManager issues access tokens. GenerateStoreTokens stamps the active
+// store in the claims, so authorization resolves the roles of the user's
+// membership in that store. GenerateImpersonationToken issues a lone access
+// token acting as user, which also names the impersonating admin.
 type TokenManager interface {
 	GenerateTokens(ctx context.Context, user *entity.User) (string, string, error)
+	GenerateStoreTokens(ctx context.Context, user *entity.User, storeID uuid.UUID) (string, string, error)
+	GenerateImpersonationToken(ctx context.Context, user *entity.User, impersonation *entity.Impersonation) (string, error)
 	ValidateAccessToken(tokenString string) (*dto.UserClaims, error)
 }
+
+// PublicKeySet exposes the public keys that validate access tokens, so other
+// services can check tokens without holding any signing secret.
+type PublicKeySet interface {
+	JWKS() dto.JWKS
+}
@@ -0,0 +1,7 @@
+package security
+
+import "context"
+
+type UnlockAccountUseCase interface {
+	Execute(ctx context.Context, token string) error
+}
@@ -0,0 +1,28 @@
+package security
+
+import (
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+)
+
+type VerificationTokenManager interface {
+	GenerateVerificationToken(user *entity.User) (string, error)
+	ValidateVerificationToken(tokenString string) (uuid.UUID, vo.Email, error)
+	// GenerateUnlockToken signs a link that releases the current lockout of
+	// the user. ValidateUnlockToken returns the lockout it was issued for, so
+	// callers can refuse it once that lockout is over.
+	GenerateUnlockToken(user *entity.User) (string, error)
+	ValidateUnlockToken(tokenString string) (uuid.UUID, time.Time, error)
+	// GenerateEmailChangeToken binds the new address to the current one.
+	// ValidateEmailChangeToken returns both, so a link issued before another
+	// change can be refused.
+	GenerateEmailChangeToken(user *entity.User, newEmail vo.Email) (string, error)
+	ValidateEmailChangeToken(tokenString string) (userID uuid.UUID, currentEmail vo.Email, newEmail vo.Email, err error)
+	// GenerateMagicLinkToken signs a login link for the user. The link ID
+	// names the single use left of it, kept by ports.MagicLinkRepository.
+	GenerateMagicLinkToken(user *entity.User, linkID uuid.UUID) (string, error)
+	ValidateMagicLinkToken(tokenString string) (userID uuid.UUID, email vo.Email, linkID uuid.UUID, err error)
+}
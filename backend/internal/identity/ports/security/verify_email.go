@@ -0,0 +1,11 @@
+package security
+
+import "context"
+
+type VerifyEmailUseCase interface {
+	Execute(ctx context.Context, token string) error
+}
+
+type ResendVerificationEmailUseCase interface {
+	Execute(ctx context.Context, email string) error
+}
@@ -0,0 +1,16 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type AcceptInvitationUseCase interface {
+	Execute(ctx context.Context, input dto.AcceptInvitationInput) error
+}
+
+type AcceptInvitationAsMemberUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, input dto.AcceptInvitationAsMemberInput) error
+}
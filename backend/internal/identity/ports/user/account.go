@@ -0,0 +1,16 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type DeleteAccountUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, password string) error
+}
+
+type ExportMyDataUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID) (*dto.PersonalDataExport, error)
+}
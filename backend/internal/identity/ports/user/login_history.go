@@ -0,0 +1,13 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type ListLoginHistoryUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, pagination common.Pagination) (*common.PaginatedResult[dto.LoginAttemptInfo], error)
+}
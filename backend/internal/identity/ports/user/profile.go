@@ -0,0 +1,16 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type UpdateProfileUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, input dto.UpdateProfileInput) (*dto.UpdateProfileResult, error)
+}
+
+type ConfirmEmailChangeUseCase interface {
+	Execute(ctx context.Context, token string) error
+}
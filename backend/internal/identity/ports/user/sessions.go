@@ -0,0 +1,20 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type ListSessionsUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID) ([]dto.SessionInfo, error)
+}
+
+type RevokeSessionUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, sessionID string) error
+}
+
+type RevokeAllSessionsUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,12 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/google/uuid"
+)
+
+type ListMyStoresUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID) ([]dto.StoreMemberInfo, error)
+}
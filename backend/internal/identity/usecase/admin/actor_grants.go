@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+)
+
+// actorGrants returns the permissions the acting user holds. It reads the
+// stored user instead of the token claims, so a demotion takes effect before
+// the demoted user's access token expires.
+func actorGrants(ctx context.Context, userRepo ports.UserRepository, catalog vo.RoleCatalog, logger ports.Logger, actorID uuid.UUID) (vo.PermissionSet, error) {
+	actor, err := userRepo.FindByID(ctx, actorID)
+	if err != nil {
+		logger.Error("failed to find actor", err, "actorID", actorID)
+		return nil, err
+	}
+
+	if actor == nil {
+		logger.Info("actor not found", "actorID", actorID)
+		return nil, entity.ErrUserNotFound
+	}
+
+	return catalog.GrantsOf(actor.Roles()...), nil
+}
+
+// actorStoreGrants adds to the global grants of the actor those of their
+// active membership in storeID, the same roles the auth middleware resolves
+// for a session switched into that store.
+func actorStoreGrants(
+	ctx context.Context,
+	userRepo ports.UserRepository,
+	memberships ports.StoreMembershipRepository,
+	catalog vo.RoleCatalog,
+	logger ports.Logger,
+	actorID uuid.UUID,
+	storeID uuid.UUID,
+) (vo.PermissionSet, error) {
+	grants, err := actorGrants(ctx, userRepo, catalog, logger, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	membership, err := memberships.Find(ctx, actorID, storeID)
+	if err != nil {
+		logger.Error("failed to find actor membership", err, "actorID", actorID, "storeID", storeID)
+		return nil, err
+	}
+
+	if membership != nil && membership.IsActive() {
+		for permission := range catalog.GrantsOf(membership.Roles()...) {
+			grants[permission] = struct{}{}
+		}
+	}
+
+	return grants, nil
+}
+
+// checkStorePermission refuses an actor who holds permission neither
+// globally nor through their membership in storeID. Routes of a store only
+// check the store the session is switched into, so reads of another store
+// must be checked here.
+func checkStorePermission(
+	ctx context.Context,
+	userRepo ports.UserRepository,
+	memberships ports.StoreMembershipRepository,
+	roles ports.RoleCatalogProvider,
+	logger ports.Logger,
+	actorID uuid.UUID,
+	storeID uuid.UUID,
+	permission vo.Permission,
+) error {
+	catalog, err := roles.Catalog(ctx)
+	if err != nil {
+		logger.Error("failed to load role catalog", err)
+		return err
+	}
+
+	grants, err := actorStoreGrants(ctx, userRepo, memberships, catalog, logger, actorID, storeID)
+	if err != nil {
+		return err
+	}
+
+	if !grants.Has(permission) {
+		logger.Info("security event: access outside actor stores refused", "actorID", actorID, "storeID", storeID, "permission", permission)
+		return entity.ErrPrivilegeEscalation
+	}
+
+	return nil
+}
+
+func parsePermissions(values []string) ([]vo.Permission, error) {
+	permissions := make([]vo.Permission, 0, len(values))
+	for _, value := range values {
+		permission, err := vo.NewPermission(value)
+		if err != nil {
+			return nil, err
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
+
+// checkReach refuses to act on a user whose roles grant a permission the
+// actor lacks, the test a role change applies to the current roles: a manager
+// cannot deactivate, unlock, log out or reset the MFA of an admin.
+func checkReach(ctx context.Context, userRepo ports.UserRepository, roles ports.RoleCatalogProvider, logger ports.Logger, actorID uuid.UUID, target *entity.User) error {
+	catalog, err := roles.Catalog(ctx)
+	if err != nil {
+		logger.Error("failed to load role catalog", err)
+		return err
+	}
+
+	grants, err := actorGrants(ctx, userRepo, catalog, logger, actorID)
+	if err != nil {
+		return err
+	}
+
+	if !grants.Covers(catalog.GrantsOf(target.Roles()...)) {
+		logger.Info("security event: action on a user beyond actor permissions refused",
+			"actorID", actorID,
+			"userID", target.ID(),
+			"roles", target.Roles(),
+		)
+		return entity.ErrPrivilegeEscalation
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateAPIKeyUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	storeID := uuid.New()
+	otherStore := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	catalog := vo.SystemRoleCatalog().With("INTEGRATOR", []vo.Permission{vo.PermAPIKeysManage, vo.PermOrdersRead})
+	integrator, _ := entity.NewStoreMembership(adminID, storeID, []vo.Role{"INTEGRATOR"}, time.Now())
+
+	tests := []struct {
+		name    string
+		input   dto.CreateAPIKeyInput
+		setup   func(m *MockAPIKeyRepository)
+		wantErr error
+	}{
+		{
+			name:  "Success",
+			input: dto.CreateAPIKeyInput{Name: "erp sync", StoreID: storeID, Scopes: []string{"orders:read", "stock:write"}},
+			setup: func(m *MockAPIKeyRepository) {
+				m.On("Save", ctx, mock.MatchedBy(func(k *entity.APIKey) bool {
+					return k.StoreID() == storeID && k.CreatedBy() == adminID && len(k.Scopes()) == 2 && len(k.Hash()) == 64
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "Escalation - Scope Creator Lacks",
+			input:   dto.CreateAPIKeyInput{Name: "erp sync", StoreID: storeID, Scopes: []string{"orders:read", "users:read"}},
+			setup:   func(m *MockAPIKeyRepository) {},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Escalation - Store Creator Cannot Reach",
+			input:   dto.CreateAPIKeyInput{Name: "erp sync", StoreID: otherStore, Scopes: []string{"orders:read"}},
+			setup:   func(m *MockAPIKeyRepository) {},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Invalid Scope",
+			input:   dto.CreateAPIKeyInput{Name: "erp sync", StoreID: storeID, Scopes: []string{"orders:delete"}},
+			setup:   func(m *MockAPIKeyRepository) {},
+			wantErr: vo.ErrInvalidAPIKeyScope,
+		},
+		{
+			name:    "Expiry In Past",
+			input:   dto.CreateAPIKeyInput{Name: "erp sync", StoreID: storeID, Scopes: []string{"orders:read"}, ExpiresAt: &past},
+			setup:   func(m *MockAPIKeyRepository) {},
+			wantErr: entity.ErrAPIKeyExpiryInPast,
+		},
+		{
+			name:  "Save Error",
+			input: dto.CreateAPIKeyInput{Name: "erp sync", StoreID: storeID, Scopes: []string{"orders:read"}},
+			setup: func(m *MockAPIKeyRepository) {
+				m.On("Save", ctx, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAPIKeyRepository)
+			tt.setup(mockRepo)
+
+			// the creator manages keys only through a custom role in storeID
+			users := new(MockUserRepository)
+			users.On("FindByID", ctx, adminID).Return(setupRoleActor(adminID, vo.EmployeeRole), nil).Maybe()
+			memberships := new(MockStoreMembershipRepository)
+			memberships.On("Find", ctx, adminID, storeID).Return(integrator, nil).Maybe()
+			memberships.On("Find", ctx, adminID, otherStore).Return(nil, nil).Maybe()
+			audit := newMockAuditLog()
+
+			uc := NewCreateAPIKeyUseCase(mockRepo, memberships, users, newStaticRoleCatalog(catalog), audit, new(MockLogger))
+			created, err := uc.Execute(ctx, adminID, tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, created)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+
+				token, err := vo.NewAPIKeyToken(created.Key)
+				assert.NoError(t, err)
+				assert.Equal(t, token.Prefix(), created.Prefix)
+				assert.Equal(t, []string{"orders:read", "stock:write"}, created.Scopes)
+				assert.Equal(t, []vo.AuditAction{vo.AuditAPIKeyCreated}, audit.recordedActions())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListAPIKeysUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	token, _ := vo.GenerateAPIKeyToken()
+	older, _ := entity.NewAPIKey("older", storeID, []vo.APIKeyScope{vo.ScopeOrdersRead}, token, uuid.New(), nil, time.Now().Add(-time.Hour))
+	newer, _ := entity.NewAPIKey("newer", storeID, []vo.APIKeyScope{vo.ScopeOrdersRead}, token, uuid.New(), nil, time.Now())
+
+	t.Run("Filtered By Store, Newest First", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("List", ctx, &storeID).Return([]*entity.APIKey{older, newer}, nil)
+
+		keys, err := NewListAPIKeysUseCase(mockRepo, new(MockLogger)).Execute(ctx, storeID.String())
+
+		assert.NoError(t, err)
+		assert.Len(t, keys, 2)
+		assert.Equal(t, "newer", keys[0].Name)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("All Stores", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("List", ctx, (*uuid.UUID)(nil)).Return([]*entity.APIKey{}, nil)
+
+		keys, err := NewListAPIKeysUseCase(mockRepo, new(MockLogger)).Execute(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Store ID", func(t *testing.T) {
+		_, err := NewListAPIKeysUseCase(new(MockAPIKeyRepository), new(MockLogger)).Execute(ctx, "invalid-uuid")
+		assert.Error(t, err)
+	})
+}
+
+func TestRevokeAPIKeyUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	token, _ := vo.GenerateAPIKeyToken()
+	setupKey := func() *entity.APIKey {
+		key, _ := entity.NewAPIKey("erp sync", uuid.New(), []vo.APIKeyScope{vo.ScopeOrdersRead}, token, uuid.New(), nil, time.Now())
+		return key
+	}
+	key := setupKey()
+	revoked := setupKey()
+	_ = revoked.Revoke(time.Now())
+
+	tests := []struct {
+		name    string
+		id      string
+		setup   func(m *MockAPIKeyRepository)
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Success",
+			id:   key.ID().String(),
+			setup: func(m *MockAPIKeyRepository) {
+				m.On("FindByID", ctx, key.ID()).Return(key, nil)
+				m.On("UpdateRevokedAt", ctx, mock.MatchedBy(func(k *entity.APIKey) bool {
+					return k.IsRevoked()
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   key.ID().String(),
+			setup: func(m *MockAPIKeyRepository) {
+				m.On("FindByID", ctx, key.ID()).Return(nil, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrAPIKeyNotFound,
+		},
+		{
+			name: "Already Revoked",
+			id:   revoked.ID().String(),
+			setup: func(m *MockAPIKeyRepository) {
+				m.On("FindByID", ctx, revoked.ID()).Return(revoked, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrAPIKeyRevoked,
+		},
+		{
+			name:    "Invalid UUID",
+			id:      "invalid-uuid",
+			setup:   func(m *MockAPIKeyRepository) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAPIKeyRepository)
+			tt.setup(mockRepo)
+
+			audit := newMockAuditLog()
+
+			err := NewRevokeAPIKeyUseCase(mockRepo, audit, new(MockLogger)).Execute(ctx, uuid.New(), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditAPIKeyRevoked}, audit.recordedActions())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
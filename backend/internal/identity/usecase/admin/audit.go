@@ -0,0 +1,15 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+// actorAuditEvent describes an action taken by actorID on targetID.
+func actorAuditEvent(ctx context.Context, action vo.AuditAction, actorID, targetID uuid.UUID) *entity.AuditEvent {
+	return auditlog.Event(ctx, action, &actorID, &targetID)
+}
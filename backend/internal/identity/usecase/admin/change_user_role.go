@@ -7,23 +7,28 @@ import (
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
 	"github.com/google/uuid"
 )
 
 type changeUserRoleUseCase struct {
 	userRepo ports.UserRepository
+	roles    ports.RoleCatalogProvider
+	audit    ports.AuditEventRepository
 	logger   ports.Logger
 }
 
-func NewChangeUserRoleUseCase(userRepo ports.UserRepository, logger ports.Logger) admin.ChangeUserRoleUseCase {
+func NewChangeUserRoleUseCase(userRepo ports.UserRepository, roles ports.RoleCatalogProvider, audit ports.AuditEventRepository, logger ports.Logger) admin.ChangeUserRoleUseCase {
 	return &changeUserRoleUseCase{
 		userRepo: userRepo,
+		roles:    roles,
+		audit:    audit,
 		logger:   logger,
 	}
 }
 
-func (u *changeUserRoleUseCase) Execute(ctx context.Context, id string, roles []string) error {
-	u.logger.Debug("starting change user roles", "userID", id, "newRoles", roles)
+func (u *changeUserRoleUseCase) Execute(ctx context.Context, actorID uuid.UUID, id string, roles []string) error {
+	u.logger.Debug("starting change user roles", "actorID", actorID, "userID", id, "newRoles", roles)
 
 	userID, err := uuid.Parse(id)
 	if err != nil {
@@ -31,14 +36,27 @@ func (u *changeUserRoleUseCase) Execute(ctx context.Context, id string, roles []
 		return err
 	}
 
+	catalog, err := u.roles.Catalog(ctx)
+	if err != nil {
+		u.logger.Error("failed to load role catalog", err)
+		return err
+	}
+
 	rolesVo := make([]vo.Role, 0, len(roles))
 	for _, r := range roles {
-		validRole, err := vo.NewRole(r)
+		validRole, err := catalog.Resolve(r)
 		if err != nil {
 			u.logger.Error("invalid role provided", err, "role", r)
 			return err
 		}
 
+		// a store's custom role held globally would grant its permissions in
+		// every store; those roles go through memberships instead
+		if !vo.IsSystemRole(validRole) {
+			u.logger.Info("custom role refused as a global role", "role", validRole)
+			return entity.ErrCustomRoleNotGlobal
+		}
+
 		rolesVo = append(rolesVo, validRole)
 	}
 
@@ -53,14 +71,45 @@ func (u *changeUserRoleUseCase) Execute(ctx context.Context, id string, roles []
 		return entity.ErrUserNotFound
 	}
 
-	u.logger.Info("updating user roles", "userID", userID, "oldRoles", user.Roles(), "newRoles", rolesVo)
+	if err := u.checkEscalation(ctx, catalog, actorID, user.Roles(), rolesVo); err != nil {
+		return err
+	}
+
+	previous := user.Roles()
+	u.logger.Info("updating user roles", "userID", userID, "oldRoles", previous, "newRoles", rolesVo)
 	user.ReplaceRoles(rolesVo)
+	user.RevokeSessions()
 
 	if err := u.userRepo.Update(ctx, user); err != nil {
 		u.logger.Error("failed to update user roles", err, "userID", userID)
 		return err
 	}
 
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditRolesChanged, actorID, userID).
+		WithChange("roles", previous, user.Roles()))
+
 	u.logger.Info("user roles updated successfully", "userID", userID)
 	return nil
 }
+
+// checkEscalation refuses the change unless the actor holds every permission
+// of both the current and the new roles. Covering the new roles stops anyone
+// from granting more than they have, themselves included; covering the
+// current ones stops them from demoting someone above them.
+func (u *changeUserRoleUseCase) checkEscalation(ctx context.Context, catalog vo.RoleCatalog, actorID uuid.UUID, current, next []vo.Role) error {
+	grants, err := actorGrants(ctx, u.userRepo, catalog, u.logger, actorID)
+	if err != nil {
+		return err
+	}
+
+	if !grants.Covers(catalog.GrantsOf(current...)) || !grants.Covers(catalog.GrantsOf(next...)) {
+		u.logger.Info("security event: role change beyond actor permissions refused",
+			"actorID", actorID,
+			"currentRoles", current,
+			"newRoles", next,
+		)
+		return entity.ErrPrivilegeEscalation
+	}
+
+	return nil
+}
@@ -14,16 +14,27 @@ import (
 func TestChangeUserRoleUseCase_Execute(t *testing.T) {
 	ctx := context.Background()
 	userID := uuid.New()
+	adminID := uuid.New()
+	managerID := uuid.New()
+	catalog := vo.SystemRoleCatalog().
+		With("CASHIER", []vo.Permission{vo.PermOrdersRead, vo.PermOrdersWrite}).
+		With("KEYMASTER", []vo.Permission{vo.PermAPIKeysManage})
 	email, _ := vo.NewEmail("test@example.com")
-	password, _ := vo.NewPassword("Password123!", "pepper")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
 	
 	setupUser := func() *entity.User {
-		user, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true)
+		user, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
 		return user
 	}
 
+	setupActor := func(id uuid.UUID, role vo.Role) *entity.User {
+		actor, _ := entity.RestoreUser(id, "actor@example.com", "actor", password.String(), []string{role.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+		return actor
+	}
+
 	tests := []struct {
 		name    string
+		actorID uuid.UUID
 		id      string
 		roles   []string
 		setup   func(m *MockUserRepository)
@@ -31,23 +42,26 @@ func TestChangeUserRoleUseCase_Execute(t *testing.T) {
 		err     error
 	}{
 		{
-			name:  "Success Change Roles",
-			id:    userID.String(),
-			roles: []string{"ADMIN", "MANAGER"},
+			name:    "Success Change Roles",
+			actorID: adminID,
+			id:      userID.String(),
+			roles:   []string{"ADMIN", "MANAGER"},
 			setup: func(m *MockUserRepository) {
 				user := setupUser()
 				m.On("FindByID", ctx, userID).Return(user, nil)
+				m.On("FindByID", ctx, adminID).Return(setupActor(adminID, vo.AdminRole), nil)
 				m.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
 					roles := u.Roles()
-					return len(roles) == 2 && roles[0] == vo.AdminRole && roles[1] == vo.ManagerRole
+					return len(roles) == 2 && roles[0] == vo.AdminRole && roles[1] == vo.ManagerRole && u.TokenVersion() == 1
 				})).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name:  "Invalid Role Name",
-			id:    userID.String(),
-			roles: []string{"INVALID_ROLE"},
+			name:    "Invalid Role Name",
+			actorID: adminID,
+			id:      userID.String(),
+			roles:   []string{"INVALID_ROLE"},
 			setup: func(m *MockUserRepository) {
 				// No FindByID expected as NewRole should fail first
 			},
@@ -55,25 +69,104 @@ func TestChangeUserRoleUseCase_Execute(t *testing.T) {
 			err:     vo.ErrInvalidRole,
 		},
 		{
-			name:  "User Not Found",
-			id:    userID.String(),
-			roles: []string{"ADMIN"},
+			name:    "User Not Found",
+			actorID: adminID,
+			id:      userID.String(),
+			roles:   []string{"ADMIN"},
 			setup: func(m *MockUserRepository) {
 				m.On("FindByID", ctx, userID).Return(nil, nil)
 			},
 			wantErr: true,
 			err:     entity.ErrUserNotFound,
 		},
+		{
+			name:    "Manager Grants Manager",
+			actorID: managerID,
+			id:      userID.String(),
+			roles:   []string{"MANAGER"},
+			setup: func(m *MockUserRepository) {
+				m.On("FindByID", ctx, userID).Return(setupUser(), nil)
+				m.On("FindByID", ctx, managerID).Return(setupActor(managerID, vo.ManagerRole), nil)
+				m.On("Update", ctx, mock.Anything).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Escalation - Manager Grants Admin",
+			actorID: managerID,
+			id:      userID.String(),
+			roles:   []string{"ADMIN"},
+			setup: func(m *MockUserRepository) {
+				m.On("FindByID", ctx, userID).Return(setupUser(), nil)
+				m.On("FindByID", ctx, managerID).Return(setupActor(managerID, vo.ManagerRole), nil)
+			},
+			wantErr: true,
+			err:     entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Escalation - Manager Demotes Admin",
+			actorID: managerID,
+			id:      adminID.String(),
+			roles:   []string{"EMPLOYEE"},
+			setup: func(m *MockUserRepository) {
+				m.On("FindByID", ctx, adminID).Return(setupActor(adminID, vo.AdminRole), nil)
+				m.On("FindByID", ctx, managerID).Return(setupActor(managerID, vo.ManagerRole), nil)
+			},
+			wantErr: true,
+			err:     entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Escalation - Manager Promotes Self",
+			actorID: managerID,
+			id:      managerID.String(),
+			roles:   []string{"MANAGER", "ADMIN"},
+			setup: func(m *MockUserRepository) {
+				m.On("FindByID", ctx, managerID).Return(setupActor(managerID, vo.ManagerRole), nil)
+			},
+			wantErr: true,
+			err:     entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Custom Role Refused As Global Role",
+			actorID: managerID,
+			id:      userID.String(),
+			roles:   []string{"cashier"},
+			setup:   func(m *MockUserRepository) {},
+			wantErr: true,
+			err:     entity.ErrCustomRoleNotGlobal,
+		},
+		{
+			name:    "Custom Role Above Own Permissions Refused As Global Role",
+			actorID: managerID,
+			id:      userID.String(),
+			roles:   []string{"EMPLOYEE", "KEYMASTER"},
+			setup:   func(m *MockUserRepository) {},
+			wantErr: true,
+			err:     entity.ErrCustomRoleNotGlobal,
+		},
+		{
+			name:    "Actor Not Found",
+			actorID: managerID,
+			id:      userID.String(),
+			roles:   []string{"EMPLOYEE"},
+			setup: func(m *MockUserRepository) {
+				m.On("FindByID", ctx, userID).Return(setupUser(), nil)
+				m.On("FindByID", ctx, managerID).Return(nil, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrUserNotFound,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := new(MockUserRepository)
 			l := new(MockLogger)
+			audit := newMockAuditLog()
 			tt.setup(m)
-			uc := NewChangeUserRoleUseCase(m, l)
+			uc := NewChangeUserRoleUseCase(m, newStaticRoleCatalog(catalog), audit, l)
 
-			err := uc.Execute(ctx, tt.id, tt.roles)
+			err := uc.Execute(ctx, tt.actorID, tt.id, tt.roles)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -82,6 +175,7 @@ func TestChangeUserRoleUseCase_Execute(t *testing.T) {
 				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditRolesChanged}, audit.recordedActions())
 			}
 			m.AssertExpectations(t)
 		})
@@ -4,25 +4,31 @@ import (
 	"context"
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
 	"github.com/google/uuid"
 )
 
 type changeUserStatusUseCase struct {
 	userRepo ports.UserRepository
+	roles    ports.RoleCatalogProvider
+	audit    ports.AuditEventRepository
 	logger   ports.Logger
 }
 
-func NewChangeUserStatusUseCase(userRepo ports.UserRepository, logger ports.Logger) admin.ChangeUserStatusUseCase {
+func NewChangeUserStatusUseCase(userRepo ports.UserRepository, roles ports.RoleCatalogProvider, audit ports.AuditEventRepository, logger ports.Logger) admin.ChangeUserStatusUseCase {
 	return &changeUserStatusUseCase{
 		userRepo: userRepo,
+		roles:    roles,
+		audit:    audit,
 		logger:   logger,
 	}
 }
 
-func (u *changeUserStatusUseCase) Execute(ctx context.Context, id string, active bool) error {
-	u.logger.Debug("starting change user status", "userID", id, "active", active)
+func (u *changeUserStatusUseCase) Execute(ctx context.Context, actorID uuid.UUID, id string, active bool) error {
+	u.logger.Debug("starting change user status", "actorID", actorID, "userID", id, "active", active)
 
 	userID, err := uuid.Parse(id)
 	if err != nil {
@@ -36,17 +42,25 @@ func (u *changeUserStatusUseCase) Execute(ctx context.Context, id string, active
 		return err
 	}
 
-	if user == nil {
+	// an anonymized account has nothing left to log in with and must not
+	// come back
+	if user == nil || user.IsDeleted() {
 		u.logger.Info("user not found for status change", "userID", userID)
 		return entity.ErrUserNotFound
 	}
 
+	if err := checkReach(ctx, u.userRepo, u.roles, u.logger, actorID, user); err != nil {
+		return err
+	}
+
+	wasActive := user.IsActive()
 	if active {
 		u.logger.Info("activating user", "userID", userID)
 		user.Activate()
 	} else {
 		u.logger.Info("deactivating user", "userID", userID)
 		user.Deactivate()
+		user.RevokeSessions()
 	}
 
 	if err := u.userRepo.Update(ctx, user); err != nil {
@@ -54,6 +68,9 @@ func (u *changeUserStatusUseCase) Execute(ctx context.Context, id string, active
 		return err
 	}
 
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditStatusChanged, actorID, userID).
+		WithChange("active", wasActive, active))
+
 	u.logger.Info("user status updated successfully", "userID", userID, "active", active)
 	return nil
 }
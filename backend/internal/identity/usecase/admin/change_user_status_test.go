@@ -3,6 +3,7 @@ package admin
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
@@ -14,11 +15,12 @@ import (
 func TestChangeUserStatusUseCase_Execute(t *testing.T) {
 	ctx := context.Background()
 	userID := uuid.New()
+	actorID := uuid.New()
 	email, _ := vo.NewEmail("test@example.com")
-	password, _ := vo.NewPassword("Password123!", "pepper")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
 	
 	setupUser := func() *entity.User {
-		user, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true)
+		user, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
 		return user
 	}
 
@@ -38,7 +40,7 @@ func TestChangeUserStatusUseCase_Execute(t *testing.T) {
 				user := setupUser()
 				m.On("FindByID", ctx, userID).Return(user, nil)
 				m.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
-					return u.ID() == userID && !u.IsActive()
+					return u.ID() == userID && !u.IsActive() && u.TokenVersion() == 1
 				})).Return(nil)
 			},
 			wantErr: false,
@@ -52,11 +54,22 @@ func TestChangeUserStatusUseCase_Execute(t *testing.T) {
 				user.Deactivate()
 				m.On("FindByID", ctx, userID).Return(user, nil)
 				m.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
-					return u.ID() == userID && u.IsActive()
+					return u.ID() == userID && u.IsActive() && u.TokenVersion() == 0
 				})).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name:   "Escalation - Target Above Actor",
+			id:     userID.String(),
+			active: false,
+			setup: func(m *MockUserRepository) {
+				target, _ := entity.RestoreUser(userID, "admin@example.com", "admin", password.String(), []string{vo.AdminRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+				m.On("FindByID", ctx, userID).Return(target, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrPrivilegeEscalation,
+		},
 		{
 			name:   "User Not Found",
 			id:     userID.String(),
@@ -67,6 +80,18 @@ func TestChangeUserStatusUseCase_Execute(t *testing.T) {
 			wantErr: true,
 			err:     entity.ErrUserNotFound,
 		},
+		{
+			name:   "Anonymized User Cannot Be Reactivated",
+			id:     userID.String(),
+			active: true,
+			setup: func(m *MockUserRepository) {
+				user := setupUser()
+				user.Anonymize(time.Now())
+				m.On("FindByID", ctx, userID).Return(user, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrUserNotFound,
+		},
 		{
 			name:    "Invalid UUID",
 			id:      "invalid-uuid",
@@ -102,10 +127,12 @@ func TestChangeUserStatusUseCase_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			m := new(MockUserRepository)
 			l := new(MockLogger)
+			audit := newMockAuditLog()
 			tt.setup(m)
-			uc := NewChangeUserStatusUseCase(m, l)
+			m.On("FindByID", ctx, actorID).Return(setupRoleActor(actorID, vo.ManagerRole), nil).Maybe()
+			uc := NewChangeUserStatusUseCase(m, newStaticRoleCatalog(vo.SystemRoleCatalog()), audit, l)
 
-			err := uc.Execute(ctx, tt.id, tt.active)
+			err := uc.Execute(ctx, actorID, tt.id, tt.active)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -114,6 +141,7 @@ func TestChangeUserStatusUseCase_Execute(t *testing.T) {
 				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditStatusChanged}, audit.recordedActions())
 			}
 			m.AssertExpectations(t)
 		})
@@ -2,8 +2,10 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
@@ -12,26 +14,34 @@ import (
 
 type getUsersInfoUseCase struct {
 	userRepo ports.UserRepository
+	roles    ports.RoleCatalogProvider
 	logger   ports.Logger
 }
 
-func NewGetUsersInfoUseCase(userRepo ports.UserRepository, logger ports.Logger) admin.GetUsersInfo {
+func NewGetUsersInfoUseCase(userRepo ports.UserRepository, roles ports.RoleCatalogProvider, logger ports.Logger) admin.GetUsersInfo {
 	return &getUsersInfoUseCase{
 		userRepo: userRepo,
+		roles:    roles,
 		logger:   logger,
 	}
 }
 
-func (uc *getUsersInfoUseCase) Execute(ctx context.Context, pagination common.Pagination, roles []string) (*common.PaginatedResult[*entity.User], error) {
+func (uc *getUsersInfoUseCase) Execute(ctx context.Context, pagination common.Pagination, roles []string) (*common.PaginatedResult[dto.AdminUserInfo], error) {
 	uc.logger.Debug("fetching users info", "pagination", pagination, "filterRoles", roles)
 
+	catalog, err := uc.roles.Catalog(ctx)
+	if err != nil {
+		uc.logger.Error("failed to load role catalog", err)
+		return nil, err
+	}
+
 	voRoles := make([]vo.Role, 0, len(roles))
 
 	if len(roles) == 0 {
-		voRoles = vo.AllRoles()
+		voRoles = catalog.Roles()
 	} else {
 		for _, role := range roles {
-			validRole, err := vo.NewRole(role)
+			validRole, err := catalog.Resolve(role)
 			if err != nil {
 				uc.logger.Error("invalid role in filter", err, "role", role)
 				return nil, err
@@ -46,6 +56,70 @@ func (uc *getUsersInfoUseCase) Execute(ctx context.Context, pagination common.Pa
 		return nil, err
 	}
 
-	uc.logger.Info("users info retrieved successfully", "count", len(result.Items), "total", result.TotalCount)
-	return result, nil
+	now := time.Now()
+	items := make([]dto.AdminUserInfo, 0, len(result.Items))
+	for _, user := range result.Items {
+		items = append(items, toAdminUserInfo(user, now))
+	}
+
+	uc.logger.Info("users info retrieved successfully", "count", len(items), "total", result.TotalCount)
+	return common.NewPaginatedResult(items, result.TotalCount, pagination), nil
+}
+
+// exportPageSize is the largest page the user listing serves.
+const exportPageSize = 100
+
+type exportUsersInfoUseCase struct {
+	getUsersInfoUseCase
+}
+
+func NewExportUsersInfoUseCase(userRepo ports.UserRepository, roles ports.RoleCatalogProvider, logger ports.Logger) admin.ExportUsersInfo {
+	return &exportUsersInfoUseCase{getUsersInfoUseCase{
+		userRepo: userRepo,
+		roles:    roles,
+		logger:   logger,
+	}}
+}
+
+// Execute walks the listing page by page, so the export holds exactly the
+// users an admin would see with the same filters.
+func (uc *exportUsersInfoUseCase) Execute(ctx context.Context, search, sort, direction string, roles []string) ([]dto.AdminUserInfo, error) {
+	uc.logger.Debug("exporting users info", "search", search, "filterRoles", roles)
+
+	var users []dto.AdminUserInfo
+
+	for page := 1; ; page++ {
+		result, err := uc.getUsersInfoUseCase.Execute(ctx, common.NewPagination(page, exportPageSize, search, sort, direction), roles)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, result.Items...)
+
+		if page >= result.TotalPages {
+			break
+		}
+	}
+
+	uc.logger.Info("users info exported successfully", "count", len(users))
+	return users, nil
+}
+
+func toAdminUserInfo(user *entity.User, now time.Time) dto.AdminUserInfo {
+	roles := make([]string, 0, len(user.Roles()))
+	for _, role := range user.Roles() {
+		roles = append(roles, role.String())
+	}
+
+	return dto.AdminUserInfo{
+		ID:             user.ID(),
+		Username:       user.Username(),
+		Email:          user.Email().String(),
+		Roles:          roles,
+		Active:         user.IsActive(),
+		EmailVerified:  user.EmailVerified(),
+		FailedAttempts: user.FailedAttempts(),
+		Locked:         user.IsLocked(now),
+		LockedUntil:    user.LockedUntil(),
+	}
 }
@@ -3,10 +3,12 @@ package admin
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/common"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -62,7 +64,7 @@ func TestGetUsersInfoUseCase_Execute(t *testing.T) {
 			m := new(MockUserRepository)
 			l := new(MockLogger)
 			tt.setup(m)
-			uc := NewGetUsersInfoUseCase(m, l)
+			uc := NewGetUsersInfoUseCase(m, newStaticRoleCatalog(vo.SystemRoleCatalog()), l)
 
 			result, err := uc.Execute(ctx, pagination, tt.roles)
 
@@ -80,3 +82,59 @@ func TestGetUsersInfoUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUsersInfoUseCase_ExposesLockout(t *testing.T) {
+	ctx := context.Background()
+	pagination := common.Pagination{Page: 1, PageSize: 10}
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	lockedUntil := time.Now().Add(time.Hour)
+	locked, _ := entity.RestoreUser(uuid.New(), "locked@example.com", "locked", password.String(), []string{"EMPLOYEE"}, true, 5, &lockedUntil, true, 0, nil, vo.SystemRoleCatalog())
+	unlocked, _ := entity.RestoreUser(uuid.New(), "ok@example.com", "ok", password.String(), []string{"EMPLOYEE"}, true, 2, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	m := new(MockUserRepository)
+	m.On("GetUsersInfo", ctx, vo.AllRoles(), pagination).Return(&common.PaginatedResult[*entity.User]{Items: []*entity.User{locked, unlocked}, TotalCount: 2}, nil)
+
+	uc := NewGetUsersInfoUseCase(m, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+	result, err := uc.Execute(ctx, pagination, nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Items, 2)
+	assert.True(t, result.Items[0].Locked)
+	assert.Equal(t, 5, result.Items[0].FailedAttempts)
+	assert.Equal(t, &lockedUntil, result.Items[0].LockedUntil)
+	assert.False(t, result.Items[1].Locked)
+	assert.Equal(t, 2, result.Items[1].FailedAttempts)
+	assert.Nil(t, result.Items[1].LockedUntil)
+}
+
+func TestExportUsersInfoUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	first, _ := entity.RestoreUser(uuid.New(), "ana@example.com", "ana", password.String(), []string{"EMPLOYEE"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	second, _ := entity.RestoreUser(uuid.New(), "bia@example.com", "bia", password.String(), []string{"EMPLOYEE"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	t.Run("Walks Every Page With The Same Filters", func(t *testing.T) {
+		m := new(MockUserRepository)
+		m.On("GetUsersInfo", ctx, []vo.Role{vo.EmployeeRole}, common.NewPagination(1, exportPageSize, "a", "name", "ASC")).
+			Return(&common.PaginatedResult[*entity.User]{Items: []*entity.User{first}, TotalCount: exportPageSize + 1}, nil)
+		m.On("GetUsersInfo", ctx, []vo.Role{vo.EmployeeRole}, common.NewPagination(2, exportPageSize, "a", "name", "ASC")).
+			Return(&common.PaginatedResult[*entity.User]{Items: []*entity.User{second}, TotalCount: exportPageSize + 1}, nil)
+
+		uc := NewExportUsersInfoUseCase(m, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+		users, err := uc.Execute(ctx, "a", "name", "ASC", []string{"EMPLOYEE"})
+
+		assert.NoError(t, err)
+		assert.Len(t, users, 2)
+		assert.Equal(t, "ana", users[0].Username)
+		assert.Equal(t, "bia", users[1].Username)
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Role", func(t *testing.T) {
+		uc := NewExportUsersInfoUseCase(new(MockUserRepository), newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+		users, err := uc.Execute(ctx, "", "", "", []string{"INVALID"})
+
+		assert.ErrorIs(t, err, vo.ErrInvalidRole)
+		assert.Nil(t, users)
+	})
+}
@@ -0,0 +1,220 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type startImpersonationUseCase struct {
+	userRepo       ports.UserRepository
+	memberships    ports.StoreMembershipRepository
+	roles          ports.RoleCatalogProvider
+	impersonations ports.ImpersonationRepository
+	tokenManager   security.TokenManager
+	audit          ports.AuditEventRepository
+	logger         ports.Logger
+	expiresIn      time.Duration
+}
+
+func NewStartImpersonationUseCase(
+	userRepo ports.UserRepository,
+	memberships ports.StoreMembershipRepository,
+	roles ports.RoleCatalogProvider,
+	impersonations ports.ImpersonationRepository,
+	tokenManager security.TokenManager,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	expiresIn time.Duration,
+) admin.StartImpersonationUseCase {
+	return &startImpersonationUseCase{
+		userRepo:       userRepo,
+		memberships:    memberships,
+		roles:          roles,
+		impersonations: impersonations,
+		tokenManager:   tokenManager,
+		audit:          audit,
+		logger:         logger,
+		expiresIn:      expiresIn,
+	}
+}
+
+// Execute issues an access token acting as the user, optionally inside one of
+// their stores. Users who may impersonate others cannot be impersonated, and
+// the actor must hold every permission the user would act with, so an
+// impersonation never reaches further than the actor already does.
+func (u *startImpersonationUseCase) Execute(ctx context.Context, actorID uuid.UUID, id string, input dto.StartImpersonationInput) (*dto.ImpersonationResult, error) {
+	u.logger.Debug("starting impersonation", "actorID", actorID, "userID", id, "storeID", input.StoreID)
+
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		u.logger.Error("failed to parse user ID", err, "id", id)
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		u.logger.Error("failed to find user", err, "userID", userID)
+		return nil, err
+	}
+
+	if user == nil || user.IsDeleted() {
+		u.logger.Info("user not found for impersonation", "userID", userID)
+		return nil, entity.ErrUserNotFound
+	}
+
+	if !user.IsActive() {
+		u.logger.Info("impersonation refused: user is inactive", "userID", userID, "actorID", actorID)
+		return nil, entity.ErrImpersonationNotAllowed
+	}
+
+	roles := user.Roles()
+
+	var storeID *uuid.UUID
+	if input.StoreID != "" {
+		parsed, err := parseStoreID(input.StoreID)
+		if err != nil {
+			u.logger.Info("invalid store ID provided", "storeID", input.StoreID)
+			return nil, err
+		}
+
+		membership, err := u.memberships.Find(ctx, userID, parsed)
+		if err != nil {
+			u.logger.Error("failed to find store membership", err, "userID", userID, "storeID", parsed)
+			return nil, err
+		}
+
+		if membership == nil || !membership.IsActive() {
+			u.logger.Info("impersonation refused: user is not an active member", "userID", userID, "storeID", parsed)
+			return nil, entity.ErrNotStoreMember
+		}
+
+		roles = append(append([]vo.Role{}, roles...), membership.Roles()...)
+		storeID = &parsed
+	}
+
+	if err := u.checkReach(ctx, actorID, userID, roles); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	impersonation, err := entity.NewImpersonation(actorID, userID, storeID, input.Reason, u.expiresIn, now)
+	if err != nil {
+		u.logger.Info("invalid impersonation request", "error", err, "actorID", actorID, "userID", userID)
+		return nil, err
+	}
+
+	accessToken, err := u.tokenManager.GenerateImpersonationToken(ctx, user, impersonation)
+	if err != nil {
+		u.logger.Error("failed to generate impersonation token", err, "userID", userID)
+		return nil, err
+	}
+
+	if err := u.impersonations.Save(ctx, impersonation); err != nil {
+		u.logger.Error("failed to save impersonation", err, "userID", userID)
+		return nil, err
+	}
+
+	event := actorAuditEvent(ctx, vo.AuditImpersonationStart, actorID, userID).
+		WithChange("impersonation_id", nil, impersonation.ID()).
+		WithChange("reason", nil, impersonation.Reason()).
+		WithChange("expires_at", nil, impersonation.ExpiresAt())
+	if storeID != nil {
+		event.WithChange("store_id", nil, *storeID)
+	}
+	auditlog.Record(ctx, u.audit, u.logger, event)
+
+	u.logger.Info("impersonation started", "actorID", actorID, "userID", userID, "impersonationID", impersonation.ID())
+	return &dto.ImpersonationResult{
+		ImpersonationID: impersonation.ID(),
+		UserID:          userID,
+		AccessToken:     accessToken,
+		ExpiresAt:       impersonation.ExpiresAt(),
+	}, nil
+}
+
+func (u *startImpersonationUseCase) checkReach(ctx context.Context, actorID, userID uuid.UUID, roles []vo.Role) error {
+	catalog, err := u.roles.Catalog(ctx)
+	if err != nil {
+		u.logger.Error("failed to load role catalog", err)
+		return err
+	}
+
+	target := catalog.GrantsOf(roles...)
+	if target.Has(vo.PermUsersImpersonate) {
+		u.logger.Info("security event: impersonation of an impersonator refused", "actorID", actorID, "userID", userID)
+		return entity.ErrImpersonationNotAllowed
+	}
+
+	grants, err := actorGrants(ctx, u.userRepo, catalog, u.logger, actorID)
+	if err != nil {
+		return err
+	}
+
+	if !grants.Covers(target) {
+		u.logger.Info("security event: impersonation beyond actor permissions refused", "actorID", actorID, "userID", userID)
+		return entity.ErrPrivilegeEscalation
+	}
+
+	return nil
+}
+
+type endImpersonationUseCase struct {
+	impersonations ports.ImpersonationRepository
+	audit          ports.AuditEventRepository
+	logger         ports.Logger
+}
+
+func NewEndImpersonationUseCase(impersonations ports.ImpersonationRepository, audit ports.AuditEventRepository, logger ports.Logger) admin.EndImpersonationUseCase {
+	return &endImpersonationUseCase{
+		impersonations: impersonations,
+		audit:          audit,
+		logger:         logger,
+	}
+}
+
+// Execute ends an impersonation before it expires. Its token is rejected from
+// the next request on, as the auth middleware no longer finds it.
+func (u *endImpersonationUseCase) Execute(ctx context.Context, actorID uuid.UUID, id string) error {
+	u.logger.Debug("ending impersonation", "actorID", actorID, "impersonationID", id)
+
+	impersonationID, err := uuid.Parse(id)
+	if err != nil {
+		u.logger.Info("invalid impersonation ID provided", "id", id)
+		return entity.ErrImpersonationNotFound
+	}
+
+	impersonation, err := u.impersonations.Find(ctx, impersonationID)
+	if err != nil {
+		if errors.Is(err, entity.ErrImpersonationNotFound) {
+			u.logger.Info("impersonation not found or already ended", "impersonationID", impersonationID)
+			return err
+		}
+
+		u.logger.Error("failed to find impersonation", err, "impersonationID", impersonationID)
+		return err
+	}
+
+	// Delete fails with ErrImpersonationNotFound when a concurrent call ended
+	// it first; that call records the end.
+	if err := u.impersonations.Delete(ctx, impersonationID); err != nil {
+		u.logger.Info("failed to end impersonation", "impersonationID", impersonationID, "error", err)
+		return err
+	}
+
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditImpersonationEnd, actorID, impersonation.UserID()).
+		WithChange("impersonation_id", impersonation.ID(), nil).
+		WithChange("impersonator_id", impersonation.ImpersonatorID(), nil))
+
+	u.logger.Info("impersonation ended", "actorID", actorID, "impersonationID", impersonationID)
+	return nil
+}
@@ -0,0 +1,228 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartImpersonationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	actorID := uuid.New()
+	userID := uuid.New()
+	storeID := uuid.New()
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+
+	newUser := func(id uuid.UUID, role vo.Role, active bool) *entity.User {
+		var roles []string
+		if role != "" {
+			roles = []string{role.String()}
+		}
+		user, _ := entity.RestoreUser(id, id.String()+"@example.com", "user-"+id.String()[:8], password.String(), roles, active, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+	membership := func(role vo.Role) *entity.StoreMembership {
+		m, _ := entity.NewStoreMembership(userID, storeID, []vo.Role{role}, time.Now())
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		input   dto.StartImpersonationInput
+		setup   func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager)
+		wantErr error
+	}{
+		{
+			name:  "Success",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "ticket 42"},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				user := newUser(userID, vo.EmployeeRole, true)
+				ur.On("FindByID", ctx, userID).Return(user, nil)
+				ur.On("FindByID", ctx, actorID).Return(newUser(actorID, vo.AdminRole, true), nil)
+				tm.On("GenerateImpersonationToken", ctx, user, mock.MatchedBy(func(i *entity.Impersonation) bool {
+					return i.ImpersonatorID() == actorID && i.UserID() == userID && i.StoreID() == nil && i.Reason() == "ticket 42"
+				})).Return("impersonation-token", nil)
+				ir.On("Save", ctx, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:  "Success Inside Store",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "cashier cannot close order", StoreID: storeID.String()},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				user := newUser(userID, "", true)
+				ur.On("FindByID", ctx, userID).Return(user, nil)
+				ur.On("FindByID", ctx, actorID).Return(newUser(actorID, vo.AdminRole, true), nil)
+				mr.On("Find", ctx, userID, storeID).Return(membership(vo.EmployeeRole), nil)
+				tm.On("GenerateImpersonationToken", ctx, user, mock.MatchedBy(func(i *entity.Impersonation) bool {
+					return i.StoreID() != nil && *i.StoreID() == storeID
+				})).Return("impersonation-token", nil)
+				ir.On("Save", ctx, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:  "User Not Found",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "ticket 42"},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				ur.On("FindByID", ctx, userID).Return(nil, nil)
+			},
+			wantErr: entity.ErrUserNotFound,
+		},
+		{
+			name:  "Inactive User",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "ticket 42"},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				ur.On("FindByID", ctx, userID).Return(newUser(userID, vo.EmployeeRole, false), nil)
+			},
+			wantErr: entity.ErrImpersonationNotAllowed,
+		},
+		{
+			name:  "Admins Cannot Be Impersonated",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "ticket 42"},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				ur.On("FindByID", ctx, userID).Return(newUser(userID, vo.AdminRole, true), nil)
+			},
+			wantErr: entity.ErrImpersonationNotAllowed,
+		},
+		{
+			name:  "Not A Member Of The Store",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "ticket 42", StoreID: storeID.String()},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				ur.On("FindByID", ctx, userID).Return(newUser(userID, "", true), nil)
+				mr.On("Find", ctx, userID, storeID).Return(nil, nil)
+			},
+			wantErr: entity.ErrNotStoreMember,
+		},
+		{
+			name:  "Beyond Actor Permissions",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "ticket 42", StoreID: storeID.String()},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				ur.On("FindByID", ctx, userID).Return(newUser(userID, "", true), nil)
+				ur.On("FindByID", ctx, actorID).Return(newUser(actorID, vo.EmployeeRole, true), nil)
+				mr.On("Find", ctx, userID, storeID).Return(membership(vo.ManagerRole), nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:  "Reason Required",
+			id:    userID.String(),
+			input: dto.StartImpersonationInput{Reason: "   "},
+			setup: func(ur *MockUserRepository, mr *MockStoreMembershipRepository, ir *MockImpersonationRepository, tm *MockTokenManager) {
+				ur.On("FindByID", ctx, userID).Return(newUser(userID, vo.EmployeeRole, true), nil)
+				ur.On("FindByID", ctx, actorID).Return(newUser(actorID, vo.AdminRole, true), nil)
+			},
+			wantErr: entity.ErrImpersonationReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			mr := new(MockStoreMembershipRepository)
+			ir := new(MockImpersonationRepository)
+			tm := new(MockTokenManager)
+			audit := newMockAuditLog()
+			tt.setup(ur, mr, ir, tm)
+
+			uc := NewStartImpersonationUseCase(ur, mr, newStaticRoleCatalog(vo.SystemRoleCatalog()), ir, tm, audit, new(MockLogger), 15*time.Minute)
+			result, err := uc.Execute(ctx, actorID, tt.id, tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				ir.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				assert.Empty(t, audit.recordedActions())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "impersonation-token", result.AccessToken)
+			assert.Equal(t, userID, result.UserID)
+			assert.WithinDuration(t, time.Now().Add(15*time.Minute), result.ExpiresAt, time.Minute)
+			assert.Equal(t, []vo.AuditAction{vo.AuditImpersonationStart}, audit.recordedActions())
+			ur.AssertExpectations(t)
+			ir.AssertExpectations(t)
+			tm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEndImpersonationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	actorID := uuid.New()
+	impersonation, _ := entity.NewImpersonation(actorID, uuid.New(), nil, "ticket 42", time.Minute, time.Now())
+
+	tests := []struct {
+		name    string
+		id      string
+		setup   func(ir *MockImpersonationRepository)
+		wantErr error
+	}{
+		{
+			name: "Success",
+			id:   impersonation.ID().String(),
+			setup: func(ir *MockImpersonationRepository) {
+				ir.On("Find", ctx, impersonation.ID()).Return(impersonation, nil)
+				ir.On("Delete", ctx, impersonation.ID()).Return(nil)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   impersonation.ID().String(),
+			setup: func(ir *MockImpersonationRepository) {
+				ir.On("Find", ctx, impersonation.ID()).Return(nil, entity.ErrImpersonationNotFound)
+			},
+			wantErr: entity.ErrImpersonationNotFound,
+		},
+		{
+			name: "Ended Concurrently",
+			id:   impersonation.ID().String(),
+			setup: func(ir *MockImpersonationRepository) {
+				ir.On("Find", ctx, impersonation.ID()).Return(impersonation, nil)
+				ir.On("Delete", ctx, impersonation.ID()).Return(entity.ErrImpersonationNotFound)
+			},
+			wantErr: entity.ErrImpersonationNotFound,
+		},
+		{
+			name:    "Invalid ID",
+			id:      "invalid-uuid",
+			setup:   func(ir *MockImpersonationRepository) {},
+			wantErr: entity.ErrImpersonationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ir := new(MockImpersonationRepository)
+			audit := newMockAuditLog()
+			tt.setup(ir)
+
+			uc := NewEndImpersonationUseCase(ir, audit, new(MockLogger))
+			err := uc.Execute(ctx, actorID, tt.id)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, audit.recordedActions())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, []vo.AuditAction{vo.AuditImpersonationEnd}, audit.recordedActions())
+			ir.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+// maxImportRows keeps an import small enough to be validated and committed
+// within a single request.
+const maxImportRows = 1000
+
+// importRowErrors make a single row invalid. Any other error aborts the whole
+// import.
+var importRowErrors = []error{
+	vo.ErrEmptyEmail,
+	vo.ErrInvalidEmail,
+	vo.ErrInvalidRole,
+	vo.ErrEmptyRole,
+	entity.ErrEmptyUsername,
+	entity.ErrRoleNotFound,
+	entity.ErrRoleNotInStore,
+	entity.ErrCustomRoleNotGlobal,
+	entity.ErrMembershipStoreRequired,
+	entity.ErrMembershipRolesRequired,
+	entity.ErrPrivilegeEscalation,
+}
+
+// importedUser is a valid row, ready to be saved.
+type importedUser struct {
+	user       *entity.User
+	membership *entity.StoreMembership
+}
+
+type importUsersUseCase struct {
+	storeMembers
+	txManager           ports.TransactionManager
+	magicLinks          ports.MagicLinkRepository
+	linkTokens          security.VerificationTokenManager
+	notificationService ports.NotificationService
+	audit               ports.AuditEventRepository
+	linkExpiresIn       time.Duration
+}
+
+func NewImportUsersUseCase(
+	userRepo ports.UserRepository,
+	memberships ports.StoreMembershipRepository,
+	roleRepo ports.RoleRepository,
+	roles ports.RoleCatalogProvider,
+	txManager ports.TransactionManager,
+	magicLinks ports.MagicLinkRepository,
+	linkTokens security.VerificationTokenManager,
+	notificationService ports.NotificationService,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	linkExpiresIn time.Duration,
+) admin.ImportUsersUseCase {
+	return &importUsersUseCase{
+		storeMembers: storeMembers{
+			memberships: memberships,
+			userRepo:    userRepo,
+			roleRepo:    roleRepo,
+			roles:       roles,
+			logger:      logger,
+		},
+		txManager:           txManager,
+		magicLinks:          magicLinks,
+		linkTokens:          linkTokens,
+		notificationService: notificationService,
+		audit:               audit,
+		linkExpiresIn:       linkExpiresIn,
+	}
+}
+
+// Execute validates every row with the checks of inviting a member or
+// changing roles, and creates either all the users or none of them. The new
+// accounts have no password: each user is emailed a login link, valid for
+// linkExpiresIn, and sets one through the change password route, which asks
+// for no old password while none is set.
+func (u *importUsersUseCase) Execute(ctx context.Context, actorID uuid.UUID, rows []dto.UserImportRow, dryRun bool) (*dto.UserImportReport, error) {
+	u.logger.Debug("starting user import", "rows", len(rows), "dryRun", dryRun, "actorID", actorID)
+
+	if len(rows) == 0 {
+		u.logger.Info("empty user import refused", "actorID", actorID)
+		return nil, entity.ErrUserImportEmpty
+	}
+
+	if len(rows) > maxImportRows {
+		u.logger.Info("user import too large", "rows", len(rows), "actorID", actorID)
+		return nil, entity.ErrUserImportTooLarge
+	}
+
+	catalog, err := u.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.UserImportReport{DryRun: dryRun, Total: len(rows), Rows: make([]dto.UserImportRowResult, 0, len(rows))}
+	imported := make([]importedUser, 0, len(rows))
+	emails := make(map[vo.Email]bool, len(rows))
+	usernames := make(map[string]bool, len(rows))
+	now := time.Now()
+
+	for _, row := range rows {
+		result := dto.UserImportRowResult{Line: row.Line, Email: row.Email, Username: row.Username}
+
+		valid, problems, err := u.validate(ctx, catalog, actorID, row, now)
+		if err != nil {
+			return nil, err
+		}
+
+		// a repeated row is reported even when the first one is invalid, so
+		// fixing that one does not reveal the duplicate on the next attempt
+		if email, err := vo.NewEmail(row.Email); err == nil {
+			if emails[email] {
+				problems = append(problems, entity.ErrDuplicateImportEmail)
+			}
+			emails[email] = true
+		}
+
+		if username := strings.TrimSpace(row.Username); username != "" {
+			if usernames[username] {
+				problems = append(problems, entity.ErrDuplicateImportUsername)
+			}
+			usernames[username] = true
+		}
+
+		for _, problem := range problems {
+			result.Errors = append(result.Errors, problem.Error())
+		}
+
+		if len(problems) > 0 {
+			report.Invalid++
+		} else {
+			imported = append(imported, *valid)
+		}
+
+		report.Rows = append(report.Rows, result)
+	}
+
+	if dryRun || report.Invalid > 0 {
+		u.logger.Info("user import validated", "rows", report.Total, "invalid", report.Invalid, "dryRun", dryRun, "actorID", actorID)
+		return report, nil
+	}
+
+	err = u.txManager.Execute(ctx, func(txCtx context.Context) error {
+		for _, entry := range imported {
+			if err := u.userRepo.Save(txCtx, entry.user); err != nil {
+				u.logger.Error("failed to save imported user", err, "email", entry.user.Email())
+				return err
+			}
+
+			if entry.membership == nil {
+				continue
+			}
+
+			if err := u.memberships.Save(txCtx, entry.membership); err != nil {
+				u.logger.Error("failed to save imported store membership", err, "userID", entry.user.ID(), "storeID", entry.membership.StoreID())
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.Imported = true
+	for i, entry := range imported {
+		userID := entry.user.ID()
+		report.Rows[i].UserID = &userID
+
+		auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditAccountImported, actorID, userID))
+		u.sendWelcome(ctx, entry.user)
+	}
+
+	u.logger.Info("users imported successfully", "count", len(imported), "actorID", actorID)
+	return report, nil
+}
+
+// validate returns the user a row describes or the reasons it cannot be
+// imported. Rows with a store get their roles there, under the same checks
+// as adding a member; rows without one get them globally, under the checks of
+// changing a user's roles.
+func (u *importUsersUseCase) validate(ctx context.Context, catalog vo.RoleCatalog, actorID uuid.UUID, row dto.UserImportRow, now time.Time) (*importedUser, []error, error) {
+	var problems []error
+
+	report := func(err error) error {
+		for _, target := range importRowErrors {
+			if errors.Is(err, target) {
+				problems = append(problems, err)
+				return nil
+			}
+		}
+
+		return err
+	}
+
+	email, err := vo.NewEmail(row.Email)
+	if err != nil {
+		if err := report(err); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		existing, err := u.userRepo.FindByEmail(ctx, email)
+		if err != nil {
+			u.logger.Error("failed to find user by email", err, "line", row.Line)
+			return nil, nil, err
+		}
+
+		if existing != nil {
+			problems = append(problems, entity.ErrEmailAlreadyRegistered)
+		}
+	}
+
+	username := strings.TrimSpace(row.Username)
+	if username == "" {
+		problems = append(problems, entity.ErrEmptyUsername)
+	} else {
+		existing, err := u.userRepo.FindByUsername(ctx, username)
+		if err != nil {
+			u.logger.Error("failed to find user by username", err, "line", row.Line)
+			return nil, nil, err
+		}
+
+		if existing != nil {
+			problems = append(problems, entity.ErrUsernameTaken)
+		}
+	}
+
+	globalRoles := []vo.Role{}
+	var membershipRoles []vo.Role
+	var storeID uuid.UUID
+
+	if strings.TrimSpace(row.StoreID) == "" {
+		globalRoles, err = u.globalRoles(ctx, catalog, actorID, row.Roles)
+	} else {
+		storeID, membershipRoles, err = u.storeRoles(ctx, catalog, actorID, row.StoreID, row.Roles)
+	}
+	if err != nil {
+		if err := report(err); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, problems, nil
+	}
+
+	user, err := entity.NewUser(email, username, vo.UnusablePassword, globalRoles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry := &importedUser{user: user}
+	if membershipRoles != nil {
+		entry.membership, err = entity.NewStoreMembership(user.ID(), storeID, membershipRoles, now)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return entry, nil, nil
+}
+
+func (u *importUsersUseCase) storeRoles(ctx context.Context, catalog vo.RoleCatalog, actorID uuid.UUID, value string, values []string) (uuid.UUID, []vo.Role, error) {
+	storeID, err := parseStoreID(value)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	roles, err := u.resolveRoles(ctx, catalog, storeID, values)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	if len(roles) == 0 {
+		return uuid.Nil, nil, entity.ErrMembershipRolesRequired
+	}
+
+	if err := u.checkEscalation(ctx, catalog, actorID, storeID, nil, roles); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return storeID, roles, nil
+}
+
+// globalRoles asks for the permission to change roles on top of holding
+// every permission granted, as a user created with global roles could
+// otherwise be used to get around it. Custom roles are refused, as they are
+// only granted through a store membership.
+func (u *importUsersUseCase) globalRoles(ctx context.Context, catalog vo.RoleCatalog, actorID uuid.UUID, values []string) ([]vo.Role, error) {
+	roles := make([]vo.Role, 0, len(values))
+	for _, value := range values {
+		role, err := catalog.Resolve(value)
+		if err != nil {
+			u.logger.Info("invalid role provided", "role", value)
+			return nil, err
+		}
+
+		if !vo.IsSystemRole(role) {
+			u.logger.Info("custom role refused as a global role", "role", role)
+			return nil, entity.ErrCustomRoleNotGlobal
+		}
+
+		roles = append(roles, role)
+	}
+
+	if len(roles) == 0 {
+		return roles, nil
+	}
+
+	grants, err := actorGrants(ctx, u.userRepo, catalog, u.logger, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !grants.Has(vo.PermUsersRolesWrite) || !grants.Covers(catalog.GrantsOf(roles...)) {
+		u.logger.Info("security event: imported roles beyond actor permissions refused", "actorID", actorID, "roles", roles)
+		return nil, entity.ErrPrivilegeEscalation
+	}
+
+	return roles, nil
+}
+
+// sendWelcome emails the login link of a new user. The account is already
+// saved, so a failure is only logged: the user can ask for another link from
+// the login page, or reset their password through the forgot password flow.
+func (u *importUsersUseCase) sendWelcome(ctx context.Context, user *entity.User) {
+	linkID := uuid.New()
+
+	token, err := u.linkTokens.GenerateMagicLinkToken(user, linkID)
+	if err != nil {
+		u.logger.Error("failed to generate login link", err, "userID", user.ID())
+		return
+	}
+
+	if err := u.magicLinks.Save(ctx, linkID, user.ID(), u.linkExpiresIn); err != nil {
+		u.logger.Error("failed to save login link", err, "userID", user.ID())
+		return
+	}
+
+	if err := u.notificationService.SendAccountCreatedEmail(ctx, user.Email(), user.Username(), token); err != nil {
+		u.logger.Error("failed to send account created email", err, "userID", user.ID())
+	}
+}
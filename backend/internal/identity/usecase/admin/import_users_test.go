@@ -0,0 +1,272 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type importMocks struct {
+	users         *MockUserRepository
+	memberships   *MockStoreMembershipRepository
+	tx            *MockTransactionManager
+	links         *MockMagicLinkRepository
+	tokens        *MockVerificationTokenManager
+	notifications *MockNotificationService
+	audit         *MockAuditEventRepository
+}
+
+func newImportUsersUseCase(m importMocks) *importUsersUseCase {
+	return NewImportUsersUseCase(
+		m.users,
+		m.memberships,
+		new(MockRoleRepository),
+		newStaticRoleCatalog(vo.SystemRoleCatalog().With("CASHIER", []vo.Permission{vo.PermOrdersRead})),
+		m.tx,
+		m.links,
+		m.tokens,
+		m.notifications,
+		m.audit,
+		new(MockLogger),
+		7*24*time.Hour,
+	).(*importUsersUseCase)
+}
+
+func TestImportUsersUseCase_Validation(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	managerID := uuid.New()
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+	registered := setupRoleActor(uuid.New(), vo.EmployeeRole)
+
+	tests := []struct {
+		name       string
+		rows       []dto.UserImportRow
+		setup      func(u *MockUserRepository)
+		wantErrors map[int][]error
+		wantErr    error
+	}{
+		{
+			name: "Valid Store Rows",
+			rows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{"employee"}, StoreID: storeID.String()},
+				{Line: 3, Email: "bia@test.com", Username: "bia", Roles: []string{"EMPLOYEE"}, StoreID: storeID.String()},
+			},
+			wantErrors: map[int][]error{},
+		},
+		{
+			name: "Invalid Values",
+			rows: []dto.UserImportRow{
+				{Line: 2, Email: "not-an-email", Username: "ana", Roles: []string{"EMPLOYEE"}, StoreID: storeID.String()},
+				{Line: 3, Email: "bia@test.com", Username: " ", Roles: []string{"CHEF"}, StoreID: storeID.String()},
+				{Line: 4, Email: "caio@test.com", Username: "caio", Roles: []string{}, StoreID: storeID.String()},
+				{Line: 5, Email: "davi@test.com", Username: "davi", Roles: []string{"EMPLOYEE"}, StoreID: "not-a-store"},
+			},
+			wantErrors: map[int][]error{
+				2: {vo.ErrInvalidEmail},
+				3: {entity.ErrEmptyUsername, vo.ErrInvalidRole},
+				4: {entity.ErrMembershipRolesRequired},
+				5: {entity.ErrMembershipStoreRequired},
+			},
+		},
+		{
+			name: "Duplicates In File And Registered Users",
+			rows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{"EMPLOYEE"}, StoreID: storeID.String()},
+				{Line: 3, Email: "ana@test.com", Username: "ana", Roles: []string{"EMPLOYEE"}, StoreID: storeID.String()},
+				{Line: 4, Email: "taken@test.com", Username: "taken", Roles: []string{"EMPLOYEE"}, StoreID: storeID.String()},
+			},
+			setup: func(u *MockUserRepository) {
+				taken, _ := vo.NewEmail("taken@test.com")
+				u.On("FindByEmail", ctx, taken).Return(registered, nil)
+				u.On("FindByUsername", ctx, "taken").Return(registered, nil)
+			},
+			wantErrors: map[int][]error{
+				3: {entity.ErrDuplicateImportEmail, entity.ErrDuplicateImportUsername},
+				4: {entity.ErrEmailAlreadyRegistered, entity.ErrUsernameTaken},
+			},
+		},
+		{
+			name: "Escalation - Admin Role In Store",
+			rows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{"ADMIN"}, StoreID: storeID.String()},
+			},
+			wantErrors: map[int][]error{2: {entity.ErrPrivilegeEscalation}},
+		},
+		{
+			name: "Escalation - Global Roles Without Role Permission",
+			rows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{"EMPLOYEE"}},
+			},
+			wantErrors: map[int][]error{2: {entity.ErrPrivilegeEscalation}},
+		},
+		{
+			name: "Custom Role Without Store",
+			rows: []dto.UserImportRow{
+				{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{"CASHIER"}},
+			},
+			wantErrors: map[int][]error{2: {entity.ErrCustomRoleNotGlobal}},
+		},
+		{
+			name:    "Empty Import",
+			rows:    []dto.UserImportRow{},
+			wantErr: entity.ErrUserImportEmpty,
+		},
+		{
+			name:    "Too Many Rows",
+			rows:    make([]dto.UserImportRow, maxImportRows+1),
+			wantErr: entity.ErrUserImportTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := importMocks{
+				users:         new(MockUserRepository),
+				memberships:   new(MockStoreMembershipRepository),
+				tx:            new(MockTransactionManager),
+				links:         new(MockMagicLinkRepository),
+				tokens:        new(MockVerificationTokenManager),
+				notifications: new(MockNotificationService),
+				audit:         newMockAuditLog(),
+			}
+			if tt.setup != nil {
+				tt.setup(m.users)
+			}
+			m.users.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+			m.users.On("FindByEmail", ctx, mock.Anything).Return(nil, nil)
+			m.users.On("FindByUsername", ctx, mock.Anything).Return(nil, nil)
+			m.memberships.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+
+			report, err := newImportUsersUseCase(m).Execute(ctx, managerID, tt.rows, true)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, report)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, report.DryRun)
+			assert.False(t, report.Imported)
+			assert.Equal(t, len(tt.rows), report.Total)
+			assert.Equal(t, len(tt.wantErrors), report.Invalid)
+			for _, row := range report.Rows {
+				var want []string
+				for _, err := range tt.wantErrors[row.Line] {
+					want = append(want, err.Error())
+				}
+				assert.Equal(t, want, row.Errors, "line %d", row.Line)
+				assert.Nil(t, row.UserID)
+			}
+			m.users.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+			m.tx.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestImportUsersUseCase_Commit(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	adminID := uuid.New()
+	rows := []dto.UserImportRow{
+		{Line: 2, Email: "ana@test.com", Username: "ana", Roles: []string{"EMPLOYEE"}, StoreID: storeID.String()},
+		{Line: 3, Email: "bia@test.com", Username: "bia", Roles: []string{"MANAGER"}},
+	}
+
+	setup := func() importMocks {
+		m := importMocks{
+			users:         new(MockUserRepository),
+			memberships:   new(MockStoreMembershipRepository),
+			tx:            new(MockTransactionManager),
+			links:         new(MockMagicLinkRepository),
+			tokens:        new(MockVerificationTokenManager),
+			notifications: new(MockNotificationService),
+			audit:         newMockAuditLog(),
+		}
+		m.users.On("FindByID", ctx, adminID).Return(setupRoleActor(adminID, vo.AdminRole), nil)
+		m.users.On("FindByEmail", ctx, mock.Anything).Return(nil, nil)
+		m.users.On("FindByUsername", ctx, mock.Anything).Return(nil, nil)
+		m.memberships.On("Find", ctx, adminID, storeID).Return(nil, nil)
+		m.tx.On("Execute", ctx, mock.Anything).Return(nil)
+		return m
+	}
+
+	t.Run("Creates Users, Memberships And Sends Login Links", func(t *testing.T) {
+		m := setup()
+		m.users.On("Save", ctx, mock.MatchedBy(func(u *entity.User) bool {
+			return u.Username() == "ana" && len(u.Roles()) == 0 && u.Password() == vo.UnusablePassword
+		})).Return(nil).Once()
+		m.users.On("Save", ctx, mock.MatchedBy(func(u *entity.User) bool {
+			return u.Username() == "bia" && u.Roles()[0] == vo.ManagerRole
+		})).Return(nil).Once()
+		m.memberships.On("Save", ctx, mock.MatchedBy(func(ms *entity.StoreMembership) bool {
+			return ms.StoreID() == storeID && ms.Roles()[0] == vo.EmployeeRole
+		})).Return(nil).Once()
+		m.tokens.On("GenerateMagicLinkToken", mock.Anything, mock.Anything).Return("login-token", nil)
+		m.links.On("Save", ctx, mock.Anything, mock.Anything, 7*24*time.Hour).Return(nil)
+		m.notifications.On("SendAccountCreatedEmail", ctx, mock.Anything, mock.Anything, "login-token").Return(nil)
+
+		report, err := newImportUsersUseCase(m).Execute(ctx, adminID, rows, false)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Imported)
+		assert.Zero(t, report.Invalid)
+		for _, row := range report.Rows {
+			assert.NotNil(t, row.UserID)
+			assert.Empty(t, row.Errors)
+		}
+		assert.Equal(t, []vo.AuditAction{vo.AuditAccountImported, vo.AuditAccountImported}, m.audit.recordedActions())
+		m.users.AssertExpectations(t)
+		m.memberships.AssertExpectations(t)
+		m.notifications.AssertNumberOfCalls(t, "SendAccountCreatedEmail", 2)
+	})
+
+	t.Run("Email Failure Keeps The Import", func(t *testing.T) {
+		m := setup()
+		m.users.On("Save", ctx, mock.Anything).Return(nil)
+		m.memberships.On("Save", ctx, mock.Anything).Return(nil)
+		m.tokens.On("GenerateMagicLinkToken", mock.Anything, mock.Anything).Return("login-token", nil)
+		m.links.On("Save", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		m.notifications.On("SendAccountCreatedEmail", ctx, mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError)
+
+		report, err := newImportUsersUseCase(m).Execute(ctx, adminID, rows, false)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Imported)
+	})
+
+	t.Run("Save Failure Imports Nothing", func(t *testing.T) {
+		m := setup()
+		m.users.On("Save", ctx, mock.Anything).Return(nil).Once()
+		m.memberships.On("Save", ctx, mock.Anything).Return(assert.AnError)
+
+		report, err := newImportUsersUseCase(m).Execute(ctx, adminID, rows, false)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, report)
+		assert.Empty(t, m.audit.recordedActions())
+		m.notifications.AssertNotCalled(t, "SendAccountCreatedEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Invalid Row Imports Nothing", func(t *testing.T) {
+		m := setup()
+		invalid := append([]dto.UserImportRow{}, rows...)
+		invalid = append(invalid, dto.UserImportRow{Line: 4, Email: "bad", Username: "caio"})
+
+		report, err := newImportUsersUseCase(m).Execute(ctx, adminID, invalid, false)
+
+		assert.NoError(t, err)
+		assert.False(t, report.Imported)
+		assert.Equal(t, 1, report.Invalid)
+		m.tx.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		m.users.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
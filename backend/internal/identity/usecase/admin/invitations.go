@@ -0,0 +1,288 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type inviteUserUseCase struct {
+	storeMembers
+	invitations         ports.InvitationRepository
+	notificationService ports.NotificationService
+	audit               ports.AuditEventRepository
+	expiresIn           time.Duration
+}
+
+func NewInviteUserUseCase(
+	invitations ports.InvitationRepository,
+	memberships ports.StoreMembershipRepository,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	roles ports.RoleCatalogProvider,
+	notificationService ports.NotificationService,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	expiresIn time.Duration,
+) admin.InviteUserUseCase {
+	return &inviteUserUseCase{
+		storeMembers: storeMembers{
+			memberships: memberships,
+			userRepo:    userRepo,
+			roleRepo:    roleRepo,
+			roles:       roles,
+			logger:      logger,
+		},
+		invitations:         invitations,
+		notificationService: notificationService,
+		audit:               audit,
+		expiresIn:           expiresIn,
+	}
+}
+
+// Execute applies the same checks as adding a member directly: the invitee
+// will become a member of the store with exactly these roles.
+func (u *inviteUserUseCase) Execute(ctx context.Context, actorID uuid.UUID, storeID string, input dto.InviteUserInput) (*dto.InvitationInfo, error) {
+	u.logger.Debug("starting user invitation", "storeID", storeID, "email", input.Email, "actorID", actorID)
+
+	store, err := parseStoreID(storeID)
+	if err != nil {
+		u.logger.Info("invalid store ID provided", "storeID", storeID)
+		return nil, err
+	}
+
+	email, err := vo.NewEmail(input.Email)
+	if err != nil {
+		u.logger.Info("invalid email format in invitation", "email", input.Email)
+		return nil, err
+	}
+
+	catalog, err := u.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := u.resolveRoles(ctx, catalog, store, input.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.checkEscalation(ctx, catalog, actorID, store, nil, roles); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		u.logger.Error("failed to find user by email", err, "email", input.Email)
+		return nil, err
+	}
+
+	// registered users accept from their own account and only get the
+	// membership, so they are refused only when they already have one
+	if existing != nil {
+		membership, err := u.memberships.Find(ctx, existing.ID(), store)
+		if err != nil {
+			u.logger.Error("failed to find store membership", err, "userID", existing.ID(), "storeID", store)
+			return nil, err
+		}
+
+		if membership != nil {
+			u.logger.Info("invitation refused: user is already a store member", "userID", existing.ID(), "storeID", store)
+			return nil, entity.ErrMembershipAlreadyExists
+		}
+	}
+
+	token, err := vo.GenerateInviteToken()
+	if err != nil {
+		u.logger.Error("failed to generate invite token", err)
+		return nil, err
+	}
+
+	invitation, err := entity.NewInvitation(email, store, roles, token, actorID, u.expiresIn, time.Now())
+	if err != nil {
+		u.logger.Info("invalid invitation input", "error", err)
+		return nil, err
+	}
+
+	if err := u.invitations.Save(ctx, invitation); err != nil {
+		u.logger.Error("failed to save invitation", err, "email", input.Email, "storeID", store)
+		return nil, err
+	}
+
+	// the invitee has no account yet, so the event names the invitation
+	auditlog.Record(ctx, u.audit, u.logger, auditlog.Event(ctx, vo.AuditInvitationCreated, &actorID, nil).
+		WithChange("invitation_id", nil, invitation.ID()).
+		WithChange("email", nil, email).
+		WithChange("store_id", nil, store).
+		WithChange("roles", nil, roles))
+
+	// the token is never stored in plain text, so an invitation whose email
+	// was not sent cannot be recovered: the manager has to invite again
+	if err := u.notificationService.SendInvitationEmail(ctx, email, token.String()); err != nil {
+		u.logger.Error("failed to send invitation email", err, "invitationID", invitation.ID())
+		return nil, fmt.Errorf("sending invitation email: %w", err)
+	}
+
+	u.logger.Info("user invited successfully", "invitationID", invitation.ID(), "storeID", store, "roles", roles, "actorID", actorID)
+	info := toInvitationInfo(invitation)
+	return &info, nil
+}
+
+type listInvitationsUseCase struct {
+	invitations ports.InvitationRepository
+	memberships ports.StoreMembershipRepository
+	userRepo    ports.UserRepository
+	roles       ports.RoleCatalogProvider
+	logger      ports.Logger
+}
+
+func NewListInvitationsUseCase(
+	invitations ports.InvitationRepository,
+	memberships ports.StoreMembershipRepository,
+	userRepo ports.UserRepository,
+	roles ports.RoleCatalogProvider,
+	logger ports.Logger,
+) admin.ListInvitationsUseCase {
+	return &listInvitationsUseCase{
+		invitations: invitations,
+		memberships: memberships,
+		userRepo:    userRepo,
+		roles:       roles,
+		logger:      logger,
+	}
+}
+
+func (u *listInvitationsUseCase) Execute(ctx context.Context, actorID uuid.UUID, storeID string) ([]dto.InvitationInfo, error) {
+	u.logger.Debug("listing invitations", "storeID", storeID, "actorID", actorID)
+
+	store, err := parseStoreID(storeID)
+	if err != nil {
+		u.logger.Info("invalid store ID provided", "storeID", storeID)
+		return nil, err
+	}
+
+	if err := checkStorePermission(ctx, u.userRepo, u.memberships, u.roles, u.logger, actorID, store, vo.PermMembersManage); err != nil {
+		return nil, err
+	}
+
+	invitations, err := u.invitations.ListByStore(ctx, store)
+	if err != nil {
+		u.logger.Error("failed to list invitations", err, "storeID", store)
+		return nil, err
+	}
+
+	result := make([]dto.InvitationInfo, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, toInvitationInfo(invitation))
+	}
+
+	return result, nil
+}
+
+type revokeInvitationUseCase struct {
+	storeMembers
+	invitations ports.InvitationRepository
+	audit       ports.AuditEventRepository
+}
+
+func NewRevokeInvitationUseCase(
+	invitations ports.InvitationRepository,
+	memberships ports.StoreMembershipRepository,
+	userRepo ports.UserRepository,
+	roles ports.RoleCatalogProvider,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) admin.RevokeInvitationUseCase {
+	return &revokeInvitationUseCase{
+		storeMembers: storeMembers{
+			memberships: memberships,
+			userRepo:    userRepo,
+			roles:       roles,
+			logger:      logger,
+		},
+		invitations: invitations,
+		audit:       audit,
+	}
+}
+
+func (u *revokeInvitationUseCase) Execute(ctx context.Context, actorID uuid.UUID, storeID string, id string) error {
+	u.logger.Debug("starting invitation revocation", "storeID", storeID, "invitationID", id, "actorID", actorID)
+
+	store, err := parseStoreID(storeID)
+	if err != nil {
+		u.logger.Info("invalid store ID provided", "storeID", storeID)
+		return err
+	}
+
+	invitationID, err := uuid.Parse(id)
+	if err != nil {
+		u.logger.Info("invalid invitation ID provided", "invitationID", id)
+		return entity.ErrInvitationNotFound
+	}
+
+	invitation, err := u.invitations.FindByID(ctx, invitationID)
+	if err != nil {
+		u.logger.Error("failed to find invitation", err, "invitationID", invitationID)
+		return err
+	}
+
+	if invitation == nil || invitation.StoreID() != store {
+		u.logger.Info("invitation not found", "invitationID", invitationID, "storeID", store)
+		return entity.ErrInvitationNotFound
+	}
+
+	catalog, err := u.catalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := u.checkEscalation(ctx, catalog, actorID, store, invitation.Roles(), nil); err != nil {
+		return err
+	}
+
+	if err := invitation.Revoke(time.Now()); err != nil {
+		u.logger.Info("invitation is no longer pending", "invitationID", invitationID)
+		return err
+	}
+
+	if err := u.invitations.UpdateRevokedAt(ctx, invitation); err != nil {
+		u.logger.Error("failed to revoke invitation", err, "invitationID", invitationID)
+		return err
+	}
+
+	auditlog.Record(ctx, u.audit, u.logger, auditlog.Event(ctx, vo.AuditInvitationRevoked, &actorID, nil).
+		WithChange("invitation_id", invitationID, nil).
+		WithChange("email", invitation.Email(), nil).
+		WithChange("store_id", store, nil).
+		WithChange("roles", invitation.Roles(), nil))
+
+	u.logger.Info("invitation revoked successfully", "invitationID", invitationID, "actorID", actorID)
+	return nil
+}
+
+func toInvitationInfo(invitation *entity.Invitation) dto.InvitationInfo {
+	roles := make([]string, 0, len(invitation.Roles()))
+	for _, role := range invitation.Roles() {
+		roles = append(roles, role.String())
+	}
+
+	return dto.InvitationInfo{
+		ID:         invitation.ID(),
+		Email:      invitation.Email().String(),
+		StoreID:    invitation.StoreID(),
+		Roles:      roles,
+		InvitedBy:  invitation.InvitedBy(),
+		CreatedAt:  invitation.CreatedAt(),
+		ExpiresAt:  invitation.ExpiresAt(),
+		AcceptedAt: invitation.AcceptedAt(),
+		RevokedAt:  invitation.RevokedAt(),
+	}
+}
@@ -0,0 +1,237 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInviteUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	otherStore := uuid.New()
+	managerID := uuid.New()
+	email, _ := vo.NewEmail("staff@test.com")
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+	registered := setupRoleActor(uuid.New(), vo.EmployeeRole)
+	smtpErr := errors.New("smtp down")
+
+	tests := []struct {
+		name    string
+		storeID string
+		input   dto.InviteUserInput
+		setup   func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService)
+		wantErr error
+	}{
+		{
+			name:    "Success",
+			storeID: storeID.String(),
+			input:   dto.InviteUserInput{Email: "staff@test.com", Roles: []string{"employee"}},
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService) {
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				u.On("FindByEmail", ctx, email).Return(nil, nil)
+				i.On("Save", ctx, mock.MatchedBy(func(inv *entity.Invitation) bool {
+					return inv.StoreID() == storeID && inv.InvitedBy() == managerID && inv.Roles()[0] == vo.EmployeeRole
+				})).Return(nil)
+				n.On("SendInvitationEmail", ctx, email, mock.MatchedBy(func(token string) bool {
+					return len(token) == 64
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "Escalation - Manager Of Another Store",
+			storeID: otherStore.String(),
+			input:   dto.InviteUserInput{Email: "staff@test.com", Roles: []string{"EMPLOYEE"}},
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService) {
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, otherStore).Return(nil, nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Registered User Outside The Store",
+			storeID: storeID.String(),
+			input:   dto.InviteUserInput{Email: "staff@test.com", Roles: []string{"EMPLOYEE"}},
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService) {
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				u.On("FindByEmail", ctx, email).Return(registered, nil)
+				m.On("Find", ctx, registered.ID(), storeID).Return(nil, nil)
+				i.On("Save", ctx, mock.Anything).Return(nil)
+				n.On("SendInvitationEmail", ctx, email, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:    "Registered User Already A Member",
+			storeID: storeID.String(),
+			input:   dto.InviteUserInput{Email: "staff@test.com", Roles: []string{"EMPLOYEE"}},
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService) {
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				u.On("FindByEmail", ctx, email).Return(registered, nil)
+				member, _ := entity.NewStoreMembership(registered.ID(), storeID, []vo.Role{vo.EmployeeRole}, time.Now())
+				m.On("Find", ctx, registered.ID(), storeID).Return(member, nil)
+			},
+			wantErr: entity.ErrMembershipAlreadyExists,
+		},
+		{
+			name:    "Email Delivery Failure",
+			storeID: storeID.String(),
+			input:   dto.InviteUserInput{Email: "staff@test.com", Roles: []string{"EMPLOYEE"}},
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService) {
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				u.On("FindByEmail", ctx, email).Return(nil, nil)
+				i.On("Save", ctx, mock.Anything).Return(nil)
+				n.On("SendInvitationEmail", ctx, email, mock.Anything).Return(smtpErr)
+			},
+			wantErr: smtpErr,
+		},
+		{
+			name:    "Invalid Email",
+			storeID: storeID.String(),
+			input:   dto.InviteUserInput{Email: "not-an-email", Roles: []string{"EMPLOYEE"}},
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService) {
+			},
+			wantErr: vo.ErrInvalidEmail,
+		},
+		{
+			name:    "Invalid Store ID",
+			storeID: "not-a-uuid",
+			input:   dto.InviteUserInput{Email: "staff@test.com", Roles: []string{"EMPLOYEE"}},
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository, n *MockNotificationService) {
+			},
+			wantErr: entity.ErrMembershipStoreRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := new(MockInvitationRepository)
+			m := new(MockStoreMembershipRepository)
+			u := new(MockUserRepository)
+			n := new(MockNotificationService)
+			tt.setup(i, m, u, n)
+			audit := newMockAuditLog()
+
+			uc := NewInviteUserUseCase(i, m, u, new(MockRoleRepository), newStaticRoleCatalog(vo.SystemRoleCatalog()), n, audit, new(MockLogger), time.Hour)
+			info, err := uc.Execute(ctx, managerID, tt.storeID, tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, info)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "staff@test.com", info.Email)
+				assert.Equal(t, []string{"EMPLOYEE"}, info.Roles)
+				assert.Equal(t, []vo.AuditAction{vo.AuditInvitationCreated}, audit.recordedActions())
+			}
+
+			i.AssertExpectations(t)
+			m.AssertExpectations(t)
+			u.AssertExpectations(t)
+			n.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRevokeInvitationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	managerID := uuid.New()
+	email, _ := vo.NewEmail("staff@test.com")
+	token, _ := vo.GenerateInviteToken()
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+	newInvitation := func(store uuid.UUID, role vo.Role) *entity.Invitation {
+		invitation, _ := entity.NewInvitation(email, store, []vo.Role{role}, token, managerID, time.Hour, time.Now())
+		return invitation
+	}
+	pending := newInvitation(storeID, vo.EmployeeRole)
+	adminInvite := newInvitation(storeID, vo.AdminRole)
+	foreign := newInvitation(uuid.New(), vo.EmployeeRole)
+	revoked := newInvitation(storeID, vo.EmployeeRole)
+	_ = revoked.Revoke(time.Now())
+
+	tests := []struct {
+		name    string
+		id      uuid.UUID
+		setup   func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository)
+		wantErr error
+	}{
+		{
+			name: "Success",
+			id:   pending.ID(),
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository) {
+				i.On("FindByID", ctx, pending.ID()).Return(pending, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				i.On("UpdateRevokedAt", ctx, mock.MatchedBy(func(inv *entity.Invitation) bool {
+					return inv.RevokedAt() != nil
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Escalation - Invitation Beyond Actor",
+			id:   adminInvite.ID(),
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository) {
+				i.On("FindByID", ctx, adminInvite.ID()).Return(adminInvite, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name: "Invitation Of Another Store",
+			id:   foreign.ID(),
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository) {
+				i.On("FindByID", ctx, foreign.ID()).Return(foreign, nil)
+			},
+			wantErr: entity.ErrInvitationNotFound,
+		},
+		{
+			name: "Already Revoked",
+			id:   revoked.ID(),
+			setup: func(i *MockInvitationRepository, m *MockStoreMembershipRepository, u *MockUserRepository) {
+				i.On("FindByID", ctx, revoked.ID()).Return(revoked, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+			},
+			wantErr: entity.ErrInvitationNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := new(MockInvitationRepository)
+			m := new(MockStoreMembershipRepository)
+			u := new(MockUserRepository)
+			tt.setup(i, m, u)
+			audit := newMockAuditLog()
+
+			uc := NewRevokeInvitationUseCase(i, m, u, newStaticRoleCatalog(vo.SystemRoleCatalog()), audit, new(MockLogger))
+			err := uc.Execute(ctx, managerID, storeID.String(), tt.id.String())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				i.AssertNotCalled(t, "UpdateRevokedAt", mock.Anything, mock.Anything)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditInvitationRevoked}, audit.recordedActions())
+			}
+
+			i.AssertExpectations(t)
+			m.AssertExpectations(t)
+			u.AssertExpectations(t)
+		})
+	}
+}
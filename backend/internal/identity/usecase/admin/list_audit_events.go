@@ -0,0 +1,129 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/google/uuid"
+)
+
+type listAuditEventsUseCase struct {
+	audit  ports.AuditEventRepository
+	logger ports.Logger
+}
+
+func NewListAuditEventsUseCase(audit ports.AuditEventRepository, logger ports.Logger) admin.ListAuditEventsUseCase {
+	return &listAuditEventsUseCase{
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+func (uc *listAuditEventsUseCase) Execute(ctx context.Context, query dto.AuditQuery, pagination common.Pagination) (*common.PaginatedResult[dto.AuditEventInfo], error) {
+	uc.logger.Debug("listing audit events", "query", query, "pagination", pagination)
+
+	filter, err := parseAuditQuery(query)
+	if err != nil {
+		uc.logger.Info("invalid audit filter", "query", query, "error", err)
+		return nil, err
+	}
+
+	result, err := uc.audit.List(ctx, filter, pagination)
+	if err != nil {
+		uc.logger.Error("failed to list audit events", err)
+		return nil, err
+	}
+
+	items := make([]dto.AuditEventInfo, 0, len(result.Items))
+	for _, event := range result.Items {
+		items = append(items, toAuditEventInfo(event))
+	}
+
+	return common.NewPaginatedResult(items, result.TotalCount, pagination), nil
+}
+
+func parseAuditQuery(query dto.AuditQuery) (dto.AuditFilter, error) {
+	var filter dto.AuditFilter
+	var err error
+
+	if filter.ActorID, err = parseOptionalUUID(query.ActorID); err != nil {
+		return filter, entity.ErrInvalidAuditFilter
+	}
+
+	if filter.TargetID, err = parseOptionalUUID(query.TargetID); err != nil {
+		return filter, entity.ErrInvalidAuditFilter
+	}
+
+	if query.Action != "" {
+		action, err := vo.NewAuditAction(query.Action)
+		if err != nil {
+			return filter, err
+		}
+		filter.Action = &action
+	}
+
+	if filter.From, err = parseOptionalTime(query.From); err != nil {
+		return filter, entity.ErrInvalidAuditFilter
+	}
+
+	if filter.To, err = parseOptionalTime(query.To); err != nil {
+		return filter, entity.ErrInvalidAuditFilter
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, entity.ErrInvalidAuditRange
+	}
+
+	return filter, nil
+}
+
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func toAuditEventInfo(event *entity.AuditEvent) dto.AuditEventInfo {
+	changes := make(map[string]dto.AuditChangeInfo, len(event.Changes()))
+	for field, change := range event.Changes() {
+		changes[field] = dto.AuditChangeInfo{Before: change.Before, After: change.After}
+	}
+
+	return dto.AuditEventInfo{
+		ID:             event.ID(),
+		Action:         event.Action().String(),
+		ActorID:        event.ActorID(),
+		TargetID:       event.TargetID(),
+		ImpersonatorID: event.ImpersonatorID(),
+		IPAddress:      event.IPAddress(),
+		UserAgent:      event.UserAgent(),
+		Changes:        changes,
+		OccurredAt:     event.OccurredAt(),
+	}
+}
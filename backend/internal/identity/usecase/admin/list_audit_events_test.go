@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListAuditEventsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	actorID := uuid.New()
+	targetID := uuid.New()
+	pagination := common.NewPagination(1, 10, "", "", "")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	event := entity.NewAuditEvent(vo.AuditRolesChanged, &actorID, &targetID, "10.0.0.1", "curl/8.0", from).
+		WithChange("roles", []vo.Role{vo.EmployeeRole}, []vo.Role{vo.ManagerRole})
+
+	tests := []struct {
+		name    string
+		query   dto.AuditQuery
+		setup   func(a *MockAuditEventRepository)
+		wantErr error
+	}{
+		{
+			name: "Success - All Filters",
+			query: dto.AuditQuery{
+				ActorID:  actorID.String(),
+				TargetID: targetID.String(),
+				Action:   "user.roles.changed",
+				From:     from.Format(time.RFC3339),
+				To:       to.Format(time.RFC3339),
+			},
+			setup: func(a *MockAuditEventRepository) {
+				a.On("List", ctx, mock.MatchedBy(func(f dto.AuditFilter) bool {
+					return *f.ActorID == actorID && *f.TargetID == targetID &&
+						*f.Action == vo.AuditRolesChanged && f.From.Equal(from) && f.To.Equal(to)
+				}), pagination).Return(common.NewPaginatedResult([]*entity.AuditEvent{event}, 1, pagination), nil)
+			},
+		},
+		{
+			name:  "Success - No Filters",
+			query: dto.AuditQuery{},
+			setup: func(a *MockAuditEventRepository) {
+				a.On("List", ctx, dto.AuditFilter{}, pagination).Return(common.NewPaginatedResult([]*entity.AuditEvent{event}, 1, pagination), nil)
+			},
+		},
+		{
+			name:    "Invalid Actor",
+			query:   dto.AuditQuery{ActorID: "not-a-uuid"},
+			setup:   func(a *MockAuditEventRepository) {},
+			wantErr: entity.ErrInvalidAuditFilter,
+		},
+		{
+			name:    "Unknown Action",
+			query:   dto.AuditQuery{Action: "user.deleted"},
+			setup:   func(a *MockAuditEventRepository) {},
+			wantErr: vo.ErrInvalidAuditAction,
+		},
+		{
+			name:    "Invalid Time",
+			query:   dto.AuditQuery{From: "yesterday"},
+			setup:   func(a *MockAuditEventRepository) {},
+			wantErr: entity.ErrInvalidAuditFilter,
+		},
+		{
+			name:    "Range Ends Before It Starts",
+			query:   dto.AuditQuery{From: to.Format(time.RFC3339), To: from.Format(time.RFC3339)},
+			setup:   func(a *MockAuditEventRepository) {},
+			wantErr: entity.ErrInvalidAuditRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := new(MockAuditEventRepository)
+			tt.setup(a)
+
+			uc := NewListAuditEventsUseCase(a, new(MockLogger))
+			result, err := uc.Execute(ctx, tt.query, pagination)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				a.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.Items, 1)
+				assert.Equal(t, "user.roles.changed", result.Items[0].Action)
+				assert.Equal(t, &actorID, result.Items[0].ActorID)
+				assert.Equal(t, []vo.Role{vo.ManagerRole}, result.Items[0].Changes["roles"].After)
+			}
+
+			a.AssertExpectations(t)
+		})
+	}
+}
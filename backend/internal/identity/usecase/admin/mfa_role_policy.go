@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+)
+
+type setRoleMFARequirementUseCase struct {
+	policyRepo ports.MFAPolicyRepository
+	roles      ports.RoleCatalogProvider
+	logger     ports.Logger
+}
+
+func NewSetRoleMFARequirementUseCase(policyRepo ports.MFAPolicyRepository, roles ports.RoleCatalogProvider, logger ports.Logger) admin.SetRoleMFARequirementUseCase {
+	return &setRoleMFARequirementUseCase{
+		policyRepo: policyRepo,
+		roles:      roles,
+		logger:     logger,
+	}
+}
+
+func (u *setRoleMFARequirementUseCase) Execute(ctx context.Context, role string, required bool) error {
+	u.logger.Debug("starting mfa role requirement change", "role", role, "required", required)
+
+	catalog, err := u.roles.Catalog(ctx)
+	if err != nil {
+		u.logger.Error("failed to load role catalog", err)
+		return err
+	}
+
+	validRole, err := catalog.Resolve(role)
+	if err != nil {
+		u.logger.Error("invalid role provided", err, "role", role)
+		return err
+	}
+
+	if err := u.policyRepo.SetRoleRequirement(ctx, validRole, required); err != nil {
+		u.logger.Error("failed to update mfa role requirement", err, "role", role)
+		return err
+	}
+
+	u.logger.Info("mfa role requirement updated", "role", role, "required", required)
+	return nil
+}
+
+type getMFARequiredRolesUseCase struct {
+	policyRepo ports.MFAPolicyRepository
+	logger     ports.Logger
+}
+
+func NewGetMFARequiredRolesUseCase(policyRepo ports.MFAPolicyRepository, logger ports.Logger) admin.GetMFARequiredRolesUseCase {
+	return &getMFARequiredRolesUseCase{
+		policyRepo: policyRepo,
+		logger:     logger,
+	}
+}
+
+func (u *getMFARequiredRolesUseCase) Execute(ctx context.Context) ([]string, error) {
+	roles, err := u.policyRepo.GetRequiredRoles(ctx)
+	if err != nil {
+		u.logger.Error("failed to load mfa required roles", err)
+		return nil, err
+	}
+
+	rolesStr := make([]string, 0, len(roles))
+	for _, role := range roles {
+		rolesStr = append(rolesStr, role.String())
+	}
+
+	return rolesStr, nil
+}
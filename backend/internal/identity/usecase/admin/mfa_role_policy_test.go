@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetRoleMFARequirementUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockPolicy := new(MockMFAPolicyRepository)
+		mockPolicy.On("SetRoleRequirement", ctx, vo.AdminRole, true).Return(nil)
+
+		uc := NewSetRoleMFARequirementUseCase(mockPolicy, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+		err := uc.Execute(ctx, "ADMIN", true)
+
+		assert.NoError(t, err)
+		mockPolicy.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Role", func(t *testing.T) {
+		mockPolicy := new(MockMFAPolicyRepository)
+
+		uc := NewSetRoleMFARequirementUseCase(mockPolicy, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+		err := uc.Execute(ctx, "UNKNOWN", true)
+
+		assert.ErrorIs(t, err, vo.ErrInvalidRole)
+		mockPolicy.AssertNotCalled(t, "SetRoleRequirement")
+	})
+}
+
+func TestGetMFARequiredRolesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockPolicy := new(MockMFAPolicyRepository)
+	mockPolicy.On("GetRequiredRoles", ctx).Return([]vo.Role{vo.AdminRole, vo.ManagerRole}, nil)
+
+	uc := NewGetMFARequiredRolesUseCase(mockPolicy, new(MockLogger))
+	roles, err := uc.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{vo.AdminRole.String(), vo.ManagerRole.String()}, roles)
+}
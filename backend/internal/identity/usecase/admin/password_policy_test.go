@@ -0,0 +1,127 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetStorePasswordPolicyUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	otherStore := uuid.New()
+	managerID := uuid.New()
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+	size := func(n int) *int { return &n }
+
+	tests := []struct {
+		name    string
+		storeID uuid.UUID
+		size    *int
+		setup   func(p *MockStorePasswordPolicyRepository, m *MockStoreMembershipRepository, u *MockUserRepository)
+		wantErr error
+	}{
+		{
+			name:    "Success",
+			storeID: storeID,
+			size:    size(5),
+			setup: func(p *MockStorePasswordPolicyRepository, m *MockStoreMembershipRepository, u *MockUserRepository) {
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				p.On("Save", ctx, mock.MatchedBy(func(policy *entity.StorePasswordPolicy) bool {
+					return policy.StoreID() == storeID && policy.HistorySize() == 5
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "Escalation - Manager Of Another Store",
+			storeID: otherStore,
+			size:    size(5),
+			setup: func(p *MockStorePasswordPolicyRepository, m *MockStoreMembershipRepository, u *MockUserRepository) {
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, otherStore).Return(nil, nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Size Out Of Range",
+			storeID: storeID,
+			size:    size(25),
+			setup: func(p *MockStorePasswordPolicyRepository, m *MockStoreMembershipRepository, u *MockUserRepository) {
+			},
+			wantErr: entity.ErrInvalidHistorySize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := new(MockStorePasswordPolicyRepository)
+			m := new(MockStoreMembershipRepository)
+			u := new(MockUserRepository)
+			tt.setup(p, m, u)
+
+			uc := NewSetStorePasswordPolicyUseCase(p, m, u, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+			info, err := uc.Execute(ctx, managerID, tt.storeID.String(), dto.SetStorePasswordPolicyInput{HistorySize: tt.size})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, info)
+				p.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.size, info.HistorySize)
+			}
+
+			p.AssertExpectations(t)
+			m.AssertExpectations(t)
+			u.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetStorePasswordPolicyUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	otherStore := uuid.New()
+	managerID := uuid.New()
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+
+	t.Run("Store Without Policy", func(t *testing.T) {
+		p := new(MockStorePasswordPolicyRepository)
+		m := new(MockStoreMembershipRepository)
+		u := new(MockUserRepository)
+		u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+		m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+		p.On("Find", ctx, storeID).Return(nil, nil)
+
+		uc := NewGetStorePasswordPolicyUseCase(p, m, u, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+		info, err := uc.Execute(ctx, managerID, storeID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, storeID, info.StoreID)
+		assert.Zero(t, info.HistorySize)
+		assert.Nil(t, info.UpdatedAt)
+	})
+
+	t.Run("Escalation - Manager Of Another Store", func(t *testing.T) {
+		p := new(MockStorePasswordPolicyRepository)
+		m := new(MockStoreMembershipRepository)
+		u := new(MockUserRepository)
+		u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+		m.On("Find", ctx, managerID, otherStore).Return(nil, nil)
+
+		uc := NewGetStorePasswordPolicyUseCase(p, m, u, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+		info, err := uc.Execute(ctx, managerID, otherStore.String())
+
+		assert.ErrorIs(t, err, entity.ErrPrivilegeEscalation)
+		assert.Nil(t, info)
+		p.AssertNotCalled(t, "Find", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type resetUserMFAUseCase struct {
+	userRepo ports.UserRepository
+	mfaRepo  ports.MFARepository
+	roles    ports.RoleCatalogProvider
+	audit    ports.AuditEventRepository
+	logger   ports.Logger
+}
+
+func NewResetUserMFAUseCase(userRepo ports.UserRepository, mfaRepo ports.MFARepository, roles ports.RoleCatalogProvider, audit ports.AuditEventRepository, logger ports.Logger) admin.ResetUserMFAUseCase {
+	return &resetUserMFAUseCase{
+		userRepo: userRepo,
+		mfaRepo:  mfaRepo,
+		roles:    roles,
+		audit:    audit,
+		logger:   logger,
+	}
+}
+
+// Execute removes the user's second factor and recovery codes. If one of the
+// user's roles requires MFA, the next login starts a fresh enrollment.
+func (u *resetUserMFAUseCase) Execute(ctx context.Context, actorID uuid.UUID, id string) error {
+	u.logger.Debug("starting mfa reset", "actorID", actorID, "userID", id)
+
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		u.logger.Error("failed to parse user ID", err, "id", id)
+		return err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		u.logger.Error("failed to find user", err, "userID", userID)
+		return err
+	}
+
+	if user == nil {
+		u.logger.Info("user not found for mfa reset", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	if err := checkReach(ctx, u.userRepo, u.roles, u.logger, actorID, user); err != nil {
+		return err
+	}
+
+	if err := u.mfaRepo.Delete(ctx, userID); err != nil {
+		u.logger.Error("failed to delete mfa settings", err, "userID", userID)
+		return err
+	}
+
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditMFAReset, actorID, userID))
+
+	u.logger.Info("user mfa reset successfully", "userID", userID)
+	return nil
+}
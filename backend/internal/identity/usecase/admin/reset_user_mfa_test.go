@@ -0,0 +1,100 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResetUserMFAUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	actorID := uuid.New()
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, _ := entity.RestoreUser(userID, "test@example.com", "testuser", password.String(), []string{vo.ManagerRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	tests := []struct {
+		name    string
+		id      string
+		setup   func(ur *MockUserRepository, mr *MockMFARepository)
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Success",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, mr *MockMFARepository) {
+				ur.On("FindByID", ctx, userID).Return(user, nil)
+				mr.On("Delete", ctx, userID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Escalation - Target Above Actor",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, mr *MockMFARepository) {
+				target, _ := entity.RestoreUser(userID, "admin@example.com", "admin", password.String(), []string{vo.AdminRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+				ur.On("FindByID", ctx, userID).Return(target, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrPrivilegeEscalation,
+		},
+		{
+			name: "User Not Found",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, mr *MockMFARepository) {
+				ur.On("FindByID", ctx, userID).Return(nil, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrUserNotFound,
+		},
+		{
+			name:    "Invalid UUID",
+			id:      "invalid-uuid",
+			setup:   func(ur *MockUserRepository, mr *MockMFARepository) {},
+			wantErr: true,
+		},
+		{
+			name: "Delete Error",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, mr *MockMFARepository) {
+				ur.On("FindByID", ctx, userID).Return(user, nil)
+				mr.On("Delete", ctx, userID).Return(assert.AnError)
+			},
+			wantErr: true,
+			err:     assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUser := new(MockUserRepository)
+			mockMFA := new(MockMFARepository)
+			tt.setup(mockUser, mockMFA)
+			mockUser.On("FindByID", ctx, actorID).Return(setupRoleActor(actorID, vo.ManagerRole), nil).Maybe()
+
+			audit := newMockAuditLog()
+
+			uc := NewResetUserMFAUseCase(mockUser, mockMFA, newStaticRoleCatalog(vo.SystemRoleCatalog()), audit, new(MockLogger))
+			err := uc.Execute(ctx, actorID, tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditMFAReset}, audit.recordedActions())
+			}
+
+			mockUser.AssertExpectations(t)
+			mockMFA.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type revokeUserSessionsUseCase struct {
+	userRepo    ports.UserRepository
+	refreshRepo ports.RefreshTokenRepository
+	roles       ports.RoleCatalogProvider
+	audit       ports.AuditEventRepository
+	logger      ports.Logger
+}
+
+func NewRevokeUserSessionsUseCase(userRepo ports.UserRepository, refreshRepo ports.RefreshTokenRepository, roles ports.RoleCatalogProvider, audit ports.AuditEventRepository, logger ports.Logger) admin.RevokeUserSessionsUseCase {
+	return &revokeUserSessionsUseCase{
+		userRepo:    userRepo,
+		refreshRepo: refreshRepo,
+		roles:       roles,
+		audit:       audit,
+		logger:      logger,
+	}
+}
+
+func (u *revokeUserSessionsUseCase) Execute(ctx context.Context, actorID uuid.UUID, id string) error {
+	u.logger.Debug("starting revoke user sessions", "actorID", actorID, "userID", id)
+
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		u.logger.Error("failed to parse user ID", err, "id", id)
+		return err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		u.logger.Error("failed to find user", err, "userID", userID)
+		return err
+	}
+
+	if user == nil {
+		u.logger.Info("user not found for session revocation", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	if err := checkReach(ctx, u.userRepo, u.roles, u.logger, actorID, user); err != nil {
+		return err
+	}
+
+	// bumping the token version stops the access tokens already issued too,
+	// which would otherwise keep working until they expire
+	user.RevokeSessions()
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		u.logger.Error("failed to update token version", err, "userID", userID)
+		return err
+	}
+
+	if err := u.refreshRepo.DeleteAllRefreshTokens(ctx, userID); err != nil {
+		u.logger.Error("failed to revoke user sessions", err, "userID", userID)
+		return err
+	}
+
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditSessionsRevoked, actorID, userID))
+
+	u.logger.Info("user sessions revoked successfully", "userID", userID)
+	return nil
+}
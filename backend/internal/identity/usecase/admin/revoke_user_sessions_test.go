@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevokeUserSessionsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	actorID := uuid.New()
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	newUser := func() *entity.User {
+		user, _ := entity.RestoreUser(userID, "test@example.com", "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		setup   func(ur *MockUserRepository, rr *MockRefreshTokenRepository)
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Success",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository) {
+				ur.On("FindByID", ctx, userID).Return(newUser(), nil)
+				ur.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
+					return u.TokenVersion() == 1
+				})).Return(nil)
+				rr.On("DeleteAllRefreshTokens", ctx, userID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Escalation - Target Above Actor",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository) {
+				target, _ := entity.RestoreUser(userID, "admin@example.com", "admin", password.String(), []string{vo.AdminRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+				ur.On("FindByID", ctx, userID).Return(target, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrPrivilegeEscalation,
+		},
+		{
+			name: "User Not Found",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository) {
+				ur.On("FindByID", ctx, userID).Return(nil, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrUserNotFound,
+		},
+		{
+			name:    "Invalid UUID",
+			id:      "invalid-uuid",
+			setup:   func(ur *MockUserRepository, rr *MockRefreshTokenRepository) {},
+			wantErr: true,
+		},
+		{
+			name: "Update Error",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository) {
+				ur.On("FindByID", ctx, userID).Return(newUser(), nil)
+				ur.On("Update", ctx, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: true,
+			err:     assert.AnError,
+		},
+		{
+			name: "Revoke Error",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository) {
+				ur.On("FindByID", ctx, userID).Return(newUser(), nil)
+				ur.On("Update", ctx, mock.Anything).Return(nil)
+				rr.On("DeleteAllRefreshTokens", ctx, userID).Return(assert.AnError)
+			},
+			wantErr: true,
+			err:     assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUser := new(MockUserRepository)
+			mockRR := new(MockRefreshTokenRepository)
+			tt.setup(mockUser, mockRR)
+
+			mockUser.On("FindByID", ctx, actorID).Return(setupRoleActor(actorID, vo.ManagerRole), nil).Maybe()
+
+			audit := newMockAuditLog()
+
+			uc := NewRevokeUserSessionsUseCase(mockUser, mockRR, newStaticRoleCatalog(vo.SystemRoleCatalog()), audit, new(MockLogger))
+			err := uc.Execute(ctx, actorID, tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditSessionsRevoked}, audit.recordedActions())
+			}
+
+			mockUser.AssertExpectations(t)
+			mockRR.AssertExpectations(t)
+		})
+	}
+}
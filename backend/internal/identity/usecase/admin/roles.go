@@ -0,0 +1,334 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type createRoleUseCase struct {
+	roleRepo ports.RoleRepository
+	userRepo ports.UserRepository
+	roles    ports.RoleCatalogProvider
+	audit    ports.AuditEventRepository
+	logger   ports.Logger
+}
+
+func NewCreateRoleUseCase(
+	roleRepo ports.RoleRepository,
+	userRepo ports.UserRepository,
+	roles ports.RoleCatalogProvider,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) admin.CreateRoleUseCase {
+	return &createRoleUseCase{
+		roleRepo: roleRepo,
+		userRepo: userRepo,
+		roles:    roles,
+		audit:    audit,
+		logger:   logger,
+	}
+}
+
+// Execute refuses roles granting permissions the actor does not hold, which
+// would otherwise be a way around the checks on role assignment.
+func (u *createRoleUseCase) Execute(ctx context.Context, actorID uuid.UUID, input dto.CreateRoleInput) (*dto.RoleInfo, error) {
+	u.logger.Debug("starting role creation", "name", input.Name, "storeID", input.StoreID, "actorID", actorID)
+
+	name, err := vo.ParseRoleName(input.Name)
+	if err != nil {
+		u.logger.Info("invalid role name provided", "name", input.Name)
+		return nil, err
+	}
+
+	permissions, err := parsePermissions(input.Permissions)
+	if err != nil {
+		u.logger.Info("invalid permission provided", "permissions", input.Permissions)
+		return nil, err
+	}
+
+	role, err := entity.NewRole(name, input.StoreID, input.Description, permissions, time.Now())
+	if err != nil {
+		u.logger.Info("invalid role input", "error", err)
+		return nil, err
+	}
+
+	// names are global, see entity.Role, so this also refuses a name another
+	// store already uses
+	existing, err := u.roleRepo.FindByName(ctx, name)
+	if err != nil {
+		u.logger.Error("failed to find role", err, "name", name)
+		return nil, err
+	}
+
+	if existing != nil {
+		u.logger.Info("role name already taken", "name", name, "storeID", existing.StoreID())
+		return nil, entity.ErrRoleAlreadyExists
+	}
+
+	catalog, err := u.roles.Catalog(ctx)
+	if err != nil {
+		u.logger.Error("failed to load role catalog", err)
+		return nil, err
+	}
+
+	grants, err := actorGrants(ctx, u.userRepo, catalog, u.logger, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !grants.Covers(vo.NewPermissionSet(role.Permissions()...)) {
+		u.logger.Info("security event: role creation beyond actor permissions refused", "actorID", actorID, "name", name)
+		return nil, entity.ErrPrivilegeEscalation
+	}
+
+	if err := u.roleRepo.Save(ctx, role); err != nil {
+		u.logger.Error("failed to save role", err, "name", name)
+		return nil, err
+	}
+
+	u.roles.Invalidate()
+
+	// roles have no user to target, so the event names the role it created
+	auditlog.Record(ctx, u.audit, u.logger, auditlog.Event(ctx, vo.AuditRoleCreated, &actorID, nil).
+		WithChange("name", nil, name).
+		WithChange("store_id", nil, role.StoreID()).
+		WithChange("permissions", nil, role.Permissions()))
+
+	u.logger.Info("role created successfully", "name", name, "storeID", input.StoreID, "actorID", actorID)
+	info := toRoleInfo(role)
+	return &info, nil
+}
+
+type listRolesUseCase struct {
+	roleRepo ports.RoleRepository
+	logger   ports.Logger
+}
+
+func NewListRolesUseCase(roleRepo ports.RoleRepository, logger ports.Logger) admin.ListRolesUseCase {
+	return &listRolesUseCase{
+		roleRepo: roleRepo,
+		logger:   logger,
+	}
+}
+
+// Execute lists the system roles with the custom roles of one store, or the
+// system roles alone when storeID is empty: custom roles are only listed for
+// the store that defined them.
+func (u *listRolesUseCase) Execute(ctx context.Context, storeID string) ([]dto.RoleInfo, error) {
+	u.logger.Debug("listing roles", "storeID", storeID)
+
+	var filter *uuid.UUID
+	if storeID != "" {
+		parsed, err := uuid.Parse(storeID)
+		if err != nil {
+			u.logger.Error("failed to parse store ID", err, "storeID", storeID)
+			return nil, err
+		}
+
+		filter = &parsed
+	}
+
+	roles, err := u.roleRepo.List(ctx, filter)
+	if err != nil {
+		u.logger.Error("failed to list roles", err, "storeID", storeID)
+		return nil, err
+	}
+
+	result := make([]dto.RoleInfo, 0, len(roles))
+	for _, role := range roles {
+		if filter == nil && !role.IsSystem() {
+			continue
+		}
+
+		result = append(result, toRoleInfo(role))
+	}
+
+	return result, nil
+}
+
+type updateRoleUseCase struct {
+	roleRepo ports.RoleRepository
+	userRepo ports.UserRepository
+	roles    ports.RoleCatalogProvider
+	audit    ports.AuditEventRepository
+	logger   ports.Logger
+}
+
+func NewUpdateRoleUseCase(
+	roleRepo ports.RoleRepository,
+	userRepo ports.UserRepository,
+	roles ports.RoleCatalogProvider,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) admin.UpdateRoleUseCase {
+	return &updateRoleUseCase{
+		roleRepo: roleRepo,
+		userRepo: userRepo,
+		roles:    roles,
+		audit:    audit,
+		logger:   logger,
+	}
+}
+
+// Execute requires the actor to hold both the old and the new permissions,
+// for the same reasons a role change does.
+func (u *updateRoleUseCase) Execute(ctx context.Context, actorID uuid.UUID, name string, input dto.UpdateRoleInput) (*dto.RoleInfo, error) {
+	u.logger.Debug("starting role update", "name", name, "actorID", actorID)
+
+	roleName, err := vo.ParseRoleName(name)
+	if err != nil {
+		u.logger.Info("invalid role name provided", "name", name)
+		return nil, err
+	}
+
+	permissions, err := parsePermissions(input.Permissions)
+	if err != nil {
+		u.logger.Info("invalid permission provided", "permissions", input.Permissions)
+		return nil, err
+	}
+
+	role, err := u.roleRepo.FindByName(ctx, roleName)
+	if err != nil {
+		u.logger.Error("failed to find role", err, "name", roleName)
+		return nil, err
+	}
+
+	if role == nil {
+		u.logger.Info("role not found for update", "name", roleName)
+		return nil, entity.ErrRoleNotFound
+	}
+
+	catalog, err := u.roles.Catalog(ctx)
+	if err != nil {
+		u.logger.Error("failed to load role catalog", err)
+		return nil, err
+	}
+
+	grants, err := actorGrants(ctx, u.userRepo, catalog, u.logger, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !grants.Covers(vo.NewPermissionSet(role.Permissions()...)) || !grants.Covers(vo.NewPermissionSet(permissions...)) {
+		u.logger.Info("security event: role update beyond actor permissions refused", "actorID", actorID, "name", roleName)
+		return nil, entity.ErrPrivilegeEscalation
+	}
+
+	previousPermissions := role.Permissions()
+
+	if err := role.Update(input.Description, permissions, time.Now()); err != nil {
+		u.logger.Info("role update refused", "name", roleName, "error", err)
+		return nil, err
+	}
+
+	if err := u.roleRepo.Update(ctx, role); err != nil {
+		u.logger.Error("failed to update role", err, "name", roleName)
+		return nil, err
+	}
+
+	u.roles.Invalidate()
+
+	// the name never changes, it is recorded so the event says which role
+	auditlog.Record(ctx, u.audit, u.logger, auditlog.Event(ctx, vo.AuditRoleUpdated, &actorID, nil).
+		WithChange("name", roleName, roleName).
+		WithChange("permissions", previousPermissions, role.Permissions()))
+
+	u.logger.Info("role updated successfully", "name", roleName, "actorID", actorID)
+	info := toRoleInfo(role)
+	return &info, nil
+}
+
+type deleteRoleUseCase struct {
+	roleRepo ports.RoleRepository
+	roles    ports.RoleCatalogProvider
+	audit    ports.AuditEventRepository
+	logger   ports.Logger
+}
+
+func NewDeleteRoleUseCase(roleRepo ports.RoleRepository, roles ports.RoleCatalogProvider, audit ports.AuditEventRepository, logger ports.Logger) admin.DeleteRoleUseCase {
+	return &deleteRoleUseCase{
+		roleRepo: roleRepo,
+		roles:    roles,
+		audit:    audit,
+		logger:   logger,
+	}
+}
+
+// Execute only deletes roles nobody holds; users must be moved to another
+// role first, so a deletion never silently strips anyone's permissions.
+func (u *deleteRoleUseCase) Execute(ctx context.Context, actorID uuid.UUID, name string) error {
+	u.logger.Debug("starting role deletion", "name", name, "actorID", actorID)
+
+	roleName, err := vo.ParseRoleName(name)
+	if err != nil {
+		u.logger.Info("invalid role name provided", "name", name)
+		return err
+	}
+
+	role, err := u.roleRepo.FindByName(ctx, roleName)
+	if err != nil {
+		u.logger.Error("failed to find role", err, "name", roleName)
+		return err
+	}
+
+	if role == nil {
+		u.logger.Info("role not found for deletion", "name", roleName)
+		return entity.ErrRoleNotFound
+	}
+
+	if role.IsSystem() {
+		u.logger.Info("system role deletion refused", "name", roleName)
+		return entity.ErrSystemRoleImmutable
+	}
+
+	holders, err := u.roleRepo.CountUsers(ctx, roleName)
+	if err != nil {
+		u.logger.Error("failed to count role holders", err, "name", roleName)
+		return err
+	}
+
+	if holders > 0 {
+		u.logger.Info("role still assigned, deletion refused", "name", roleName, "holders", holders)
+		return entity.ErrRoleInUse
+	}
+
+	if err := u.roleRepo.Delete(ctx, roleName); err != nil {
+		u.logger.Error("failed to delete role", err, "name", roleName)
+		return err
+	}
+
+	u.roles.Invalidate()
+
+	auditlog.Record(ctx, u.audit, u.logger, auditlog.Event(ctx, vo.AuditRoleDeleted, &actorID, nil).
+		WithChange("name", roleName, nil).
+		WithChange("store_id", role.StoreID(), nil).
+		WithChange("permissions", role.Permissions(), nil))
+
+	u.logger.Info("role deleted successfully", "name", roleName, "actorID", actorID)
+	return nil
+}
+
+func toRoleInfo(role *entity.Role) dto.RoleInfo {
+	permissions := make([]string, 0, len(role.Permissions()))
+	for _, permission := range role.Permissions() {
+		permissions = append(permissions, permission.String())
+	}
+
+	return dto.RoleInfo{
+		Name:        role.Name().String(),
+		StoreID:     role.StoreID(),
+		Description: role.Description(),
+		Permissions: permissions,
+		System:      role.IsSystem(),
+		CreatedAt:   role.CreatedAt(),
+		UpdatedAt:   role.UpdatedAt(),
+	}
+}
@@ -0,0 +1,321 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupRoleActor(id uuid.UUID, role vo.Role) *entity.User {
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	actor, _ := entity.RestoreUser(id, "actor@example.com", "actor", password.String(), []string{role.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	return actor
+}
+
+func TestCreateRoleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	adminID := uuid.New()
+	managerID := uuid.New()
+	existing, _ := entity.NewRole("CASHIER", uuid.New(), "", []vo.Permission{vo.PermOrdersRead}, time.Now())
+
+	tests := []struct {
+		name    string
+		actorID uuid.UUID
+		input   dto.CreateRoleInput
+		setup   func(r *MockRoleRepository, u *MockUserRepository)
+		wantErr error
+	}{
+		{
+			name:    "Success",
+			actorID: managerID,
+			input:   dto.CreateRoleInput{Name: "cashier", StoreID: storeID, Description: "Front desk", Permissions: []string{"orders:read", "orders:write"}},
+			setup: func(r *MockRoleRepository, u *MockUserRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(nil, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.ManagerRole), nil)
+				r.On("Save", ctx, mock.MatchedBy(func(role *entity.Role) bool {
+					return role.Name() == "CASHIER" && *role.StoreID() == storeID && len(role.Permissions()) == 2
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "Escalation - Permission Actor Lacks",
+			actorID: managerID,
+			input:   dto.CreateRoleInput{Name: "KEYMASTER", StoreID: storeID, Permissions: []string{"api_keys:manage"}},
+			setup: func(r *MockRoleRepository, u *MockUserRepository) {
+				r.On("FindByName", ctx, vo.Role("KEYMASTER")).Return(nil, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.ManagerRole), nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Name Taken",
+			actorID: adminID,
+			input:   dto.CreateRoleInput{Name: "CASHIER", StoreID: storeID, Permissions: []string{"orders:read"}},
+			setup: func(r *MockRoleRepository, u *MockUserRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(existing, nil)
+			},
+			wantErr: entity.ErrRoleAlreadyExists,
+		},
+		{
+			name:    "System Name Reserved",
+			actorID: adminID,
+			input:   dto.CreateRoleInput{Name: "admin", StoreID: storeID, Permissions: []string{"orders:read"}},
+			setup:   func(r *MockRoleRepository, u *MockUserRepository) {},
+			wantErr: entity.ErrRoleNameReserved,
+		},
+		{
+			name:    "Unknown Permission",
+			actorID: adminID,
+			input:   dto.CreateRoleInput{Name: "CASHIER", StoreID: storeID, Permissions: []string{"orders:delete"}},
+			setup:   func(r *MockRoleRepository, u *MockUserRepository) {},
+			wantErr: vo.ErrInvalidPermission,
+		},
+		{
+			name:    "Invalid Name",
+			actorID: adminID,
+			input:   dto.CreateRoleInput{Name: "head-chef", StoreID: storeID, Permissions: []string{"orders:read"}},
+			setup:   func(r *MockRoleRepository, u *MockUserRepository) {},
+			wantErr: vo.ErrInvalidRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := new(MockRoleRepository)
+			userRepo := new(MockUserRepository)
+			catalog := newStaticRoleCatalog(vo.SystemRoleCatalog())
+			audit := newMockAuditLog()
+			tt.setup(roleRepo, userRepo)
+
+			uc := NewCreateRoleUseCase(roleRepo, userRepo, catalog, audit, new(MockLogger))
+			info, err := uc.Execute(ctx, tt.actorID, tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, info)
+				assert.Zero(t, catalog.invalidations)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "CASHIER", info.Name)
+				assert.False(t, info.System)
+				assert.Equal(t, 1, catalog.invalidations)
+				assert.Equal(t, []vo.AuditAction{vo.AuditRoleCreated}, audit.recordedActions())
+			}
+
+			roleRepo.AssertExpectations(t)
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListRolesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	admin, _ := entity.RestoreRole("ADMIN", nil, "", nil, true, time.Now(), time.Now())
+	cashier, _ := entity.NewRole("CASHIER", storeID, "", []vo.Permission{vo.PermOrdersRead}, time.Now())
+
+	t.Run("Filtered By Store", func(t *testing.T) {
+		roleRepo := new(MockRoleRepository)
+		roleRepo.On("List", ctx, &storeID).Return([]*entity.Role{admin, cashier}, nil)
+
+		roles, err := NewListRolesUseCase(roleRepo, new(MockLogger)).Execute(ctx, storeID.String())
+
+		assert.NoError(t, err)
+		assert.Len(t, roles, 2)
+		assert.True(t, roles[0].System)
+		assert.Nil(t, roles[0].StoreID)
+		assert.Equal(t, []string{"orders:read"}, roles[1].Permissions)
+		roleRepo.AssertExpectations(t)
+	})
+
+	t.Run("Without Store Lists System Roles Only", func(t *testing.T) {
+		roleRepo := new(MockRoleRepository)
+		roleRepo.On("List", ctx, (*uuid.UUID)(nil)).Return([]*entity.Role{admin, cashier}, nil)
+
+		roles, err := NewListRolesUseCase(roleRepo, new(MockLogger)).Execute(ctx, "")
+
+		assert.NoError(t, err)
+		if assert.Len(t, roles, 1) {
+			assert.Equal(t, "ADMIN", roles[0].Name)
+		}
+		roleRepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Store ID", func(t *testing.T) {
+		_, err := NewListRolesUseCase(new(MockRoleRepository), new(MockLogger)).Execute(ctx, "invalid-uuid")
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateRoleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	managerID := uuid.New()
+	adminID := uuid.New()
+	setupCustom := func(permissions ...vo.Permission) *entity.Role {
+		role, _ := entity.NewRole("CASHIER", uuid.New(), "", permissions, time.Now())
+		return role
+	}
+	system, _ := entity.RestoreRole("EMPLOYEE", nil, "", nil, true, time.Now(), time.Now())
+
+	tests := []struct {
+		name    string
+		actorID uuid.UUID
+		role    string
+		input   dto.UpdateRoleInput
+		setup   func(r *MockRoleRepository, u *MockUserRepository)
+		wantErr error
+	}{
+		{
+			name:    "Success",
+			actorID: managerID,
+			role:    "cashier",
+			input:   dto.UpdateRoleInput{Description: "Tills", Permissions: []string{"orders:write"}},
+			setup: func(r *MockRoleRepository, u *MockUserRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(setupCustom(vo.PermOrdersRead), nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.ManagerRole), nil)
+				r.On("Update", ctx, mock.MatchedBy(func(role *entity.Role) bool {
+					return role.Description() == "Tills" && role.Permissions()[0] == vo.PermOrdersWrite
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "Escalation - Role Already Above Actor",
+			actorID: managerID,
+			role:    "CASHIER",
+			input:   dto.UpdateRoleInput{Permissions: []string{"orders:read"}},
+			setup: func(r *MockRoleRepository, u *MockUserRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(setupCustom(vo.PermAPIKeysManage), nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.ManagerRole), nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "System Role",
+			actorID: adminID,
+			role:    "EMPLOYEE",
+			input:   dto.UpdateRoleInput{Permissions: []string{"orders:read"}},
+			setup: func(r *MockRoleRepository, u *MockUserRepository) {
+				r.On("FindByName", ctx, vo.EmployeeRole).Return(system, nil)
+				u.On("FindByID", ctx, adminID).Return(setupRoleActor(adminID, vo.AdminRole), nil)
+			},
+			wantErr: entity.ErrSystemRoleImmutable,
+		},
+		{
+			name:    "Not Found",
+			actorID: adminID,
+			role:    "CASHIER",
+			input:   dto.UpdateRoleInput{Permissions: []string{"orders:read"}},
+			setup: func(r *MockRoleRepository, u *MockUserRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(nil, nil)
+			},
+			wantErr: entity.ErrRoleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := new(MockRoleRepository)
+			userRepo := new(MockUserRepository)
+			catalog := newStaticRoleCatalog(vo.SystemRoleCatalog())
+			audit := newMockAuditLog()
+			tt.setup(roleRepo, userRepo)
+
+			uc := NewUpdateRoleUseCase(roleRepo, userRepo, catalog, audit, new(MockLogger))
+			info, err := uc.Execute(ctx, tt.actorID, tt.role, tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, info)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"orders:write"}, info.Permissions)
+				assert.Equal(t, 1, catalog.invalidations)
+				assert.Equal(t, []vo.AuditAction{vo.AuditRoleUpdated}, audit.recordedActions())
+			}
+
+			roleRepo.AssertExpectations(t)
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteRoleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	cashier, _ := entity.NewRole("CASHIER", uuid.New(), "", []vo.Permission{vo.PermOrdersRead}, time.Now())
+	system, _ := entity.RestoreRole("MANAGER", nil, "", nil, true, time.Now(), time.Now())
+
+	tests := []struct {
+		name    string
+		role    string
+		setup   func(r *MockRoleRepository)
+		wantErr error
+	}{
+		{
+			name: "Success",
+			role: "CASHIER",
+			setup: func(r *MockRoleRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(cashier, nil)
+				r.On("CountUsers", ctx, vo.Role("CASHIER")).Return(0, nil)
+				r.On("Delete", ctx, vo.Role("CASHIER")).Return(nil)
+			},
+		},
+		{
+			name: "In Use",
+			role: "CASHIER",
+			setup: func(r *MockRoleRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(cashier, nil)
+				r.On("CountUsers", ctx, vo.Role("CASHIER")).Return(3, nil)
+			},
+			wantErr: entity.ErrRoleInUse,
+		},
+		{
+			name: "System Role",
+			role: "MANAGER",
+			setup: func(r *MockRoleRepository) {
+				r.On("FindByName", ctx, vo.ManagerRole).Return(system, nil)
+			},
+			wantErr: entity.ErrSystemRoleImmutable,
+		},
+		{
+			name: "Not Found",
+			role: "CASHIER",
+			setup: func(r *MockRoleRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(nil, nil)
+			},
+			wantErr: entity.ErrRoleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := new(MockRoleRepository)
+			catalog := newStaticRoleCatalog(vo.SystemRoleCatalog())
+			audit := newMockAuditLog()
+			tt.setup(roleRepo)
+
+			err := NewDeleteRoleUseCase(roleRepo, catalog, audit, new(MockLogger)).Execute(ctx, uuid.New(), tt.role)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, catalog.invalidations)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, catalog.invalidations)
+				assert.Equal(t, []vo.AuditAction{vo.AuditRoleDeleted}, audit.recordedActions())
+			}
+
+			roleRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,431 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+// storeMembers holds what every membership use case needs to check that the
+// actor may manage the members of a store.
+type storeMembers struct {
+	memberships ports.StoreMembershipRepository
+	userRepo    ports.UserRepository
+	roleRepo    ports.RoleRepository
+	roles       ports.RoleCatalogProvider
+	logger      ports.Logger
+}
+
+// resolveRoles accepts system roles and the custom roles of storeID only.
+func (s *storeMembers) resolveRoles(ctx context.Context, catalog vo.RoleCatalog, storeID uuid.UUID, values []string) ([]vo.Role, error) {
+	roles := make([]vo.Role, 0, len(values))
+	for _, value := range values {
+		role, err := catalog.Resolve(value)
+		if err != nil {
+			s.logger.Info("invalid role provided", "role", value)
+			return nil, err
+		}
+
+		if !vo.IsSystemRole(role) {
+			custom, err := s.roleRepo.FindByName(ctx, role)
+			if err != nil {
+				s.logger.Error("failed to find role", err, "name", role)
+				return nil, err
+			}
+
+			if custom == nil {
+				return nil, entity.ErrRoleNotFound
+			}
+
+			if custom.StoreID() == nil || *custom.StoreID() != storeID {
+				s.logger.Info("role of another store provided", "role", role, "storeID", storeID)
+				return nil, entity.ErrRoleNotInStore
+			}
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// checkEscalation refuses the change unless the actor may manage members in
+// storeID and holds every permission of both the current and the new roles,
+// as role changes on users do.
+func (s *storeMembers) checkEscalation(ctx context.Context, catalog vo.RoleCatalog, actorID, storeID uuid.UUID, current, next []vo.Role) error {
+	grants, err := actorStoreGrants(ctx, s.userRepo, s.memberships, catalog, s.logger, actorID, storeID)
+	if err != nil {
+		return err
+	}
+
+	if !grants.Has(vo.PermMembersManage) || !grants.Covers(catalog.GrantsOf(current...)) || !grants.Covers(catalog.GrantsOf(next...)) {
+		s.logger.Info("security event: membership change beyond actor permissions refused",
+			"actorID", actorID,
+			"storeID", storeID,
+			"currentRoles", current,
+			"newRoles", next,
+		)
+		return entity.ErrPrivilegeEscalation
+	}
+
+	return nil
+}
+
+func (s *storeMembers) catalog(ctx context.Context) (vo.RoleCatalog, error) {
+	catalog, err := s.roles.Catalog(ctx)
+	if err != nil {
+		s.logger.Error("failed to load role catalog", err)
+		return vo.RoleCatalog{}, err
+	}
+
+	return catalog, nil
+}
+
+type addStoreMemberUseCase struct {
+	storeMembers
+	audit ports.AuditEventRepository
+}
+
+func NewAddStoreMemberUseCase(
+	memberships ports.StoreMembershipRepository,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	roles ports.RoleCatalogProvider,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) admin.AddStoreMemberUseCase {
+	return &addStoreMemberUseCase{
+		storeMembers: storeMembers{
+			memberships: memberships,
+			userRepo:    userRepo,
+			roleRepo:    roleRepo,
+			roles:       roles,
+			logger:      logger,
+		},
+		audit: audit,
+	}
+}
+
+func (u *addStoreMemberUseCase) Execute(ctx context.Context, actorID uuid.UUID, storeID string, input dto.AddStoreMemberInput) (*dto.StoreMemberInfo, error) {
+	u.logger.Debug("starting store member addition", "storeID", storeID, "userID", input.UserID, "actorID", actorID)
+
+	store, err := parseStoreID(storeID)
+	if err != nil {
+		u.logger.Info("invalid store ID provided", "storeID", storeID)
+		return nil, err
+	}
+
+	catalog, err := u.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := u.resolveRoles(ctx, catalog, store, input.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	membership, err := entity.NewStoreMembership(input.UserID, store, roles, time.Now())
+	if err != nil {
+		u.logger.Info("invalid membership input", "error", err)
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		u.logger.Error("failed to find user", err, "userID", input.UserID)
+		return nil, err
+	}
+
+	if user == nil {
+		u.logger.Info("user not found for store membership", "userID", input.UserID)
+		return nil, entity.ErrUserNotFound
+	}
+
+	existing, err := u.memberships.Find(ctx, input.UserID, store)
+	if err != nil {
+		u.logger.Error("failed to find store membership", err, "userID", input.UserID, "storeID", store)
+		return nil, err
+	}
+
+	if existing != nil {
+		u.logger.Info("user is already a store member", "userID", input.UserID, "storeID", store)
+		return nil, entity.ErrMembershipAlreadyExists
+	}
+
+	if err := u.checkEscalation(ctx, catalog, actorID, store, nil, roles); err != nil {
+		return nil, err
+	}
+
+	if err := u.memberships.Save(ctx, membership); err != nil {
+		u.logger.Error("failed to save store membership", err, "userID", input.UserID, "storeID", store)
+		return nil, err
+	}
+
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditMemberAdded, actorID, input.UserID).
+		WithChange("store_id", nil, store).
+		WithChange("roles", nil, roles))
+
+	u.logger.Info("store member added successfully", "userID", input.UserID, "storeID", store, "roles", roles, "actorID", actorID)
+	info := toStoreMemberInfo(membership)
+	return &info, nil
+}
+
+type listStoreMembersUseCase struct {
+	memberships ports.StoreMembershipRepository
+	userRepo    ports.UserRepository
+	roles       ports.RoleCatalogProvider
+	logger      ports.Logger
+}
+
+func NewListStoreMembersUseCase(
+	memberships ports.StoreMembershipRepository,
+	userRepo ports.UserRepository,
+	roles ports.RoleCatalogProvider,
+	logger ports.Logger,
+) admin.ListStoreMembersUseCase {
+	return &listStoreMembersUseCase{
+		memberships: memberships,
+		userRepo:    userRepo,
+		roles:       roles,
+		logger:      logger,
+	}
+}
+
+func (u *listStoreMembersUseCase) Execute(ctx context.Context, actorID uuid.UUID, storeID string) ([]dto.StoreMemberInfo, error) {
+	u.logger.Debug("listing store members", "storeID", storeID, "actorID", actorID)
+
+	store, err := parseStoreID(storeID)
+	if err != nil {
+		u.logger.Info("invalid store ID provided", "storeID", storeID)
+		return nil, err
+	}
+
+	if err := checkStorePermission(ctx, u.userRepo, u.memberships, u.roles, u.logger, actorID, store, vo.PermMembersManage); err != nil {
+		return nil, err
+	}
+
+	memberships, err := u.memberships.ListByStore(ctx, store)
+	if err != nil {
+		u.logger.Error("failed to list store members", err, "storeID", store)
+		return nil, err
+	}
+
+	result := make([]dto.StoreMemberInfo, 0, len(memberships))
+	for _, membership := range memberships {
+		result = append(result, toStoreMemberInfo(membership))
+	}
+
+	return result, nil
+}
+
+type updateStoreMemberUseCase struct {
+	storeMembers
+	audit ports.AuditEventRepository
+}
+
+func NewUpdateStoreMemberUseCase(
+	memberships ports.StoreMembershipRepository,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	roles ports.RoleCatalogProvider,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) admin.UpdateStoreMemberUseCase {
+	return &updateStoreMemberUseCase{
+		storeMembers: storeMembers{
+			memberships: memberships,
+			userRepo:    userRepo,
+			roleRepo:    roleRepo,
+			roles:       roles,
+			logger:      logger,
+		},
+		audit: audit,
+	}
+}
+
+// Execute replaces the roles of a member and, when a status is given,
+// suspends or reactivates the membership.
+func (u *updateStoreMemberUseCase) Execute(ctx context.Context, actorID uuid.UUID, storeID string, userID string, input dto.UpdateStoreMemberInput) (*dto.StoreMemberInfo, error) {
+	u.logger.Debug("starting store member update", "storeID", storeID, "userID", userID, "actorID", actorID)
+
+	store, member, err := parseMembershipKey(storeID, userID)
+	if err != nil {
+		u.logger.Info("invalid membership key provided", "storeID", storeID, "userID", userID)
+		return nil, err
+	}
+
+	var status vo.MembershipStatus
+	if input.Status != "" {
+		status, err = vo.NewMembershipStatus(input.Status)
+		if err != nil {
+			u.logger.Info("invalid membership status provided", "status", input.Status)
+			return nil, err
+		}
+	}
+
+	catalog, err := u.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := u.resolveRoles(ctx, catalog, store, input.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	membership, err := u.memberships.Find(ctx, member, store)
+	if err != nil {
+		u.logger.Error("failed to find store membership", err, "userID", member, "storeID", store)
+		return nil, err
+	}
+
+	if membership == nil {
+		u.logger.Info("store membership not found", "userID", member, "storeID", store)
+		return nil, entity.ErrMembershipNotFound
+	}
+
+	if err := u.checkEscalation(ctx, catalog, actorID, store, membership.Roles(), roles); err != nil {
+		return nil, err
+	}
+
+	previousRoles, previousStatus := membership.Roles(), membership.Status()
+
+	now := time.Now()
+	if err := membership.ReplaceRoles(roles, now); err != nil {
+		return nil, err
+	}
+
+	if status != "" {
+		membership.ChangeStatus(status, now)
+	}
+
+	if err := u.memberships.Update(ctx, membership); err != nil {
+		u.logger.Error("failed to update store membership", err, "userID", member, "storeID", store)
+		return nil, err
+	}
+
+	// the store never changes, it is recorded so the event says which one
+	event := actorAuditEvent(ctx, vo.AuditMemberUpdated, actorID, member).
+		WithChange("store_id", store, store).
+		WithChange("roles", previousRoles, membership.Roles())
+	if membership.Status() != previousStatus {
+		event.WithChange("status", previousStatus, membership.Status())
+	}
+	auditlog.Record(ctx, u.audit, u.logger, event)
+
+	u.logger.Info("store member updated successfully", "userID", member, "storeID", store, "roles", roles, "status", membership.Status(), "actorID", actorID)
+	info := toStoreMemberInfo(membership)
+	return &info, nil
+}
+
+type removeStoreMemberUseCase struct {
+	storeMembers
+	audit ports.AuditEventRepository
+}
+
+func NewRemoveStoreMemberUseCase(
+	memberships ports.StoreMembershipRepository,
+	userRepo ports.UserRepository,
+	roles ports.RoleCatalogProvider,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) admin.RemoveStoreMemberUseCase {
+	return &removeStoreMemberUseCase{
+		storeMembers: storeMembers{
+			memberships: memberships,
+			userRepo:    userRepo,
+			roles:       roles,
+			logger:      logger,
+		},
+		audit: audit,
+	}
+}
+
+func (u *removeStoreMemberUseCase) Execute(ctx context.Context, actorID uuid.UUID, storeID string, userID string) error {
+	u.logger.Debug("starting store member removal", "storeID", storeID, "userID", userID, "actorID", actorID)
+
+	store, member, err := parseMembershipKey(storeID, userID)
+	if err != nil {
+		u.logger.Info("invalid membership key provided", "storeID", storeID, "userID", userID)
+		return err
+	}
+
+	membership, err := u.memberships.Find(ctx, member, store)
+	if err != nil {
+		u.logger.Error("failed to find store membership", err, "userID", member, "storeID", store)
+		return err
+	}
+
+	if membership == nil {
+		u.logger.Info("store membership not found", "userID", member, "storeID", store)
+		return entity.ErrMembershipNotFound
+	}
+
+	catalog, err := u.catalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := u.checkEscalation(ctx, catalog, actorID, store, membership.Roles(), nil); err != nil {
+		return err
+	}
+
+	if err := u.memberships.Delete(ctx, member, store); err != nil {
+		u.logger.Error("failed to delete store membership", err, "userID", member, "storeID", store)
+		return err
+	}
+
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditMemberRemoved, actorID, member).
+		WithChange("store_id", store, nil).
+		WithChange("roles", membership.Roles(), nil))
+
+	u.logger.Info("store member removed successfully", "userID", member, "storeID", store, "actorID", actorID)
+	return nil
+}
+
+func parseStoreID(value string) (uuid.UUID, error) {
+	storeID, err := uuid.Parse(value)
+	if err != nil || storeID == uuid.Nil {
+		return uuid.Nil, entity.ErrMembershipStoreRequired
+	}
+
+	return storeID, nil
+}
+
+func parseMembershipKey(storeID, userID string) (uuid.UUID, uuid.UUID, error) {
+	store, err := parseStoreID(storeID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	member, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, entity.ErrMembershipNotFound
+	}
+
+	return store, member, nil
+}
+
+func toStoreMemberInfo(membership *entity.StoreMembership) dto.StoreMemberInfo {
+	roles := make([]string, 0, len(membership.Roles()))
+	for _, role := range membership.Roles() {
+		roles = append(roles, role.String())
+	}
+
+	return dto.StoreMemberInfo{
+		UserID:    membership.UserID(),
+		StoreID:   membership.StoreID(),
+		Roles:     roles,
+		Status:    membership.Status().String(),
+		CreatedAt: membership.CreatedAt(),
+		UpdatedAt: membership.UpdatedAt(),
+	}
+}
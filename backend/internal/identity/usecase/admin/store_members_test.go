@@ -0,0 +1,304 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddStoreMemberUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	otherStore := uuid.New()
+	adminID := uuid.New()
+	managerID := uuid.New()
+	userID := uuid.New()
+	cashier, _ := entity.NewRole("CASHIER", storeID, "", []vo.Permission{vo.PermOrdersRead}, time.Now())
+	foreign, _ := entity.NewRole("BAKER", otherStore, "", []vo.Permission{vo.PermOrdersRead}, time.Now())
+	catalog := vo.SystemRoleCatalog().
+		With("CASHIER", []vo.Permission{vo.PermOrdersRead}).
+		With("BAKER", []vo.Permission{vo.PermOrdersRead})
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+
+	tests := []struct {
+		name    string
+		actorID uuid.UUID
+		storeID string
+		roles   []string
+		setup   func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository)
+		wantErr error
+	}{
+		{
+			name:    "Success - Store Manager Adds Cashier",
+			actorID: managerID,
+			storeID: storeID.String(),
+			roles:   []string{"cashier"},
+			setup: func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {
+				r.On("FindByName", ctx, vo.Role("CASHIER")).Return(cashier, nil)
+				u.On("FindByID", ctx, userID).Return(setupRoleActor(userID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, userID, storeID).Return(nil, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				m.On("Save", ctx, mock.MatchedBy(func(ms *entity.StoreMembership) bool {
+					return ms.UserID() == userID && ms.StoreID() == storeID && ms.IsActive() && len(ms.Roles()) == 1
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "Escalation - Manager Of Another Store",
+			actorID: managerID,
+			storeID: otherStore.String(),
+			roles:   []string{"EMPLOYEE"},
+			setup: func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {
+				u.On("FindByID", ctx, userID).Return(setupRoleActor(userID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, userID, otherStore).Return(nil, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, otherStore).Return(nil, nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Escalation - Role Beyond Actor",
+			actorID: managerID,
+			storeID: storeID.String(),
+			roles:   []string{"ADMIN"},
+			setup: func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {
+				u.On("FindByID", ctx, userID).Return(setupRoleActor(userID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, userID, storeID).Return(nil, nil)
+				u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+		{
+			name:    "Custom Role Of Another Store",
+			actorID: adminID,
+			storeID: storeID.String(),
+			roles:   []string{"BAKER"},
+			setup: func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {
+				r.On("FindByName", ctx, vo.Role("BAKER")).Return(foreign, nil)
+			},
+			wantErr: entity.ErrRoleNotInStore,
+		},
+		{
+			name:    "Already A Member",
+			actorID: adminID,
+			storeID: storeID.String(),
+			roles:   []string{"EMPLOYEE"},
+			setup: func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {
+				u.On("FindByID", ctx, userID).Return(setupRoleActor(userID, vo.EmployeeRole), nil)
+				m.On("Find", ctx, userID, storeID).Return(storeManager, nil)
+			},
+			wantErr: entity.ErrMembershipAlreadyExists,
+		},
+		{
+			name:    "User Not Found",
+			actorID: adminID,
+			storeID: storeID.String(),
+			roles:   []string{"EMPLOYEE"},
+			setup: func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {
+				u.On("FindByID", ctx, userID).Return(nil, nil)
+			},
+			wantErr: entity.ErrUserNotFound,
+		},
+		{
+			name:    "Unknown Role",
+			actorID: adminID,
+			storeID: storeID.String(),
+			roles:   []string{"GHOST"},
+			setup:   func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {},
+			wantErr: vo.ErrInvalidRole,
+		},
+		{
+			name:    "Invalid Store ID",
+			actorID: adminID,
+			storeID: "not-a-uuid",
+			roles:   []string{"EMPLOYEE"},
+			setup:   func(m *MockStoreMembershipRepository, u *MockUserRepository, r *MockRoleRepository) {},
+			wantErr: entity.ErrMembershipStoreRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := new(MockStoreMembershipRepository)
+			u := new(MockUserRepository)
+			r := new(MockRoleRepository)
+			tt.setup(m, u, r)
+			audit := newMockAuditLog()
+
+			uc := NewAddStoreMemberUseCase(m, u, r, newStaticRoleCatalog(catalog), audit, new(MockLogger))
+			info, err := uc.Execute(ctx, tt.actorID, tt.storeID, dto.AddStoreMemberInput{UserID: userID, Roles: tt.roles})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				m.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, vo.MembershipActive.String(), info.Status)
+				assert.Equal(t, []vo.AuditAction{vo.AuditMemberAdded}, audit.recordedActions())
+			}
+
+			m.AssertExpectations(t)
+			u.AssertExpectations(t)
+			r.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateStoreMemberUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	adminID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("Suspends Member", func(t *testing.T) {
+		membership, _ := entity.NewStoreMembership(userID, storeID, []vo.Role{vo.EmployeeRole}, time.Now())
+		m := new(MockStoreMembershipRepository)
+		u := new(MockUserRepository)
+		m.On("Find", ctx, userID, storeID).Return(membership, nil)
+		u.On("FindByID", ctx, adminID).Return(setupRoleActor(adminID, vo.AdminRole), nil)
+		m.On("Find", ctx, adminID, storeID).Return(nil, nil)
+		m.On("Update", ctx, mock.MatchedBy(func(ms *entity.StoreMembership) bool {
+			return !ms.IsActive() && ms.Roles()[0] == vo.ManagerRole
+		})).Return(nil)
+
+		uc := NewUpdateStoreMemberUseCase(m, u, new(MockRoleRepository), newStaticRoleCatalog(vo.SystemRoleCatalog()), newMockAuditLog(), new(MockLogger))
+		info, err := uc.Execute(ctx, adminID, storeID.String(), userID.String(), dto.UpdateStoreMemberInput{Roles: []string{"MANAGER"}, Status: "suspended"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, vo.MembershipSuspended.String(), info.Status)
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Membership Not Found", func(t *testing.T) {
+		m := new(MockStoreMembershipRepository)
+		m.On("Find", ctx, userID, storeID).Return(nil, nil)
+
+		uc := NewUpdateStoreMemberUseCase(m, new(MockUserRepository), new(MockRoleRepository), newStaticRoleCatalog(vo.SystemRoleCatalog()), newMockAuditLog(), new(MockLogger))
+		_, err := uc.Execute(ctx, adminID, storeID.String(), userID.String(), dto.UpdateStoreMemberInput{Roles: []string{"EMPLOYEE"}})
+
+		assert.ErrorIs(t, err, entity.ErrMembershipNotFound)
+	})
+
+	t.Run("Invalid Status", func(t *testing.T) {
+		uc := NewUpdateStoreMemberUseCase(new(MockStoreMembershipRepository), new(MockUserRepository), new(MockRoleRepository), newStaticRoleCatalog(vo.SystemRoleCatalog()), newMockAuditLog(), new(MockLogger))
+		_, err := uc.Execute(ctx, adminID, storeID.String(), userID.String(), dto.UpdateStoreMemberInput{Roles: []string{"EMPLOYEE"}, Status: "fired"})
+
+		assert.ErrorIs(t, err, vo.ErrInvalidMembershipStatus)
+	})
+}
+
+func TestRemoveStoreMemberUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	managerID := uuid.New()
+	userID := uuid.New()
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+
+	t.Run("Success", func(t *testing.T) {
+		membership, _ := entity.NewStoreMembership(userID, storeID, []vo.Role{vo.EmployeeRole}, time.Now())
+		m := new(MockStoreMembershipRepository)
+		u := new(MockUserRepository)
+		m.On("Find", ctx, userID, storeID).Return(membership, nil)
+		u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+		m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+		m.On("Delete", ctx, userID, storeID).Return(nil)
+		audit := newMockAuditLog()
+
+		uc := NewRemoveStoreMemberUseCase(m, u, newStaticRoleCatalog(vo.SystemRoleCatalog()), audit, new(MockLogger))
+		err := uc.Execute(ctx, managerID, storeID.String(), userID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []vo.AuditAction{vo.AuditMemberRemoved}, audit.recordedActions())
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Escalation - Removing A Higher Member", func(t *testing.T) {
+		membership, _ := entity.NewStoreMembership(userID, storeID, []vo.Role{vo.AdminRole}, time.Now())
+		m := new(MockStoreMembershipRepository)
+		u := new(MockUserRepository)
+		m.On("Find", ctx, userID, storeID).Return(membership, nil)
+		u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+		m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+
+		uc := NewRemoveStoreMemberUseCase(m, u, newStaticRoleCatalog(vo.SystemRoleCatalog()), newMockAuditLog(), new(MockLogger))
+		err := uc.Execute(ctx, managerID, storeID.String(), userID.String())
+
+		assert.ErrorIs(t, err, entity.ErrPrivilegeEscalation)
+		m.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Membership Not Found", func(t *testing.T) {
+		m := new(MockStoreMembershipRepository)
+		m.On("Find", ctx, userID, storeID).Return(nil, nil)
+
+		uc := NewRemoveStoreMemberUseCase(m, new(MockUserRepository), newStaticRoleCatalog(vo.SystemRoleCatalog()), newMockAuditLog(), new(MockLogger))
+		err := uc.Execute(ctx, managerID, storeID.String(), userID.String())
+
+		assert.ErrorIs(t, err, entity.ErrMembershipNotFound)
+	})
+}
+
+func TestListStoreMembersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	otherStore := uuid.New()
+	managerID := uuid.New()
+	storeManager, _ := entity.NewStoreMembership(managerID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+
+	tests := []struct {
+		name    string
+		storeID uuid.UUID
+		setup   func(m *MockStoreMembershipRepository)
+		wantErr error
+	}{
+		{
+			name:    "Success - Store Manager",
+			storeID: storeID,
+			setup: func(m *MockStoreMembershipRepository) {
+				m.On("Find", ctx, managerID, storeID).Return(storeManager, nil)
+				m.On("ListByStore", ctx, storeID).Return([]*entity.StoreMembership{storeManager}, nil)
+			},
+		},
+		{
+			name:    "Escalation - Manager Of Another Store",
+			storeID: otherStore,
+			setup: func(m *MockStoreMembershipRepository) {
+				m.On("Find", ctx, managerID, otherStore).Return(nil, nil)
+			},
+			wantErr: entity.ErrPrivilegeEscalation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := new(MockStoreMembershipRepository)
+			u := new(MockUserRepository)
+			u.On("FindByID", ctx, managerID).Return(setupRoleActor(managerID, vo.EmployeeRole), nil)
+			tt.setup(m)
+
+			uc := NewListStoreMembersUseCase(m, u, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger))
+			members, err := uc.Execute(ctx, managerID, tt.storeID.String())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, members)
+				m.AssertNotCalled(t, "ListByStore", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, members, 1)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
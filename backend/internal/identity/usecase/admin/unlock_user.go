@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/admin"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type unlockUserUseCase struct {
+	userRepo ports.UserRepository
+	roles    ports.RoleCatalogProvider
+	audit    ports.AuditEventRepository
+	logger   ports.Logger
+}
+
+func NewUnlockUserUseCase(userRepo ports.UserRepository, roles ports.RoleCatalogProvider, audit ports.AuditEventRepository, logger ports.Logger) admin.UnlockUserUseCase {
+	return &unlockUserUseCase{
+		userRepo: userRepo,
+		roles:    roles,
+		audit:    audit,
+		logger:   logger,
+	}
+}
+
+// Execute releases a locked account before its lockout expires and clears the
+// failed attempts, so the next wrong password does not lock it again at once.
+func (u *unlockUserUseCase) Execute(ctx context.Context, actorID uuid.UUID, id string) error {
+	u.logger.Debug("starting user unlock", "actorID", actorID, "userID", id)
+
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		u.logger.Error("failed to parse user ID", err, "id", id)
+		return err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		u.logger.Error("failed to find user", err, "userID", userID)
+		return err
+	}
+
+	if user == nil {
+		u.logger.Info("user not found for unlock", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	if err := checkReach(ctx, u.userRepo, u.roles, u.logger, actorID, user); err != nil {
+		return err
+	}
+
+	attempts, lockedUntil := user.FailedAttempts(), user.LockedUntil()
+	user.ResetFailedAttempts()
+
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		u.logger.Error("failed to unlock user", err, "userID", userID)
+		return err
+	}
+
+	auditlog.Record(ctx, u.audit, u.logger, actorAuditEvent(ctx, vo.AuditAccountUnlocked, actorID, userID).
+		WithChange("failed_attempts", attempts, 0).
+		WithChange("locked_until", lockedUntil, nil))
+
+	u.logger.Info("user unlocked successfully", "userID", userID, "actorID", actorID)
+	return nil
+}
@@ -0,0 +1,105 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnlockUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	actorID := uuid.New()
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	lockedUntil := time.Now().Add(time.Hour)
+
+	setupLockedUser := func() *entity.User {
+		user, _ := entity.RestoreUser(userID, "test@example.com", "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 5, &lockedUntil, true, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		setup   func(ur *MockUserRepository)
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "Success",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository) {
+				ur.On("FindByID", ctx, userID).Return(setupLockedUser(), nil)
+				ur.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
+					return u.FailedAttempts() == 0 && u.LockedUntil() == nil && !u.IsLocked(time.Now())
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Escalation - Target Above Actor",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository) {
+				target, _ := entity.RestoreUser(userID, "admin@example.com", "admin", password.String(), []string{vo.AdminRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+				ur.On("FindByID", ctx, userID).Return(target, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrPrivilegeEscalation,
+		},
+		{
+			name: "User Not Found",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository) {
+				ur.On("FindByID", ctx, userID).Return(nil, nil)
+			},
+			wantErr: true,
+			err:     entity.ErrUserNotFound,
+		},
+		{
+			name:    "Invalid UUID",
+			id:      "invalid-uuid",
+			setup:   func(ur *MockUserRepository) {},
+			wantErr: true,
+		},
+		{
+			name: "Update Error",
+			id:   userID.String(),
+			setup: func(ur *MockUserRepository) {
+				ur.On("FindByID", ctx, userID).Return(setupLockedUser(), nil)
+				ur.On("Update", ctx, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: true,
+			err:     assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			audit := newMockAuditLog()
+			tt.setup(ur)
+			ur.On("FindByID", ctx, actorID).Return(setupRoleActor(actorID, vo.ManagerRole), nil).Maybe()
+
+			uc := NewUnlockUserUseCase(ur, newStaticRoleCatalog(vo.SystemRoleCatalog()), audit, new(MockLogger))
+			err := uc.Execute(ctx, actorID, tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditAccountUnlocked}, audit.recordedActions())
+			}
+
+			ur.AssertExpectations(t)
+		})
+	}
+}
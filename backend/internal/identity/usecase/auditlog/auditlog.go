@@ -0,0 +1,31 @@
+// Package auditlog holds what the use cases of every area share to write the
+// audit log.
+package auditlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+)
+
+// Record appends an event once the change it describes is saved. A failure is
+// logged and the change stands: it is already persisted, and a login must not
+// fail because the audit log is unavailable.
+func Record(ctx context.Context, audit ports.AuditEventRepository, logger ports.Logger, event *entity.AuditEvent) {
+	if err := audit.Append(ctx, event); err != nil {
+		logger.Error("failed to append audit event", err, "action", event.Action())
+	}
+}
+
+// Event describes an action taken by actorID on targetID, with the client and
+// any impersonator taken from the request context. Either ID may be nil.
+func Event(ctx context.Context, action vo.AuditAction, actorID, targetID *uuid.UUID) *entity.AuditEvent {
+	client := dto.ClientInfoFromContext(ctx)
+	return entity.NewAuditEvent(action, actorID, targetID, client.IPAddress, client.UserAgent, time.Now()).
+		WithImpersonator(dto.ImpersonatorFromContext(ctx))
+}
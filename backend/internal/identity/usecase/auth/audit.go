@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+// userAuditEvent describes an action on user's account. Logins get the client
+// as an argument rather than from the request context, so it is passed on
+// explicitly; any impersonator still comes from the context.
+func userAuditEvent(ctx context.Context, action vo.AuditAction, actorID *uuid.UUID, user *entity.User, client dto.ClientInfo) *entity.AuditEvent {
+	targetID := user.ID()
+	return auditlog.Event(dto.WithClientInfo(ctx, client), action, actorID, &targetID)
+}
+
+// recordFailedLogin audits a wrong password or code for a known user, and
+// the lockout when this attempt triggered one. The attempt was not
+// authenticated, so neither event has an actor.
+func recordFailedLogin(ctx context.Context, audit ports.AuditEventRepository, logger ports.Logger, user *entity.User, attemptsBefore int, locked bool, client dto.ClientInfo) {
+	auditlog.Record(ctx, audit, logger, userAuditEvent(ctx, vo.AuditLoginFailed, nil, user, client).
+		WithChange("failed_attempts", attemptsBefore, user.FailedAttempts()))
+
+	if locked {
+		auditlog.Record(ctx, audit, logger, userAuditEvent(ctx, vo.AuditAccountLocked, nil, user, client).
+			WithChange("locked_until", nil, user.LockedUntil()))
+	}
+}
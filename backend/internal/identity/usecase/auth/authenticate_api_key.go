@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+)
+
+type authenticateAPIKeyUseCase struct {
+	apiKeyRepo         ports.APIKeyRepository
+	userRepo           ports.UserRepository
+	roles              ports.RoleCatalogProvider
+	logger             ports.Logger
+	lastUsedResolution time.Duration
+}
+
+// NewAuthenticateAPIKey builds the API key check used by the auth middleware.
+// lastUsedResolution bounds how often a busy key has its last use written.
+func NewAuthenticateAPIKey(
+	apiKeyRepo ports.APIKeyRepository,
+	userRepo ports.UserRepository,
+	roles ports.RoleCatalogProvider,
+	logger ports.Logger,
+	lastUsedResolution time.Duration,
+) security.AuthenticateAPIKeyUseCase {
+	return &authenticateAPIKeyUseCase{
+		apiKeyRepo:         apiKeyRepo,
+		userRepo:           userRepo,
+		roles:              roles,
+		logger:             logger,
+		lastUsedResolution: lastUsedResolution,
+	}
+}
+
+func (uc *authenticateAPIKeyUseCase) Execute(ctx context.Context, rawKey string) (*dto.APIKeyClaims, error) {
+	token, err := vo.NewAPIKeyToken(rawKey)
+	if err != nil {
+		return nil, entity.ErrInvalidAPIKey
+	}
+
+	key, err := uc.apiKeyRepo.FindByHash(ctx, token.Hash())
+	if err != nil {
+		uc.logger.Error("failed to find api key", err, "prefix", token.Prefix())
+		return nil, fmt.Errorf("finding api key: %w", err)
+	}
+
+	now := time.Now()
+
+	if key == nil || !key.IsUsable(now) {
+		uc.logger.Info("api key rejected", "prefix", token.Prefix())
+		return nil, entity.ErrInvalidAPIKey
+	}
+
+	if err := uc.checkCreator(ctx, key); err != nil {
+		return nil, err
+	}
+
+	// recording the use is best effort: failing to write it must not reject
+	// an otherwise valid request
+	if key.MarkUsed(now, uc.lastUsedResolution) {
+		if err := uc.apiKeyRepo.UpdateLastUsedAt(ctx, key); err != nil {
+			uc.logger.Error("failed to record api key use", err, "keyID", key.ID())
+		}
+	}
+
+	return &dto.APIKeyClaims{
+		KeyID:   key.ID(),
+		Name:    key.Name(),
+		StoreID: key.StoreID(),
+		Scopes:  key.Scopes(),
+	}, nil
+}
+
+// checkCreator rejects the keys of an admin who was deactivated, deleted or
+// no longer holds the permission to manage keys, so taking that access away
+// from someone also stops the integrations they set up.
+func (uc *authenticateAPIKeyUseCase) checkCreator(ctx context.Context, key *entity.APIKey) error {
+	creator, err := uc.userRepo.FindByID(ctx, key.CreatedBy())
+	if err != nil {
+		uc.logger.Error("failed to find api key creator", err, "keyID", key.ID())
+		return fmt.Errorf("finding api key creator: %w", err)
+	}
+
+	if creator == nil || !creator.IsActive() || creator.IsDeleted() {
+		uc.logger.Info("api key of inactive creator rejected", "keyID", key.ID(), "createdBy", key.CreatedBy())
+		return entity.ErrInvalidAPIKey
+	}
+
+	catalog, err := uc.roles.Catalog(ctx)
+	if err != nil {
+		uc.logger.Error("failed to load role catalog", err)
+		return err
+	}
+
+	if !catalog.GrantsOf(creator.Roles()...).Has(vo.PermAPIKeysManage) {
+		uc.logger.Info("api key of demoted creator rejected", "keyID", key.ID(), "createdBy", key.CreatedBy())
+		return entity.ErrInvalidAPIKey
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthenticateAPIKeyUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	storeID := uuid.New()
+	creatorID := uuid.New()
+	token, _ := vo.GenerateAPIKeyToken()
+
+	setupCreator := func(role string, active bool) *entity.User {
+		creator, _ := entity.RestoreUser(creatorID, "admin@example.com", "admin", "hash", []string{role}, active, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+		return creator
+	}
+
+	setupKey := func() *entity.APIKey {
+		key, _ := entity.NewAPIKey("erp sync", storeID, []vo.APIKeyScope{vo.ScopeOrdersRead}, token, creatorID, nil, time.Now())
+		return key
+	}
+
+	recentlyUsed := func() *entity.APIKey {
+		key := setupKey()
+		key.MarkUsed(time.Now(), time.Minute)
+		return key
+	}
+
+	revoked := func() *entity.APIKey {
+		key := setupKey()
+		_ = key.Revoke(time.Now())
+		return key
+	}
+
+	expired := func() *entity.APIKey {
+		expiresAt := time.Now().Add(-time.Minute)
+		key, _ := entity.RestoreAPIKey(uuid.New(), "old", storeID, []string{"orders:read"}, token.Prefix(), token.Hash(), creatorID, time.Now().Add(-time.Hour), &expiresAt, nil, nil)
+		return key
+	}
+
+	tests := []struct {
+		name    string
+		rawKey  string
+		setup   func(m *MockAPIKeyRepository, u *MockUserRepository)
+		wantErr error
+	}{
+		{
+			name:   "Success - Records first use",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(setupKey(), nil)
+				m.On("UpdateLastUsedAt", ctx, mock.MatchedBy(func(k *entity.APIKey) bool {
+					return k.LastUsedAt() != nil
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "Success - Recent use is not written again",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(recentlyUsed(), nil)
+			},
+		},
+		{
+			name:   "Success - Last use write error is ignored",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(setupKey(), nil)
+				m.On("UpdateLastUsedAt", ctx, mock.Anything).Return(assert.AnError)
+			},
+		},
+		{
+			name:    "Malformed Key",
+			rawKey:  "not-a-key",
+			setup:   func(m *MockAPIKeyRepository, u *MockUserRepository) {},
+			wantErr: entity.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Unknown Key",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(nil, nil)
+			},
+			wantErr: entity.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Revoked Key",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(revoked(), nil)
+			},
+			wantErr: entity.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Expired Key",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(expired(), nil)
+			},
+			wantErr: entity.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Deactivated Creator",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(setupKey(), nil)
+				u.On("FindByID", ctx, creatorID).Return(setupCreator("ADMIN", false), nil)
+			},
+			wantErr: entity.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Demoted Creator",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(setupKey(), nil)
+				u.On("FindByID", ctx, creatorID).Return(setupCreator("EMPLOYEE", true), nil)
+			},
+			wantErr: entity.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Missing Creator",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(setupKey(), nil)
+				u.On("FindByID", ctx, creatorID).Return(nil, nil)
+			},
+			wantErr: entity.ErrInvalidAPIKey,
+		},
+		{
+			name:   "Repository Error",
+			rawKey: token.String(),
+			setup: func(m *MockAPIKeyRepository, u *MockUserRepository) {
+				m.On("FindByHash", ctx, token.Hash()).Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAPIKeyRepository)
+			mockUser := new(MockUserRepository)
+			tt.setup(mockRepo, mockUser)
+			mockUser.On("FindByID", ctx, creatorID).Return(setupCreator("ADMIN", true), nil).Maybe()
+
+			uc := NewAuthenticateAPIKey(mockRepo, mockUser, newStaticRoleCatalog(vo.SystemRoleCatalog()), new(MockLogger), time.Minute)
+			claims, err := uc.Execute(ctx, tt.rawKey)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, claims)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, storeID, claims.StoreID)
+				assert.True(t, claims.HasScope(vo.ScopeOrdersRead))
+				assert.False(t, claims.HasScope(vo.ScopeOrdersWrite))
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
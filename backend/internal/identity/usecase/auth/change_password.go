@@ -4,28 +4,45 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
 	"github.com/google/uuid"
 )
 
 type changePasswordUseCase struct {
 	userRepo ports.UserRepository
+	audit    ports.AuditEventRepository
 	logger   ports.Logger
-	pepper   string
+	peppers  vo.Peppers
+	policy   vo.PasswordPolicy
+	history  passwordHistory
 }
 
 func NewChangePassword(
 	userRepo ports.UserRepository,
+	audit ports.AuditEventRepository,
 	logger ports.Logger,
-	pepper string,
+	peppers vo.Peppers,
+	policy vo.PasswordPolicy,
+	history ports.PasswordHistoryRepository,
+	storePolicies ports.StorePasswordPolicyRepository,
+	defaultHistorySize int,
 ) security.ChangePasswordUseCase {
 	return &changePasswordUseCase{
 		userRepo: userRepo,
+		audit:    audit,
 		logger:   logger,
-		pepper:   pepper,
+		peppers:  peppers,
+		policy:   policy,
+		history: passwordHistory{
+			history:     history,
+			policies:    storePolicies,
+			defaultSize: defaultHistorySize,
+		},
 	}
 }
 
@@ -42,23 +59,60 @@ func (uc *changePasswordUseCase) Execute(ctx context.Context, userID uuid.UUID,
 		return entity.ErrUserNotFound
 	}
 
-	if !user.Password().Matches(oldPassword, uc.pepper) {
+	// accounts created without a password, e.g. by an import, set their first
+	// one from the session opened with their login link
+	settingFirst := !user.Password().IsUsable()
+
+	if !settingFirst && !user.Password().Matches(oldPassword, uc.peppers) {
 		uc.logger.Info("invalid old password provided", "userID", userID)
 		return entity.ErrInvalidOldPassword
 	}
 
-	newPasswordVO, err := vo.NewPassword(newPassword, uc.pepper)
+	historySize, err := uc.history.size(ctx, user)
+	if err != nil {
+		uc.logger.Error("failed to resolve password history size", err, "userID", userID)
+		return err
+	}
+
+	previous, err := uc.history.previous(ctx, user, historySize)
+	if err != nil {
+		uc.logger.Error("failed to load password history", err, "userID", userID)
+		return err
+	}
+
+	candidate := vo.PasswordCandidate{
+		PlainText: newPassword,
+		Username:  user.Username(),
+		Email:     user.Email(),
+		Previous:  previous,
+	}
+	if err := uc.policy.Check(candidate); err != nil {
+		uc.logger.Info("new password rejected by policy", "userID", userID, "reason", err)
+		return err
+	}
+
+	newPasswordVO, err := vo.NewPassword(newPassword, uc.peppers)
 	if err != nil {
 		uc.logger.Error("failed to create new password VO", err, "userID", userID)
 		return fmt.Errorf("creating new password VO: %w", err)
 	}
 
+	if !settingFirst {
+		if err := uc.history.record(ctx, user, historySize); err != nil {
+			uc.logger.Error("failed to record password history", err, "userID", userID)
+			return err
+		}
+	}
+
 	user.ChangePassword(newPasswordVO)
+	user.RevokeSessions()
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		uc.logger.Error("failed to update user password in repository", err, "userID", userID)
 		return fmt.Errorf("updating user password: %w", err)
 	}
 
+	auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditPasswordChanged, &userID, user, dto.ClientInfoFromContext(ctx)))
+
 	uc.logger.Info("password changed successfully", "userID", userID)
 	return nil
 }
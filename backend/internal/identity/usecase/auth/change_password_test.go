@@ -11,9 +11,10 @@ import (
 )
 
 func TestChangePasswordUseCase_Execute(t *testing.T) {
-	pepper := "pepper"
+	pepper := vo.SinglePepper("pepper")
 	oldPass := "OldPass123!"
 	newPass := "NewPass123!"
+	policy := vo.DefaultPasswordPolicy(stubPasswordCorpus{"Password1!": true}, pepper)
 
 	tests := []struct {
 		name        string
@@ -29,7 +30,7 @@ func TestChangePasswordUseCase_Execute(t *testing.T) {
 			setup: func(mr *MockUserRepository, user *entity.User) {
 				mr.On("FindByID", mock.Anything, user.ID()).Return(user, nil)
 				mr.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
-					return u.Password().Matches(newPass, pepper)
+					return u.Password().Matches(newPass, pepper) && u.TokenVersion() == 1
 				})).Return(nil)
 			},
 			wantErr: nil,
@@ -52,6 +53,24 @@ func TestChangePasswordUseCase_Execute(t *testing.T) {
 			},
 			wantErr: vo.ErrPasswordTooShort,
 		},
+		{
+			name:        "New Password Breached",
+			oldPassword: oldPass,
+			newPassword: "Password1!",
+			setup: func(mr *MockUserRepository, user *entity.User) {
+				mr.On("FindByID", mock.Anything, user.ID()).Return(user, nil)
+			},
+			wantErr: vo.ErrBreachedPassword,
+		},
+		{
+			name:        "New Password Same As Current",
+			oldPassword: oldPass,
+			newPassword: oldPass,
+			setup: func(mr *MockUserRepository, user *entity.User) {
+				mr.On("FindByID", mock.Anything, user.ID()).Return(user, nil)
+			},
+			wantErr: vo.ErrPasswordReused,
+		},
 		{
 			name:        "FindByID Error",
 			oldPassword: oldPass,
@@ -86,6 +105,11 @@ func TestChangePasswordUseCase_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockUserRepository)
 			mockLogger := new(MockLogger)
+			mockHistory := new(MockPasswordHistoryRepository)
+			mockPolicies := new(MockStorePasswordPolicyRepository)
+			mockPolicies.On("MaxHistorySizeForUser", mock.Anything, mock.Anything).Return(0, nil).Maybe()
+			mockHistory.On("ListRecent", mock.Anything, mock.Anything, 0).Return(nil, nil).Maybe()
+			mockHistory.On("Add", mock.Anything, mock.Anything, mock.Anything, 0).Return(nil).Maybe()
 
 			email, _ := vo.NewEmail("t@t.com")
 			hashedOld, _ := vo.NewPassword(oldPass, pepper)
@@ -93,7 +117,7 @@ func TestChangePasswordUseCase_Execute(t *testing.T) {
 
 			tt.setup(mockRepo, user)
 
-			uc := NewChangePassword(mockRepo, mockLogger, pepper)
+			uc := NewChangePassword(mockRepo, newMockAuditLog(), mockLogger, pepper, policy, mockHistory, mockPolicies, 1)
 			err := uc.Execute(context.Background(), user.ID(), tt.oldPassword, tt.newPassword)
 
 			if tt.wantErr != nil {
@@ -106,3 +130,114 @@ func TestChangePasswordUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestChangePasswordUseCase_PasswordHistory(t *testing.T) {
+	pepper := vo.SinglePepper("pepper")
+	policy := vo.DefaultPasswordPolicy(stubPasswordCorpus{}, pepper)
+	current := "Current123!"
+	former := "Former123!"
+	formerHash, _ := vo.NewPassword(former, pepper)
+
+	tests := []struct {
+		name        string
+		storeSize   int
+		newPassword string
+		setup       func(*MockUserRepository, *MockPasswordHistoryRepository, *entity.User)
+		wantErr     error
+	}{
+		{
+			name:        "Former Password Within Store History",
+			storeSize:   3,
+			newPassword: former,
+			setup: func(ur *MockUserRepository, hr *MockPasswordHistoryRepository, user *entity.User) {
+				hr.On("ListRecent", mock.Anything, user.ID(), 2).Return([]vo.Password{formerHash}, nil)
+			},
+			wantErr: vo.ErrPasswordReused,
+		},
+		{
+			name:        "Default Wins Over Smaller Store History",
+			storeSize:   0,
+			newPassword: former,
+			setup: func(ur *MockUserRepository, hr *MockPasswordHistoryRepository, user *entity.User) {
+				hr.On("ListRecent", mock.Anything, user.ID(), 1).Return([]vo.Password{formerHash}, nil)
+			},
+			wantErr: vo.ErrPasswordReused,
+		},
+		{
+			name:        "Records Current Hash",
+			storeSize:   3,
+			newPassword: "Brand-New123!",
+			setup: func(ur *MockUserRepository, hr *MockPasswordHistoryRepository, user *entity.User) {
+				hr.On("ListRecent", mock.Anything, user.ID(), 2).Return([]vo.Password{formerHash}, nil)
+				hr.On("Add", mock.Anything, user.ID(), user.Password(), 2).Return(nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.Password().Matches("Brand-New123!", pepper)
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "History Failure Keeps Password",
+			storeSize:   3,
+			newPassword: "Brand-New123!",
+			setup: func(ur *MockUserRepository, hr *MockPasswordHistoryRepository, user *entity.User) {
+				hr.On("ListRecent", mock.Anything, user.ID(), 2).Return(nil, nil)
+				hr.On("Add", mock.Anything, user.ID(), user.Password(), 2).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			hr := new(MockPasswordHistoryRepository)
+			sp := new(MockStorePasswordPolicyRepository)
+
+			email, _ := vo.NewEmail("t@t.com")
+			hashed, _ := vo.NewPassword(current, pepper)
+			user, _ := entity.NewUser(email, "user", hashed, nil)
+
+			ur.On("FindByID", mock.Anything, user.ID()).Return(user, nil)
+			sp.On("MaxHistorySizeForUser", mock.Anything, user.ID()).Return(tt.storeSize, nil)
+			tt.setup(ur, hr, user)
+
+			uc := NewChangePassword(ur, newMockAuditLog(), new(MockLogger), pepper, policy, hr, sp, 2)
+			err := uc.Execute(context.Background(), user.ID(), current, tt.newPassword)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				ur.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			ur.AssertExpectations(t)
+			hr.AssertExpectations(t)
+			sp.AssertExpectations(t)
+		})
+	}
+}
+
+func TestChangePasswordUseCase_FirstPassword(t *testing.T) {
+	pepper := vo.SinglePepper("pepper")
+	policy := vo.DefaultPasswordPolicy(stubPasswordCorpus{}, pepper)
+	email, _ := vo.NewEmail("t@t.com")
+	user, _ := entity.NewUser(email, "user", vo.UnusablePassword, nil)
+
+	ur := new(MockUserRepository)
+	hr := new(MockPasswordHistoryRepository)
+	sp := new(MockStorePasswordPolicyRepository)
+	ur.On("FindByID", mock.Anything, user.ID()).Return(user, nil)
+	sp.On("MaxHistorySizeForUser", mock.Anything, user.ID()).Return(0, nil)
+	hr.On("ListRecent", mock.Anything, user.ID(), mock.Anything).Return(nil, nil)
+	ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+		return u.Password().Matches("Brand-New123!", pepper)
+	})).Return(nil)
+
+	uc := NewChangePassword(ur, newMockAuditLog(), new(MockLogger), pepper, policy, hr, sp, 1)
+	err := uc.Execute(context.Background(), user.ID(), "", "Brand-New123!")
+
+	assert.NoError(t, err)
+	ur.AssertExpectations(t)
+	hr.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/google/uuid"
+)
+
+const recoveryCodesAmount = 10
+
+type confirmMFAEnrollmentUseCase struct {
+	mfaRepo ports.MFARepository
+	logger  ports.Logger
+}
+
+func NewConfirmMFAEnrollment(mfaRepo ports.MFARepository, logger ports.Logger) security.ConfirmMFAEnrollmentUseCase {
+	return &confirmMFAEnrollmentUseCase{
+		mfaRepo: mfaRepo,
+		logger:  logger,
+	}
+}
+
+func (uc *confirmMFAEnrollmentUseCase) Execute(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	uc.logger.Debug("confirming mfa enrollment", "userID", userID)
+
+	mfa, err := uc.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to load mfa settings", err, "userID", userID)
+		return nil, fmt.Errorf("finding mfa settings: %w", err)
+	}
+
+	if mfa == nil {
+		return nil, entity.ErrMFANotEnrolled
+	}
+
+	if err := mfa.Confirm(code, time.Now()); err != nil {
+		uc.logger.Info("mfa enrollment not confirmed", "userID", userID, "reason", err.Error())
+		return nil, err
+	}
+
+	recoveryCodes, err := replaceRecoveryCodes(mfa)
+	if err != nil {
+		uc.logger.Error("failed to generate recovery codes", err, "userID", userID)
+		return nil, err
+	}
+
+	if err := uc.mfaRepo.Save(ctx, mfa); err != nil {
+		uc.logger.Error("failed to save confirmed mfa", err, "userID", userID)
+		return nil, fmt.Errorf("saving mfa settings: %w", err)
+	}
+
+	uc.logger.Info("mfa enabled", "userID", userID)
+	return recoveryCodes, nil
+}
+
+// replaceRecoveryCodes stores only the hashes on the entity; the plain codes
+// are returned so they can be shown to the user exactly once.
+func replaceRecoveryCodes(mfa *entity.UserMFA) ([]string, error) {
+	codes, err := vo.GenerateRecoveryCodes(recoveryCodesAmount)
+	if err != nil {
+		return nil, fmt.Errorf("generating recovery codes: %w", err)
+	}
+
+	mfa.ReplaceRecoveryCodes(codes)
+
+	plain := make([]string, 0, len(codes))
+	for _, code := range codes {
+		plain = append(plain, code.String())
+	}
+
+	return plain, nil
+}
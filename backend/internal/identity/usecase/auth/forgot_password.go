@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
@@ -15,6 +16,7 @@ type forgotPasswordUseCase struct {
 	notificationService ports.NotificationService
 	logger              ports.Logger
 	expiresIn           time.Duration
+	cooldown            time.Duration
 }
 
 func NewForgotPassword(
@@ -23,6 +25,7 @@ func NewForgotPassword(
 	notificationService ports.NotificationService,
 	logger ports.Logger,
 	expiresIn time.Duration,
+	cooldown time.Duration,
 ) security.ForgotPasswordUseCase {
 	return &forgotPasswordUseCase{
 		otpRepo:             otpRepo,
@@ -30,6 +33,7 @@ func NewForgotPassword(
 		notificationService: notificationService,
 		logger:              logger,
 		expiresIn:           expiresIn,
+		cooldown:            cooldown,
 	}
 }
 
@@ -42,6 +46,19 @@ func (uc *forgotPasswordUseCase) Execute(ctx context.Context, email string) erro
 		return err
 	}
 
+	// the cooldown is started before looking the user up, so known and
+	// unknown addresses behave the same way
+	started, err := uc.otpRepo.StartOTPCooldown(ctx, emailVO, uc.cooldown)
+	if err != nil {
+		uc.logger.Error("failed to start otp cooldown", err, "email", email)
+		return err
+	}
+
+	if !started {
+		uc.logger.Info("otp requested during cooldown", "email", email)
+		return entity.ErrOTPCooldown
+	}
+
 	user, err := uc.userRepo.FindByEmail(ctx, emailVO)
 	if err != nil {
 		uc.logger.Error("error finding user by email", err, "email", email)
@@ -27,6 +27,7 @@ func TestForgotPasswordUseCase_Execute(t *testing.T) {
 			name:  "Success",
 			email: emailStr,
 			setup: func(ur *MockUserRepository, or *MockOTPRepository, ns *MockNotificationService) {
+				or.On("StartOTPCooldown", mock.Anything, emailVO, time.Minute).Return(true, nil)
 				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
 				or.On("SaveOTP", mock.Anything, emailVO, mock.Anything, mock.Anything).Return(nil)
 				ns.On("SendForgotPasswordEmail", mock.Anything, emailVO, mock.Anything).Return(nil)
@@ -37,11 +38,21 @@ func TestForgotPasswordUseCase_Execute(t *testing.T) {
 			name:  "User Not Found",
 			email: emailStr,
 			setup: func(ur *MockUserRepository, or *MockOTPRepository, ns *MockNotificationService) {
+				or.On("StartOTPCooldown", mock.Anything, emailVO, time.Minute).Return(true, nil)
 				ur.On("FindByEmail", mock.Anything, emailVO).Return(nil, nil)
 			},
 			wantErr:   nil, // O use case atual retorna nil mesmo se nao encontrar (para evitar enumeraçao)
 			expectErr: false,
 		},
+		{
+			name:  "Cooldown Active",
+			email: emailStr,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, ns *MockNotificationService) {
+				or.On("StartOTPCooldown", mock.Anything, emailVO, time.Minute).Return(false, nil)
+			},
+			wantErr:   entity.ErrOTPCooldown,
+			expectErr: true,
+		},
 		{
 			name:    "Invalid Email",
 			email:   "invalid",
@@ -52,6 +63,7 @@ func TestForgotPasswordUseCase_Execute(t *testing.T) {
 			name:  "Save OTP Error",
 			email: emailStr,
 			setup: func(ur *MockUserRepository, or *MockOTPRepository, ns *MockNotificationService) {
+				or.On("StartOTPCooldown", mock.Anything, emailVO, time.Minute).Return(true, nil)
 				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
 				or.On("SaveOTP", mock.Anything, emailVO, mock.Anything, mock.Anything).Return(assert.AnError)
 			},
@@ -61,6 +73,7 @@ func TestForgotPasswordUseCase_Execute(t *testing.T) {
 			name:  "Send Email Error",
 			email: emailStr,
 			setup: func(ur *MockUserRepository, or *MockOTPRepository, ns *MockNotificationService) {
+				or.On("StartOTPCooldown", mock.Anything, emailVO, time.Minute).Return(true, nil)
 				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
 				or.On("SaveOTP", mock.Anything, emailVO, mock.Anything, mock.Anything).Return(nil)
 				ns.On("SendForgotPasswordEmail", mock.Anything, emailVO, mock.Anything).Return(assert.AnError)
@@ -78,7 +91,7 @@ func TestForgotPasswordUseCase_Execute(t *testing.T) {
 
 			tt.setup(ur, or, ns)
 
-			uc := NewForgotPassword(or, ur, ns, ml, time.Hour)
+			uc := NewForgotPassword(or, ur, ns, ml, time.Hour, time.Minute)
 			err := uc.Execute(context.Background(), tt.email)
 
 			if tt.expectErr {
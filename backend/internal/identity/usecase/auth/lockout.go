@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+)
+
+// lockoutNotifier tells users their account was locked, with a link that
+// lifts the lock. The owner learns someone is guessing their password and
+// does not have to wait the lockout out or call an admin.
+type lockoutNotifier struct {
+	notifier ports.NotificationService
+	tokens   security.VerificationTokenManager
+	logger   ports.Logger
+}
+
+// notify is best effort: the attempt already failed and a lost email must not
+// turn that into a different error.
+func (n lockoutNotifier) notify(ctx context.Context, user *entity.User) {
+	token, err := n.tokens.GenerateUnlockToken(user)
+	if err != nil {
+		n.logger.Error("failed to generate unlock token", err, "userID", user.ID())
+		return
+	}
+
+	if err := n.notifier.SendAccountLockedEmail(ctx, user.Email(), *user.LockedUntil(), token); err != nil {
+		n.logger.Error("failed to send account locked email", err, "userID", user.ID())
+		return
+	}
+
+	n.logger.Info("account locked notification sent", "userID", user.ID())
+}
@@ -10,42 +10,95 @@ import (
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+)
+
+// UnverifiedEmailPolicy decides what happens when a user whose email was
+// never verified tries to log in.
+type UnverifiedEmailPolicy int
+
+const (
+	// RestrictUnverifiedEmail lets the user in, but the access token carries
+	// email_verified=false so routes behind middleware.RequireVerifiedEmail
+	// stay closed until the address is confirmed.
+	RestrictUnverifiedEmail UnverifiedEmailPolicy = iota
+	// RejectUnverifiedEmail refuses the login with entity.ErrEmailNotVerified.
+	RejectUnverifiedEmail
 )
 
 type LoginUseCase struct {
-	userRepo     ports.UserRepository
-	tokenManager security.TokenManager
-	refreshRepo  ports.RefreshTokenRepository
-	logger       ports.Logger
-	pepper       string
-	baseDuration time.Duration
-	threshold    int
-	expiresIn    time.Duration
+	userRepo         ports.UserRepository
+	tokenManager     security.TokenManager
+	refreshRepo      ports.RefreshTokenRepository
+	audit            ports.AuditEventRepository
+	mfa              mfaGate
+	lockout          lockoutNotifier
+	logins           loginRecorder
+	logger           ports.Logger
+	peppers          vo.Peppers
+	baseDuration     time.Duration
+	threshold        int
+	expiresIn        time.Duration
+	unverifiedPolicy UnverifiedEmailPolicy
 }
 
 func NewLogin(
 	userRepo ports.UserRepository,
 	tokenManager security.TokenManager,
 	refreshRepo ports.RefreshTokenRepository,
+	mfaRepo ports.MFARepository,
+	mfaPolicyRepo ports.MFAPolicyRepository,
+	memberships ports.StoreMembershipRepository,
+	challengeRepo ports.MFAChallengeRepository,
+	audit ports.AuditEventRepository,
+	history ports.LoginHistoryRepository,
+	notifier ports.NotificationService,
+	unlockTokens security.VerificationTokenManager,
 	logger ports.Logger,
-	pepper string,
+	peppers vo.Peppers,
 	baseDuration time.Duration,
 	threshold int,
 	expiresIn time.Duration,
+	unverifiedPolicy UnverifiedEmailPolicy,
+	challengeTTL time.Duration,
+	mfaIssuer string,
+	newDeviceWindow time.Duration,
 ) security.LoginUseCase {
 	return &LoginUseCase{
-		userRepo:     userRepo,
-		tokenManager: tokenManager,
-		refreshRepo:  refreshRepo,
-		logger:       logger,
-		pepper:       pepper,
-		baseDuration: baseDuration,
-		threshold:    threshold,
-		expiresIn:    expiresIn,
+		userRepo:         userRepo,
+		tokenManager:     tokenManager,
+		refreshRepo:      refreshRepo,
+		audit:            audit,
+		logger:           logger,
+		peppers:          peppers,
+		baseDuration:     baseDuration,
+		threshold:        threshold,
+		expiresIn:        expiresIn,
+		unverifiedPolicy: unverifiedPolicy,
+		mfa: mfaGate{
+			mfaRepo:       mfaRepo,
+			mfaPolicyRepo: mfaPolicyRepo,
+			memberships:   memberships,
+			challengeRepo: challengeRepo,
+			logger:        logger,
+			challengeTTL:  challengeTTL,
+			issuer:        mfaIssuer,
+		},
+		lockout: lockoutNotifier{
+			notifier: notifier,
+			tokens:   unlockTokens,
+			logger:   logger,
+		},
+		logins: loginRecorder{
+			history:         history,
+			notifier:        notifier,
+			logger:          logger,
+			newDeviceWindow: newDeviceWindow,
+		},
 	}
 }
 
-func (uc *LoginUseCase) Execute(ctx context.Context, input *dto.LoginRequest) (*dto.LoginResult, error) {
+func (uc *LoginUseCase) Execute(ctx context.Context, input *dto.LoginRequest, client dto.ClientInfo) (*dto.LoginResult, error) {
 	uc.logger.Debug("starting login process", "email", input.Email)
 
 	email, err := vo.NewEmail(input.Email)
@@ -62,26 +115,56 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input *dto.LoginRequest) (*
 
 	if user == nil {
 		uc.logger.Info("login failed: user not found", "email", input.Email)
+		auditlog.Record(ctx, uc.audit, uc.logger, entity.NewAuditEvent(vo.AuditLoginFailed, nil, nil, client.IPAddress, client.UserAgent, time.Now()))
 		return nil, entity.ErrInvalidCredentials
 	}
 
 	if user.IsLocked(time.Now()) {
 		uc.logger.Info("user is locked", "email", input.Email)
+		now := time.Now()
+		auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditLoginFailed, nil, user, client))
+		uc.logins.failed(ctx, user, vo.LoginAccountLocked, client, now)
 		return nil, entity.ErrUserBlocked
 	}
 
-	if ok := user.Password().Matches(input.Password, uc.pepper); !ok {
+	if !user.IsActive() {
+		uc.logger.Info("login refused: user is deactivated", "userID", user.ID())
+		return nil, entity.ErrUserIsDeactivated
+	}
+
+	if ok := user.Password().Matches(input.Password, uc.peppers); !ok {
 		uc.logger.Info("login failed: invalid password", "userID", user.ID())
 
-		user.RecordFailedLogin(uc.threshold, uc.baseDuration, time.Now())
+		now := time.Now()
+		attempts := user.FailedAttempts()
+		locked := user.RecordFailedLogin(uc.threshold, uc.baseDuration, now)
 		err := uc.userRepo.Update(ctx, user)
 		if err != nil {
 			return nil, fmt.Errorf("error updating failed attempts: %w", err)
 		}
 
+		recordFailedLogin(ctx, uc.audit, uc.logger, user, attempts, locked, client)
+		uc.logins.failed(ctx, user, vo.LoginInvalidPassword, client, now)
+		if locked {
+			uc.lockout.notify(ctx, user)
+		}
+
 		return nil, entity.ErrInvalidCredentials
 	}
 
+	if user.Password().NeedsRehash(uc.peppers) {
+		uc.upgradePasswordHash(ctx, user, input.Password)
+	}
+
+	if !user.EmailVerified() && uc.unverifiedPolicy == RejectUnverifiedEmail {
+		uc.logger.Info("login refused: email not verified", "userID", user.ID())
+		return nil, entity.ErrEmailNotVerified
+	}
+
+	if challenge, err := uc.mfa.challenge(ctx, user); err != nil || challenge != nil {
+		return challenge, err
+	}
+
 	user.ResetFailedAttempts()
 
 	accessToken, refreshToken, err := uc.tokenManager.GenerateTokens(ctx, user)
@@ -90,18 +173,45 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input *dto.LoginRequest) (*
 		return nil, fmt.Errorf("generating tokens: %w", err)
 	}
 
-	if err := uc.refreshRepo.SaveRefreshToken(ctx, user.ID(), refreshToken, uc.expiresIn); err != nil {
-		uc.logger.Error("failed to save refresh token", err, "userID", user.ID())
-		return nil, fmt.Errorf("saving refresh token: %w", err)
+	if err := startSession(ctx, uc.refreshRepo, user, refreshToken, client, uc.expiresIn); err != nil {
+		uc.logger.Error("failed to start session", err, "userID", user.ID())
+		return nil, err
 	}
 
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return nil, fmt.Errorf("error reset failed attempts: %w", err)
 	}
 
+	now := time.Now()
+	actorID := user.ID()
+	auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditLoginSucceeded, &actorID, user, client))
+	uc.logins.succeeded(ctx, user, client, now)
+
 	uc.logger.Info("user logged in successfully", "userID", user.ID())
 	return &dto.LoginResult{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 	}, nil
 }
+
+// upgradePasswordHash rewrites a hash made with old Argon2id parameters or a
+// rotated pepper, which is only possible while the plain text is at hand. It
+// is saved right away because the login may still stop at the MFA step. A
+// failure is logged and the login goes on: the old hash keeps working.
+func (uc *LoginUseCase) upgradePasswordHash(ctx context.Context, user *entity.User, plainText string) {
+	password, err := vo.RehashPassword(plainText, uc.peppers)
+	if err != nil {
+		uc.logger.Error("failed to rehash password", err, "userID", user.ID())
+		return
+	}
+
+	previous := user.Password()
+	user.ChangePassword(password)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error("failed to save rehashed password", err, "userID", user.ID())
+		user.ChangePassword(previous)
+		return
+	}
+
+	uc.logger.Info("password hash upgraded", "userID", user.ID(), "pepperID", uc.peppers.CurrentID())
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+)
+
+// loginRecorder keeps the login history of known users and alerts them when
+// they log in from a device and IP address they have not used within
+// newDeviceWindow. Like the audit log it is best effort: a login never fails
+// because its history could not be written or the alert could not be sent.
+type loginRecorder struct {
+	history         ports.LoginHistoryRepository
+	notifier        ports.NotificationService
+	logger          ports.Logger
+	newDeviceWindow time.Duration
+}
+
+func (r loginRecorder) failed(ctx context.Context, user *entity.User, outcome vo.LoginOutcome, client dto.ClientInfo, now time.Time) {
+	r.append(ctx, entity.NewLoginAttempt(user.ID(), outcome, client.IPAddress, client.UserAgent, now))
+}
+
+// succeeded looks the device up before appending the attempt, which would
+// otherwise always find itself. A user's first login ever is not alerted on.
+func (r loginRecorder) succeeded(ctx context.Context, user *entity.User, client dto.ClientInfo, now time.Time) {
+	attempt := entity.NewLoginAttempt(user.ID(), vo.LoginSucceeded, client.IPAddress, client.UserAgent, now)
+
+	newDevice, err := r.isNewDevice(ctx, attempt)
+	if err != nil {
+		r.logger.Error("failed to check login device", err, "userID", user.ID())
+	}
+
+	r.append(ctx, attempt)
+
+	if !newDevice {
+		return
+	}
+
+	if err := r.notifier.SendNewDeviceLoginAlert(ctx, user.Email(), attempt.Device(), attempt.IPAddress(), now); err != nil {
+		r.logger.Error("failed to send new device login alert", err, "userID", user.ID())
+		return
+	}
+
+	r.logger.Info("new device login alert sent", "userID", user.ID(), "device", attempt.Device())
+}
+
+func (r loginRecorder) isNewDevice(ctx context.Context, attempt *entity.LoginAttempt) (bool, error) {
+	seen, err := r.history.SeenFrom(ctx, attempt.UserID(), attempt.Device(), attempt.IPAddress(), attempt.OccurredAt().Add(-r.newDeviceWindow))
+	if err != nil || seen {
+		return false, err
+	}
+
+	loggedInBefore, err := r.history.HasSucceeded(ctx, attempt.UserID())
+	if err != nil {
+		return false, err
+	}
+
+	return loggedInBefore, nil
+}
+
+func (r loginRecorder) append(ctx context.Context, attempt *entity.LoginAttempt) {
+	if err := r.history.Append(ctx, attempt); err != nil {
+		r.logger.Error("failed to record login attempt", err, "userID", attempt.UserID(), "outcome", attempt.Outcome())
+	}
+}
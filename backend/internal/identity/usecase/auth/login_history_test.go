@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLoginRecorder_Succeeded(t *testing.T) {
+	ctx := context.Background()
+	email, _ := vo.NewEmail("test@example.com")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, _ := entity.NewUser(email, "testuser", password, []vo.Role{vo.EmployeeRole})
+
+	client := dto.ClientInfo{
+		IPAddress: "203.0.113.7",
+		UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+	}
+	device := vo.DeviceFingerprint("firefox/linux/desktop")
+	window := 30 * 24 * time.Hour
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setup     func(h *MockLoginHistoryRepository)
+		wantAlert bool
+	}{
+		{
+			name: "Known Device",
+			setup: func(h *MockLoginHistoryRepository) {
+				h.On("SeenFrom", ctx, user.ID(), device, client.IPAddress, now.Add(-window)).Return(true, nil)
+			},
+		},
+		{
+			name: "New Device",
+			setup: func(h *MockLoginHistoryRepository) {
+				h.On("SeenFrom", ctx, user.ID(), device, client.IPAddress, now.Add(-window)).Return(false, nil)
+				h.On("HasSucceeded", ctx, user.ID()).Return(true, nil)
+			},
+			wantAlert: true,
+		},
+		{
+			name: "First Login Ever",
+			setup: func(h *MockLoginHistoryRepository) {
+				h.On("SeenFrom", ctx, user.ID(), device, client.IPAddress, now.Add(-window)).Return(false, nil)
+				h.On("HasSucceeded", ctx, user.ID()).Return(false, nil)
+			},
+		},
+		{
+			name: "History Unavailable",
+			setup: func(h *MockLoginHistoryRepository) {
+				h.On("SeenFrom", ctx, user.ID(), device, client.IPAddress, now.Add(-window)).Return(false, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := new(MockLoginHistoryRepository)
+			notifier := new(MockNotificationService)
+			tt.setup(history)
+			history.On("Append", ctx, mock.MatchedBy(func(a *entity.LoginAttempt) bool {
+				return a.Succeeded() && a.Device() == device && a.IPAddress() == client.IPAddress
+			})).Return(nil)
+			if tt.wantAlert {
+				notifier.On("SendNewDeviceLoginAlert", ctx, email, device, client.IPAddress, now).Return(nil)
+			}
+
+			recorder := loginRecorder{history: history, notifier: notifier, logger: new(MockLogger), newDeviceWindow: window}
+			recorder.succeeded(ctx, user, client, now)
+
+			history.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+			if !tt.wantAlert {
+				notifier.AssertNotCalled(t, "SendNewDeviceLoginAlert", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestLoginRecorder_FailedDoesNotAlert(t *testing.T) {
+	ctx := context.Background()
+	email, _ := vo.NewEmail("test@example.com")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, _ := entity.NewUser(email, "testuser", password, []vo.Role{vo.EmployeeRole})
+
+	history := new(MockLoginHistoryRepository)
+	history.On("Append", ctx, mock.Anything).Return(assert.AnError)
+	notifier := new(MockNotificationService)
+
+	recorder := loginRecorder{history: history, notifier: notifier, logger: new(MockLogger), newDeviceWindow: time.Hour}
+	recorder.failed(ctx, user, vo.LoginInvalidPassword, dto.ClientInfo{IPAddress: "203.0.113.7"}, time.Now())
+
+	assert.Equal(t, []vo.LoginOutcome{vo.LoginInvalidPassword}, history.recordedOutcomes())
+	history.AssertNotCalled(t, "SeenFrom", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "SendNewDeviceLoginAlert", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
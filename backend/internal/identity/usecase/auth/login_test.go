@@ -15,7 +15,7 @@ import (
 )
 
 func TestLoginUseCase_Execute(t *testing.T) {
-	pepper := "test-pepper"
+	pepper := vo.SinglePepper("test-pepper")
 	emailStr := "test@example.com"
 	emailVO, _ := vo.NewEmail(emailStr)
 	passwordStr := "Password123!"
@@ -24,17 +24,39 @@ func TestLoginUseCase_Execute(t *testing.T) {
 
 	// Usuário já bloqueado para teste de lockout
 	lockedTime := time.Now().Add(time.Hour)
-	lockedUser, _ := entity.RestoreUser(uuid.New(), emailStr, "locked", passwordVO.String(), []string{"ADMIN"}, true, 5, &lockedTime, true)
+	lockedUser, _ := entity.RestoreUser(uuid.New(), emailStr, "locked", passwordVO.String(), []string{"ADMIN"}, true, 5, &lockedTime, true, 0, nil, vo.SystemRoleCatalog())
+
+	verifiedUser, _ := entity.RestoreUser(uuid.New(), emailStr, "verified", passwordVO.String(), []string{"EMPLOYEE"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	deactivatedUser, _ := entity.RestoreUser(uuid.New(), emailStr, "deactivated", passwordVO.String(), []string{"EMPLOYEE"}, false, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	// Uma falha antes do limite de bloqueio
+	nearLockUser, _ := entity.RestoreUser(uuid.New(), emailStr, "nearlock", passwordVO.String(), []string{"EMPLOYEE"}, true, 4, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	secret, _ := vo.GenerateTOTPSecret()
+	enabledMFA, _ := entity.RestoreUserMFA(user.ID(), secret.String(), true, nil, 0)
+	pendingMFA, _ := entity.RestoreUserMFA(user.ID(), secret.String(), false, nil, 0)
+	storeManager, _ := entity.NewStoreMembership(user.ID(), uuid.New(), []vo.Role{vo.ManagerRole}, time.Now())
+	suspendedManager, _ := entity.NewStoreMembership(user.ID(), uuid.New(), []vo.Role{vo.ManagerRole}, time.Now())
+	suspendedManager.ChangeStatus(vo.MembershipSuspended, time.Now())
 
 	threshold := 5
 	baseDuration := 15 * time.Minute
 
 	tests := []struct {
-		name      string
-		input     *dto.LoginRequest
-		setup     func(*MockUserRepository, *MockTokenManager, *MockRefreshTokenRepository)
-		wantErr   bool
-		expectErr error
+		name           string
+		input          *dto.LoginRequest
+		policy         UnverifiedEmailPolicy
+		setup          func(*MockUserRepository, *MockTokenManager, *MockRefreshTokenRepository)
+		mfaSetup       func(*MockMFARepository, *MockMFAPolicyRepository, *MockMFAChallengeRepository)
+		wantErr        bool
+		expectErr      error
+		wantChallenge  bool
+		wantEnrollment bool
+		wantAudit      []vo.AuditAction
+		wantLogins     []vo.LoginOutcome
+		wantLockNotice bool
+		memberships    []*entity.StoreMembership
 	}{
 		{
 			name:  "Success - Resets failed attempts",
@@ -42,11 +64,126 @@ func TestLoginUseCase_Execute(t *testing.T) {
 			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
 				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
 				tm.On("GenerateTokens", mock.Anything, user).Return("access", "refresh", nil)
-				rr.On("SaveRefreshToken", mock.Anything, user.ID(), "refresh", mock.Anything).Return(nil)
+				rr.On("SaveRefreshToken", mock.Anything, newFamilyToken(user, "refresh"), mock.Anything).Return(nil)
+				rr.On("SaveSession", mock.Anything, newSessionOf(user.ID()), mock.Anything).Return(nil)
 				mr.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
 					return u.FailedAttempts() == 0 && u.LockedUntil() == nil
 				})).Return(nil)
 			},
+			wantErr:    false,
+			wantAudit:  []vo.AuditAction{vo.AuditLoginSucceeded},
+			wantLogins: []vo.LoginOutcome{vo.LoginSucceeded},
+		},
+		{
+			name:  "MFA Enabled - Returns challenge instead of tokens",
+			input: &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+			},
+			mfaSetup: func(mfa *MockMFARepository, policy *MockMFAPolicyRepository, ch *MockMFAChallengeRepository) {
+				mfa.On("FindByUserID", mock.Anything, user.ID()).Return(enabledMFA, nil)
+				policy.On("GetRequiredRoles", mock.Anything).Return([]vo.Role{}, nil)
+				ch.On("SaveChallenge", mock.Anything, mock.AnythingOfType("string"), user.ID(), time.Minute*5).Return(nil)
+			},
+			wantChallenge: true,
+		},
+		{
+			name:  "MFA Required By Role - Starts enrollment with challenge",
+			input: &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+			},
+			mfaSetup: func(mfa *MockMFARepository, policy *MockMFAPolicyRepository, ch *MockMFAChallengeRepository) {
+				mfa.On("FindByUserID", mock.Anything, user.ID()).Return(nil, nil)
+				policy.On("GetRequiredRoles", mock.Anything).Return([]vo.Role{vo.EmployeeRole}, nil)
+				mfa.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.UserMFA) bool {
+					return m.UserID() == user.ID() && !m.IsEnabled()
+				})).Return(nil)
+				ch.On("SaveChallenge", mock.Anything, mock.AnythingOfType("string"), user.ID(), time.Minute*5).Return(nil)
+			},
+			wantChallenge:  true,
+			wantEnrollment: true,
+		},
+		{
+			name:  "MFA Enrollment Pending - Secret is not shown again",
+			input: &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+			},
+			mfaSetup: func(mfa *MockMFARepository, policy *MockMFAPolicyRepository, ch *MockMFAChallengeRepository) {
+				mfa.On("FindByUserID", mock.Anything, user.ID()).Return(pendingMFA, nil)
+				policy.On("GetRequiredRoles", mock.Anything).Return([]vo.Role{vo.EmployeeRole}, nil)
+				ch.On("SaveChallenge", mock.Anything, mock.AnythingOfType("string"), user.ID(), time.Minute*5).Return(nil)
+			},
+			wantChallenge: true,
+		},
+		{
+			name:  "MFA Required By Store Role - Starts enrollment with challenge",
+			input: &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+			},
+			mfaSetup: func(mfa *MockMFARepository, policy *MockMFAPolicyRepository, ch *MockMFAChallengeRepository) {
+				mfa.On("FindByUserID", mock.Anything, user.ID()).Return(nil, nil)
+				policy.On("GetRequiredRoles", mock.Anything).Return([]vo.Role{vo.ManagerRole}, nil)
+				mfa.On("Save", mock.Anything, mock.Anything).Return(nil)
+				ch.On("SaveChallenge", mock.Anything, mock.AnythingOfType("string"), user.ID(), time.Minute*5).Return(nil)
+			},
+			memberships:    []*entity.StoreMembership{storeManager},
+			wantChallenge:  true,
+			wantEnrollment: true,
+		},
+		{
+			name:  "MFA Not Required By Suspended Store Role",
+			input: &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				tm.On("GenerateTokens", mock.Anything, user).Return("access", "refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, newFamilyToken(user, "refresh"), mock.Anything).Return(nil)
+				rr.On("SaveSession", mock.Anything, newSessionOf(user.ID()), mock.Anything).Return(nil)
+				mr.On("Update", mock.Anything, mock.Anything).Return(nil)
+			},
+			mfaSetup: func(mfa *MockMFARepository, policy *MockMFAPolicyRepository, ch *MockMFAChallengeRepository) {
+				mfa.On("FindByUserID", mock.Anything, user.ID()).Return(nil, nil)
+				policy.On("GetRequiredRoles", mock.Anything).Return([]vo.Role{vo.ManagerRole}, nil)
+			},
+			memberships: []*entity.StoreMembership{suspendedManager},
+			wantAudit:   []vo.AuditAction{vo.AuditLoginSucceeded},
+			wantLogins:  []vo.LoginOutcome{vo.LoginSucceeded},
+		},
+		{
+			name:  "MFA Policy Error",
+			input: &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+			},
+			mfaSetup: func(mfa *MockMFARepository, policy *MockMFAPolicyRepository, ch *MockMFAChallengeRepository) {
+				mfa.On("FindByUserID", mock.Anything, user.ID()).Return(nil, nil)
+				policy.On("GetRequiredRoles", mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Unverified Email - Rejected by policy",
+			input:  &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			policy: RejectUnverifiedEmail,
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+			},
+			wantErr:   true,
+			expectErr: entity.ErrEmailNotVerified,
+		},
+		{
+			name:   "Verified Email - Allowed by reject policy",
+			input:  &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			policy: RejectUnverifiedEmail,
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(verifiedUser, nil)
+				tm.On("GenerateTokens", mock.Anything, verifiedUser).Return("access", "refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, newFamilyToken(verifiedUser, "refresh"), mock.Anything).Return(nil)
+				rr.On("SaveSession", mock.Anything, newSessionOf(verifiedUser.ID()), mock.Anything).Return(nil)
+				mr.On("Update", mock.Anything, verifiedUser).Return(nil)
+			},
 			wantErr: false,
 		},
 		{
@@ -55,8 +192,19 @@ func TestLoginUseCase_Execute(t *testing.T) {
 			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
 				mr.On("FindByEmail", mock.Anything, emailVO).Return(lockedUser, nil)
 			},
+			wantErr:    true,
+			expectErr:  entity.ErrUserBlocked,
+			wantAudit:  []vo.AuditAction{vo.AuditLoginFailed},
+			wantLogins: []vo.LoginOutcome{vo.LoginAccountLocked},
+		},
+		{
+			name:  "Login Refused - User deactivated",
+			input: &dto.LoginRequest{Email: emailStr, Password: passwordStr},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(deactivatedUser, nil)
+			},
 			wantErr:   true,
-			expectErr: entity.ErrUserBlocked,
+			expectErr: entity.ErrUserIsDeactivated,
 		},
 		{
 			name:  "Invalid Password - Increments failed attempts and updates",
@@ -67,8 +215,25 @@ func TestLoginUseCase_Execute(t *testing.T) {
 					return u.FailedAttempts() > 0
 				})).Return(nil)
 			},
-			wantErr:   true,
-			expectErr: entity.ErrInvalidCredentials,
+			wantErr:    true,
+			expectErr:  entity.ErrInvalidCredentials,
+			wantAudit:  []vo.AuditAction{vo.AuditLoginFailed},
+			wantLogins: []vo.LoginOutcome{vo.LoginInvalidPassword},
+		},
+		{
+			name:  "Invalid Password - Reaching threshold locks and audits lockout",
+			input: &dto.LoginRequest{Email: emailStr, Password: "wrong-password"},
+			setup: func(mr *MockUserRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				mr.On("FindByEmail", mock.Anything, emailVO).Return(nearLockUser, nil)
+				mr.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.LockedUntil() != nil
+				})).Return(nil)
+			},
+			wantErr:        true,
+			expectErr:      entity.ErrInvalidCredentials,
+			wantAudit:      []vo.AuditAction{vo.AuditLoginFailed, vo.AuditAccountLocked},
+			wantLogins:     []vo.LoginOutcome{vo.LoginInvalidPassword},
+			wantLockNotice: true,
 		},
 		{
 			name:  "User Not Found",
@@ -78,6 +243,7 @@ func TestLoginUseCase_Execute(t *testing.T) {
 			},
 			wantErr:   true,
 			expectErr: entity.ErrInvalidCredentials,
+			wantAudit: []vo.AuditAction{vo.AuditLoginFailed},
 		},
 		{
 			name:  "Token Generation Error",
@@ -109,18 +275,43 @@ func TestLoginUseCase_Execute(t *testing.T) {
 			mockRepo := new(MockUserRepository)
 			mockTM := new(MockTokenManager)
 			mockRR := new(MockRefreshTokenRepository)
+			mockMFA := new(MockMFARepository)
+			mockPolicy := new(MockMFAPolicyRepository)
+			mockChallenge := new(MockMFAChallengeRepository)
+			mockMemberships := new(MockStoreMembershipRepository)
+			mockMemberships.On("ListByUser", mock.Anything, mock.Anything).Return(tt.memberships, nil).Maybe()
+			mockAudit := newMockAuditLog()
+			mockHistory := newMockLoginHistory()
+			mockNotifier := new(MockNotificationService)
+			mockTokens := new(MockVerificationTokenManager)
 			mockLogger := new(MockLogger)
 
 			tt.setup(mockRepo, mockTM, mockRR)
+			if tt.wantLockNotice {
+				mockTokens.On("GenerateUnlockToken", nearLockUser).Return("unlock-token", nil)
+				mockNotifier.On("SendAccountLockedEmail", mock.Anything, emailVO, mock.AnythingOfType("time.Time"), "unlock-token").Return(nil)
+			}
+			if tt.mfaSetup != nil {
+				tt.mfaSetup(mockMFA, mockPolicy, mockChallenge)
+			} else {
+				mockMFA.On("FindByUserID", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+				mockPolicy.On("GetRequiredRoles", mock.Anything).Return([]vo.Role{}, nil).Maybe()
+			}
 
-			uc := NewLogin(mockRepo, mockTM, mockRR, mockLogger, pepper, baseDuration, threshold, time.Hour)
-			result, err := uc.Execute(context.Background(), tt.input)
+			uc := NewLogin(mockRepo, mockTM, mockRR, mockMFA, mockPolicy, mockMemberships, mockChallenge, mockAudit, mockHistory, mockNotifier, mockTokens, mockLogger, pepper, baseDuration, threshold, time.Hour, tt.policy, time.Minute*5, "Store Manager", 30*24*time.Hour)
+			result, err := uc.Execute(context.Background(), tt.input, dto.ClientInfo{IPAddress: "127.0.0.1", UserAgent: "test-agent"})
 
 			if tt.wantErr {
 				assert.Error(t, err)
 				if tt.expectErr != nil {
 					assert.ErrorIs(t, err, tt.expectErr)
 				}
+			} else if tt.wantChallenge {
+				assert.NoError(t, err)
+				assert.True(t, result.MFARequired())
+				assert.Empty(t, result.AccessToken)
+				assert.Empty(t, result.RefreshToken)
+				assert.Equal(t, tt.wantEnrollment, result.MFAEnrollment != nil)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
@@ -128,9 +319,72 @@ func TestLoginUseCase_Execute(t *testing.T) {
 				assert.Equal(t, "refresh", result.RefreshToken)
 			}
 
+			if tt.wantAudit != nil {
+				assert.Equal(t, tt.wantAudit, mockAudit.recordedActions())
+			}
+
+			if tt.wantLogins != nil {
+				assert.Equal(t, tt.wantLogins, mockHistory.recordedOutcomes())
+			}
+
 			mockRepo.AssertExpectations(t)
 			mockTM.AssertExpectations(t)
 			mockRR.AssertExpectations(t)
+			mockMFA.AssertExpectations(t)
+			mockPolicy.AssertExpectations(t)
+			mockChallenge.AssertExpectations(t)
+			mockNotifier.AssertExpectations(t)
+			mockTokens.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginUseCase_RehashesPasswordWithRotatedPepper(t *testing.T) {
+	emailStr := "test@example.com"
+	emailVO, _ := vo.NewEmail(emailStr)
+	passwordStr := "Password123!"
+	oldHash, _ := vo.NewPassword(passwordStr, vo.SinglePepper("old-pepper"))
+	rotated, _ := vo.NewPeppers("2", map[string]string{vo.DefaultPepperID: "old-pepper", "2": "new-pepper"})
+	secret, _ := vo.GenerateTOTPSecret()
+
+	tests := []struct {
+		name      string
+		updateErr error
+	}{
+		{name: "Saved Before MFA Challenge"},
+		{name: "Save Failure Does Not Block Login", updateErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, _ := entity.RestoreUser(uuid.New(), emailStr, "testuser", oldHash.String(), []string{"EMPLOYEE"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+			enabledMFA, _ := entity.RestoreUserMFA(user.ID(), secret.String(), true, nil, 0)
+
+			mockRepo := new(MockUserRepository)
+			mockMFA := new(MockMFARepository)
+			mockPolicy := new(MockMFAPolicyRepository)
+			mockChallenge := new(MockMFAChallengeRepository)
+
+			mockRepo.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+			mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+				return u.Password().Matches(passwordStr, rotated) && !u.Password().NeedsRehash(rotated)
+			})).Return(tt.updateErr).Once()
+			mockMFA.On("FindByUserID", mock.Anything, user.ID()).Return(enabledMFA, nil)
+			mockPolicy.On("GetRequiredRoles", mock.Anything).Return([]vo.Role{}, nil)
+			mockChallenge.On("SaveChallenge", mock.Anything, mock.AnythingOfType("string"), user.ID(), time.Minute*5).Return(nil)
+
+			uc := NewLogin(mockRepo, new(MockTokenManager), new(MockRefreshTokenRepository), mockMFA, mockPolicy, new(MockStoreMembershipRepository), mockChallenge, newMockAuditLog(), newMockLoginHistory(), new(MockNotificationService), new(MockVerificationTokenManager), new(MockLogger), rotated, 15*time.Minute, 5, time.Hour, RestrictUnverifiedEmail, time.Minute*5, "Store Manager", 30*24*time.Hour)
+			result, err := uc.Execute(context.Background(), &dto.LoginRequest{Email: emailStr, Password: passwordStr}, dto.ClientInfo{})
+
+			assert.NoError(t, err)
+			assert.True(t, result.MFARequired())
+			if tt.updateErr != nil {
+				assert.Equal(t, oldHash, user.Password())
+			} else {
+				assert.NotEqual(t, oldHash, user.Password())
+			}
+
+			mockRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type requestMagicLinkUseCase struct {
+	userRepo            ports.UserRepository
+	magicLinks          ports.MagicLinkRepository
+	linkTokens          security.VerificationTokenManager
+	notificationService ports.NotificationService
+	audit               ports.AuditEventRepository
+	logger              ports.Logger
+	expiresIn           time.Duration
+}
+
+// NewRequestMagicLink expects linkTokens to sign tokens that live as long as
+// expiresIn, so the signature and the single use left of a link expire
+// together.
+func NewRequestMagicLink(
+	userRepo ports.UserRepository,
+	magicLinks ports.MagicLinkRepository,
+	linkTokens security.VerificationTokenManager,
+	notificationService ports.NotificationService,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	expiresIn time.Duration,
+) security.RequestMagicLinkUseCase {
+	return &requestMagicLinkUseCase{
+		userRepo:            userRepo,
+		magicLinks:          magicLinks,
+		linkTokens:          linkTokens,
+		notificationService: notificationService,
+		audit:               audit,
+		logger:              logger,
+		expiresIn:           expiresIn,
+	}
+}
+
+// Execute emails a login link to the address. Unknown addresses, and accounts
+// that could not log in anyway, get no link and no error, so the endpoint does
+// not tell which addresses are registered.
+func (uc *requestMagicLinkUseCase) Execute(ctx context.Context, email string) error {
+	uc.logger.Debug("starting magic link request", "email", email)
+
+	emailVO, err := vo.NewEmail(email)
+	if err != nil {
+		uc.logger.Info("invalid email provided in magic link request", "email", email)
+		return err
+	}
+
+	user, err := uc.userRepo.FindByEmail(ctx, emailVO)
+	if err != nil {
+		uc.logger.Error("error finding user by email", err, "email", email)
+		return nil
+	}
+
+	now := time.Now()
+	if user == nil || user.IsDeleted() || !user.IsActive() || user.IsLocked(now) {
+		uc.logger.Info("magic link not sent: no user able to log in", "email", email)
+		return nil
+	}
+
+	linkID := uuid.New()
+	token, err := uc.linkTokens.GenerateMagicLinkToken(user, linkID)
+	if err != nil {
+		uc.logger.Error("failed to generate magic link token", err, "userID", user.ID())
+		return err
+	}
+
+	if err := uc.magicLinks.Save(ctx, linkID, user.ID(), uc.expiresIn); err != nil {
+		uc.logger.Error("failed to save magic link", err, "userID", user.ID())
+		return err
+	}
+
+	if err := uc.notificationService.SendMagicLinkEmail(ctx, user.Email(), token); err != nil {
+		uc.logger.Error("failed to send magic link email", err, "userID", user.ID())
+		return err
+	}
+
+	auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditMagicLinkSent, nil, user, dto.ClientInfoFromContext(ctx)))
+
+	uc.logger.Info("magic link sent", "userID", user.ID())
+	return nil
+}
+
+type consumeMagicLinkUseCase struct {
+	userRepo     ports.UserRepository
+	magicLinks   ports.MagicLinkRepository
+	linkTokens   security.VerificationTokenManager
+	tokenManager security.TokenManager
+	refreshRepo  ports.RefreshTokenRepository
+	audit        ports.AuditEventRepository
+	mfa          mfaGate
+	logins       loginRecorder
+	logger       ports.Logger
+	expiresIn    time.Duration
+}
+
+func NewConsumeMagicLink(
+	userRepo ports.UserRepository,
+	magicLinks ports.MagicLinkRepository,
+	linkTokens security.VerificationTokenManager,
+	tokenManager security.TokenManager,
+	refreshRepo ports.RefreshTokenRepository,
+	mfaRepo ports.MFARepository,
+	mfaPolicyRepo ports.MFAPolicyRepository,
+	memberships ports.StoreMembershipRepository,
+	challengeRepo ports.MFAChallengeRepository,
+	audit ports.AuditEventRepository,
+	history ports.LoginHistoryRepository,
+	notifier ports.NotificationService,
+	logger ports.Logger,
+	expiresIn time.Duration,
+	challengeTTL time.Duration,
+	mfaIssuer string,
+	newDeviceWindow time.Duration,
+) security.ConsumeMagicLinkUseCase {
+	return &consumeMagicLinkUseCase{
+		userRepo:     userRepo,
+		magicLinks:   magicLinks,
+		linkTokens:   linkTokens,
+		tokenManager: tokenManager,
+		refreshRepo:  refreshRepo,
+		audit:        audit,
+		logger:       logger,
+		expiresIn:    expiresIn,
+		mfa: mfaGate{
+			mfaRepo:       mfaRepo,
+			mfaPolicyRepo: mfaPolicyRepo,
+			memberships:   memberships,
+			challengeRepo: challengeRepo,
+			logger:        logger,
+			challengeTTL:  challengeTTL,
+			issuer:        mfaIssuer,
+		},
+		logins: loginRecorder{
+			history:         history,
+			notifier:        notifier,
+			logger:          logger,
+			newDeviceWindow: newDeviceWindow,
+		},
+	}
+}
+
+// Execute logs the user in with the link instead of a password. The link is
+// used up before anything else is checked, so it never works twice, and it
+// only works for the address it was sent to. Lockout, deactivation and MFA
+// apply as they do to a password login.
+func (uc *consumeMagicLinkUseCase) Execute(ctx context.Context, token string, client dto.ClientInfo) (*dto.LoginResult, error) {
+	uc.logger.Debug("starting magic link login")
+
+	userID, email, linkID, err := uc.linkTokens.ValidateMagicLinkToken(token)
+	if err != nil {
+		uc.logger.Info("invalid magic link token", "error", err)
+		return nil, fmt.Errorf("validating magic link token: %w", err)
+	}
+
+	linkUserID, err := uc.magicLinks.Consume(ctx, linkID)
+	if err != nil {
+		uc.logger.Info("magic link already used or expired", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	if linkUserID != userID {
+		uc.logger.Info("security event: magic link issued for another user", "userID", userID, "linkUserID", linkUserID)
+		return nil, entity.ErrInvalidMagicLink
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for magic link login", err, "userID", userID)
+		return nil, fmt.Errorf("finding user: %w", err)
+	}
+
+	// a link sent before the address changed no longer matches it
+	if user == nil || user.IsDeleted() || !user.Email().Equals(email) {
+		uc.logger.Info("magic link does not match the user", "userID", userID)
+		return nil, entity.ErrInvalidMagicLink
+	}
+
+	if !user.IsActive() {
+		return nil, entity.ErrUserIsDeactivated
+	}
+
+	now := time.Now()
+	if user.IsLocked(now) {
+		uc.logger.Info("user is locked", "userID", userID)
+		auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditLoginFailed, nil, user, client))
+		uc.logins.failed(ctx, user, vo.LoginAccountLocked, client, now)
+		return nil, entity.ErrUserBlocked
+	}
+
+	// following the link proves the mailbox is the user's, so an address
+	// that was never verified counts as verified from now on
+	if !user.EmailVerified() {
+		user.VerifyEmail()
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			uc.logger.Error("failed to verify email on magic link login", err, "userID", userID)
+			return nil, fmt.Errorf("verifying email: %w", err)
+		}
+	}
+
+	if challenge, err := uc.mfa.challenge(ctx, user); err != nil || challenge != nil {
+		return challenge, err
+	}
+
+	user.ResetFailedAttempts()
+
+	accessToken, refreshToken, err := uc.tokenManager.GenerateTokens(ctx, user)
+	if err != nil {
+		uc.logger.Error("failed to generate auth tokens", err, "userID", userID)
+		return nil, fmt.Errorf("generating tokens: %w", err)
+	}
+
+	if err := startSession(ctx, uc.refreshRepo, user, refreshToken, client, uc.expiresIn); err != nil {
+		uc.logger.Error("failed to start session", err, "userID", userID)
+		return nil, err
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("error reset failed attempts: %w", err)
+	}
+
+	auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditLoginSucceeded, &userID, user, client))
+	uc.logins.succeeded(ctx, user, client, now)
+
+	uc.logger.Info("user logged in with magic link", "userID", userID)
+	return &dto.LoginResult{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
@@ -0,0 +1,270 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestMagicLinkUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	email, _ := vo.NewEmail("cashier@example.com")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, _ := entity.NewUser(email, "cashier", password, []vo.Role{vo.EmployeeRole})
+	lockedUntil := time.Now().Add(time.Hour)
+	locked, _ := entity.RestoreUser(user.ID(), email.String(), "cashier", password.String(), []string{"EMPLOYEE"}, true, 5, &lockedUntil, true, 0, nil, vo.SystemRoleCatalog())
+	inactive, _ := entity.RestoreUser(user.ID(), email.String(), "cashier", password.String(), []string{"EMPLOYEE"}, false, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	tests := []struct {
+		name     string
+		email    string
+		setup    func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, ns *MockNotificationService)
+		wantErr  error
+		wantSent bool
+	}{
+		{
+			name:  "Success",
+			email: email.String(),
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", ctx, email).Return(user, nil)
+				vt.On("GenerateMagicLinkToken", user, mock.AnythingOfType("uuid.UUID")).Return("link-token", nil)
+				ml.On("Save", ctx, mock.AnythingOfType("uuid.UUID"), user.ID(), 10*time.Minute).Return(nil)
+				ns.On("SendMagicLinkEmail", ctx, email, "link-token").Return(nil)
+			},
+			wantSent: true,
+		},
+		{
+			name:  "Unknown Email",
+			email: email.String(),
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", ctx, email).Return(nil, nil)
+			},
+		},
+		{
+			name:  "Locked Account",
+			email: email.String(),
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", ctx, email).Return(locked, nil)
+			},
+		},
+		{
+			name:  "Deactivated Account",
+			email: email.String(),
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", ctx, email).Return(inactive, nil)
+			},
+		},
+		{
+			name:    "Invalid Email",
+			email:   "not-an-email",
+			setup:   func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {},
+			wantErr: vo.ErrInvalidEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			ml := new(MockMagicLinkRepository)
+			vt := new(MockVerificationTokenManager)
+			ns := new(MockNotificationService)
+			audit := newMockAuditLog()
+			tt.setup(ur, ml, vt, ns)
+
+			uc := NewRequestMagicLink(ur, ml, vt, ns, audit, new(MockLogger), 10*time.Minute)
+			err := uc.Execute(ctx, tt.email)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.wantSent {
+				assert.Equal(t, []vo.AuditAction{vo.AuditMagicLinkSent}, audit.recordedActions())
+			} else {
+				assert.Empty(t, audit.recordedActions())
+				ns.AssertNotCalled(t, "SendMagicLinkEmail", mock.Anything, mock.Anything, mock.Anything)
+			}
+
+			ur.AssertExpectations(t)
+			ml.AssertExpectations(t)
+			vt.AssertExpectations(t)
+			ns.AssertExpectations(t)
+		})
+	}
+}
+
+func TestConsumeMagicLinkUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	email, _ := vo.NewEmail("cashier@example.com")
+	otherEmail, _ := vo.NewEmail("previous@example.com")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	userID := uuid.New()
+	linkID := uuid.New()
+	client := dto.ClientInfo{IPAddress: "10.0.0.5", UserAgent: "tablet"}
+
+	restore := func(active bool, failedAttempts int, lockedUntil *time.Time, verified bool) *entity.User {
+		user, _ := entity.RestoreUser(userID, email.String(), "cashier", password.String(), []string{"EMPLOYEE"}, active, failedAttempts, lockedUntil, verified, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+	lockedUntil := time.Now().Add(time.Hour)
+
+	secret, _ := vo.GenerateTOTPSecret()
+	enabledMFA, _ := entity.RestoreUserMFA(userID, secret.String(), true, nil, 0)
+
+	tests := []struct {
+		name          string
+		setup         func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository)
+		mfa           *entity.UserMFA
+		wantErr       error
+		wantChallenge bool
+		wantAudit     []vo.AuditAction
+		wantLogins    []vo.LoginOutcome
+	}{
+		{
+			name: "Success - Verifies email and resets failed attempts",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				user := restore(true, 2, nil, false)
+				vt.On("ValidateMagicLinkToken", "link-token").Return(userID, email, linkID, nil)
+				ml.On("Consume", ctx, linkID).Return(userID, nil)
+				ur.On("FindByID", ctx, userID).Return(user, nil)
+				tm.On("GenerateTokens", ctx, user).Return("access", "refresh", nil)
+				rr.On("SaveRefreshToken", ctx, newFamilyToken(user, "refresh"), time.Hour).Return(nil)
+				rr.On("SaveSession", ctx, newSessionOf(userID), time.Hour).Return(nil)
+				ur.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
+					return u.EmailVerified() && u.FailedAttempts() == 2
+				})).Return(nil).Once()
+				ur.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
+					return u.EmailVerified() && u.FailedAttempts() == 0
+				})).Return(nil).Once()
+			},
+			wantAudit:  []vo.AuditAction{vo.AuditLoginSucceeded},
+			wantLogins: []vo.LoginOutcome{vo.LoginSucceeded},
+		},
+		{
+			name: "MFA Enabled - Returns challenge instead of tokens",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				vt.On("ValidateMagicLinkToken", "link-token").Return(userID, email, linkID, nil)
+				ml.On("Consume", ctx, linkID).Return(userID, nil)
+				ur.On("FindByID", ctx, userID).Return(restore(true, 0, nil, true), nil)
+			},
+			mfa:           enabledMFA,
+			wantChallenge: true,
+		},
+		{
+			name: "Already Used",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				vt.On("ValidateMagicLinkToken", "link-token").Return(userID, email, linkID, nil)
+				ml.On("Consume", ctx, linkID).Return(uuid.Nil, entity.ErrInvalidMagicLink)
+			},
+			wantErr: entity.ErrInvalidMagicLink,
+		},
+		{
+			name: "Sent Before The Email Changed",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				vt.On("ValidateMagicLinkToken", "link-token").Return(userID, otherEmail, linkID, nil)
+				ml.On("Consume", ctx, linkID).Return(userID, nil)
+				ur.On("FindByID", ctx, userID).Return(restore(true, 0, nil, true), nil)
+			},
+			wantErr: entity.ErrInvalidMagicLink,
+		},
+		{
+			name: "Link Of Another User",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				vt.On("ValidateMagicLinkToken", "link-token").Return(userID, email, linkID, nil)
+				ml.On("Consume", ctx, linkID).Return(uuid.New(), nil)
+			},
+			wantErr: entity.ErrInvalidMagicLink,
+		},
+		{
+			name: "Deactivated User",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				vt.On("ValidateMagicLinkToken", "link-token").Return(userID, email, linkID, nil)
+				ml.On("Consume", ctx, linkID).Return(userID, nil)
+				ur.On("FindByID", ctx, userID).Return(restore(false, 0, nil, true), nil)
+			},
+			wantErr: entity.ErrUserIsDeactivated,
+		},
+		{
+			name: "Locked User",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				vt.On("ValidateMagicLinkToken", "link-token").Return(userID, email, linkID, nil)
+				ml.On("Consume", ctx, linkID).Return(userID, nil)
+				ur.On("FindByID", ctx, userID).Return(restore(true, 5, &lockedUntil, true), nil)
+			},
+			wantErr:    entity.ErrUserBlocked,
+			wantAudit:  []vo.AuditAction{vo.AuditLoginFailed},
+			wantLogins: []vo.LoginOutcome{vo.LoginAccountLocked},
+		},
+		{
+			name: "Invalid Token",
+			setup: func(ur *MockUserRepository, ml *MockMagicLinkRepository, vt *MockVerificationTokenManager, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				vt.On("ValidateMagicLinkToken", "link-token").Return(uuid.Nil, vo.Email(""), uuid.Nil, entity.ErrInvalidMagicLink)
+			},
+			wantErr: entity.ErrInvalidMagicLink,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			ml := new(MockMagicLinkRepository)
+			vt := new(MockVerificationTokenManager)
+			tm := new(MockTokenManager)
+			rr := new(MockRefreshTokenRepository)
+			mfa := new(MockMFARepository)
+			policy := new(MockMFAPolicyRepository)
+			challenges := new(MockMFAChallengeRepository)
+			audit := newMockAuditLog()
+			history := newMockLoginHistory()
+			tt.setup(ur, ml, vt, tm, rr)
+
+			mfa.On("FindByUserID", ctx, userID).Return(tt.mfa, nil).Maybe()
+			policy.On("GetRequiredRoles", ctx).Return([]vo.Role{}, nil).Maybe()
+			if tt.wantChallenge {
+				challenges.On("SaveChallenge", ctx, mock.AnythingOfType("string"), userID, 5*time.Minute).Return(nil)
+			}
+
+			uc := NewConsumeMagicLink(ur, ml, vt, tm, rr, mfa, policy, new(MockStoreMembershipRepository), challenges, audit, history, new(MockNotificationService), new(MockLogger), time.Hour, 5*time.Minute, "Store Manager", 30*24*time.Hour)
+			result, err := uc.Execute(ctx, "link-token", client)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				tm.AssertNotCalled(t, "GenerateTokens", mock.Anything, mock.Anything)
+			case tt.wantChallenge:
+				assert.NoError(t, err)
+				assert.True(t, result.MFARequired())
+				assert.Empty(t, result.AccessToken)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, "access", result.AccessToken)
+				assert.Equal(t, "refresh", result.RefreshToken)
+			}
+
+			if tt.wantAudit != nil {
+				assert.Equal(t, tt.wantAudit, audit.recordedActions())
+			}
+
+			if tt.wantLogins != nil {
+				assert.Equal(t, tt.wantLogins, history.recordedOutcomes())
+			}
+
+			ur.AssertExpectations(t)
+			ml.AssertExpectations(t)
+			vt.AssertExpectations(t)
+			tm.AssertExpectations(t)
+			rr.AssertExpectations(t)
+			challenges.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartMFAEnrollmentUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	passwordVO, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, _ := entity.RestoreUser(userID, "manager@example.com", "manager", passwordVO.String(), []string{"MANAGER"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	secret, _ := vo.GenerateTOTPSecret()
+
+	t.Run("Success - Saves pending secret and returns otpauth uri", func(t *testing.T) {
+		mockUser := new(MockUserRepository)
+		mockMFA := new(MockMFARepository)
+
+		mockUser.On("FindByID", mock.Anything, userID).Return(user, nil)
+		mockMFA.On("FindByUserID", mock.Anything, userID).Return(nil, nil)
+		mockMFA.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.UserMFA) bool {
+			return m.UserID() == userID && !m.IsEnabled()
+		})).Return(nil)
+
+		uc := NewStartMFAEnrollment(mockUser, mockMFA, new(MockLogger), "Store Manager")
+		enrollment, err := uc.Execute(context.Background(), userID)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, enrollment.Secret)
+		assert.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/"))
+		assert.Contains(t, enrollment.URI, enrollment.Secret)
+		mockMFA.AssertExpectations(t)
+	})
+
+	t.Run("Already Enabled", func(t *testing.T) {
+		mockUser := new(MockUserRepository)
+		mockMFA := new(MockMFARepository)
+		enabled, _ := entity.RestoreUserMFA(userID, secret.String(), true, nil, 0)
+
+		mockUser.On("FindByID", mock.Anything, userID).Return(user, nil)
+		mockMFA.On("FindByUserID", mock.Anything, userID).Return(enabled, nil)
+
+		uc := NewStartMFAEnrollment(mockUser, mockMFA, new(MockLogger), "Store Manager")
+		_, err := uc.Execute(context.Background(), userID)
+
+		assert.ErrorIs(t, err, entity.ErrMFAAlreadyEnabled)
+		mockMFA.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestConfirmMFAEnrollmentUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	secret, _ := vo.GenerateTOTPSecret()
+
+	t.Run("Success - Enables and returns recovery codes", func(t *testing.T) {
+		mockMFA := new(MockMFARepository)
+		mockMFA.On("FindByUserID", mock.Anything, userID).Return(entity.NewUserMFA(userID, secret), nil)
+		mockMFA.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.UserMFA) bool {
+			return m.IsEnabled() && len(m.RecoveryCodes()) == recoveryCodesAmount
+		})).Return(nil)
+
+		uc := NewConfirmMFAEnrollment(mockMFA, new(MockLogger))
+		codes, err := uc.Execute(context.Background(), userID, secret.Code(vo.TOTPStep(time.Now())))
+
+		assert.NoError(t, err)
+		assert.Len(t, codes, recoveryCodesAmount)
+		mockMFA.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Code", func(t *testing.T) {
+		mockMFA := new(MockMFARepository)
+		mockMFA.On("FindByUserID", mock.Anything, userID).Return(entity.NewUserMFA(userID, secret), nil)
+
+		uc := NewConfirmMFAEnrollment(mockMFA, new(MockLogger))
+		_, err := uc.Execute(context.Background(), userID, secret.Code(vo.TOTPStep(time.Now())-10))
+
+		assert.ErrorIs(t, err, entity.ErrInvalidMFACode)
+		mockMFA.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Not Enrolled", func(t *testing.T) {
+		mockMFA := new(MockMFARepository)
+		mockMFA.On("FindByUserID", mock.Anything, userID).Return(nil, nil)
+
+		uc := NewConfirmMFAEnrollment(mockMFA, new(MockLogger))
+		_, err := uc.Execute(context.Background(), userID, "123456")
+
+		assert.ErrorIs(t, err, entity.ErrMFANotEnrolled)
+	})
+}
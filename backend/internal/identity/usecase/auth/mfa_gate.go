@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/google/uuid"
+)
+
+// mfaGate stands between a proven first factor, a password or a magic link,
+// and the session: users with MFA enabled, or with a role that requires it,
+// get a challenge instead.
+type mfaGate struct {
+	mfaRepo       ports.MFARepository
+	mfaPolicyRepo ports.MFAPolicyRepository
+	memberships   ports.StoreMembershipRepository
+	challengeRepo ports.MFAChallengeRepository
+	logger        ports.Logger
+	challengeTTL  time.Duration
+	issuer        string
+}
+
+// challenge returns nil when the user may log in with the first factor alone.
+func (g mfaGate) challenge(ctx context.Context, user *entity.User) (*dto.LoginResult, error) {
+	mfa, err := g.mfaRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		g.logger.Error("failed to load mfa settings", err, "userID", user.ID())
+		return nil, fmt.Errorf("finding mfa settings: %w", err)
+	}
+
+	mfaRequired, err := g.isRequired(ctx, user)
+	if err != nil {
+		g.logger.Error("failed to load mfa role policy", err, "userID", user.ID())
+		return nil, fmt.Errorf("loading mfa policy: %w", err)
+	}
+
+	if (mfa != nil && mfa.IsEnabled()) || mfaRequired {
+		return g.issue(ctx, user, mfa)
+	}
+
+	return nil, nil
+}
+
+// isRequired looks at the roles of the active store memberships as well as
+// the global ones: the session can be switched into any of those stores
+// without logging in again.
+func (g mfaGate) isRequired(ctx context.Context, user *entity.User) (bool, error) {
+	requiredRoles, err := g.mfaPolicyRepo.GetRequiredRoles(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if len(requiredRoles) == 0 {
+		return false, nil
+	}
+
+	for _, role := range user.Roles() {
+		if slices.Contains(requiredRoles, role) {
+			return true, nil
+		}
+	}
+
+	memberships, err := g.memberships.ListByUser(ctx, user.ID())
+	if err != nil {
+		return false, err
+	}
+
+	for _, membership := range memberships {
+		if !membership.IsActive() {
+			continue
+		}
+
+		for _, role := range membership.Roles() {
+			if slices.Contains(requiredRoles, role) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// issue stops the login after the first factor. Failed attempts are only
+// reset once the second factor is verified, so guessing codes still counts
+// towards the lockout. A user whose role requires MFA but who never
+// enrolled gets a pending secret, which the first valid code confirms.
+//
+// The secret is only shown when it is created: anyone holding the password
+// would otherwise read it on every login and could finish the enrollment
+// themselves. A user who lost it needs an admin to reset their MFA.
+func (g mfaGate) issue(ctx context.Context, user *entity.User, mfa *entity.UserMFA) (*dto.LoginResult, error) {
+	result := &dto.LoginResult{}
+
+	if mfa == nil {
+		secret, err := vo.GenerateTOTPSecret()
+		if err != nil {
+			g.logger.Error("failed to generate totp secret", err, "userID", user.ID())
+			return nil, fmt.Errorf("generating totp secret: %w", err)
+		}
+
+		mfa = entity.NewUserMFA(user.ID(), secret)
+		if err := g.mfaRepo.Save(ctx, mfa); err != nil {
+			g.logger.Error("failed to save pending mfa enrollment", err, "userID", user.ID())
+			return nil, fmt.Errorf("saving mfa enrollment: %w", err)
+		}
+
+		result.MFAEnrollment = &dto.MFAEnrollment{
+			Secret: secret.String(),
+			URI:    secret.ProvisioningURI(g.issuer, user.Email().String()),
+		}
+	}
+
+	challenge := uuid.New().String()
+	if err := g.challengeRepo.SaveChallenge(ctx, challenge, user.ID(), g.challengeTTL); err != nil {
+		g.logger.Error("failed to save mfa challenge", err, "userID", user.ID())
+		return nil, fmt.Errorf("saving mfa challenge: %w", err)
+	}
+
+	result.MFAChallengeToken = challenge
+
+	g.logger.Info("first factor accepted, waiting for second factor", "userID", user.ID(), "enrolling", result.MFAEnrollment != nil)
+	return result, nil
+}
@@ -38,6 +38,14 @@ func (m *MockUserRepository) FindByEmail(ctx context.Context, email vo.Email) (*
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (m *MockUserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
 func (m *MockUserRepository) GetUsersInfo(ctx context.Context, roles []vo.Role, pagination common.Pagination) (*common.PaginatedResult[*entity.User], error) {
 	args := m.Called(ctx, roles, pagination)
 	if args.Get(0) == nil {
@@ -61,6 +69,16 @@ func (m *MockTokenManager) GenerateTokens(ctx context.Context, user *entity.User
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockTokenManager) GenerateStoreTokens(ctx context.Context, user *entity.User, storeID uuid.UUID) (string, string, error) {
+	args := m.Called(ctx, user, storeID)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *MockTokenManager) GenerateImpersonationToken(ctx context.Context, user *entity.User, impersonation *entity.Impersonation) (string, error) {
+	args := m.Called(ctx, user, impersonation)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockTokenManager) ValidateAccessToken(tokenString string) (*dto.UserClaims, error) {
 	args := m.Called(tokenString)
 	if args.Get(0) == nil {
@@ -74,14 +92,22 @@ type MockRefreshTokenRepository struct {
 	mock.Mock
 }
 
-func (m *MockRefreshTokenRepository) SaveRefreshToken(ctx context.Context, userID uuid.UUID, refreshToken string, expiresIn time.Duration) error {
-	args := m.Called(ctx, userID, refreshToken, expiresIn)
+func (m *MockRefreshTokenRepository) SaveRefreshToken(ctx context.Context, refreshToken *entity.RefreshToken, expiresIn time.Duration) error {
+	args := m.Called(ctx, refreshToken, expiresIn)
 	return args.Error(0)
 }
 
-func (m *MockRefreshTokenRepository) GetUserIDByRefreshToken(ctx context.Context, refreshToken string) (uuid.UUID, error) {
+func (m *MockRefreshTokenRepository) FindRefreshToken(ctx context.Context, refreshToken string) (*entity.RefreshToken, error) {
 	args := m.Called(ctx, refreshToken)
-	return args.Get(0).(uuid.UUID), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) MarkRefreshTokenRotated(ctx context.Context, refreshToken string) (bool, error) {
+	args := m.Called(ctx, refreshToken)
+	return args.Bool(0), args.Error(1)
 }
 
 func (m *MockRefreshTokenRepository) DeleteRefreshToken(ctx context.Context, refreshToken string) error {
@@ -89,6 +115,52 @@ func (m *MockRefreshTokenRepository) DeleteRefreshToken(ctx context.Context, ref
 	return args.Error(0)
 }
 
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, userID uuid.UUID, familyID uuid.UUID) error {
+	args := m.Called(ctx, userID, familyID)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) DeleteAllRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) SaveSession(ctx context.Context, session *entity.Session, expiresIn time.Duration) error {
+	args := m.Called(ctx, session, expiresIn)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) FindSession(ctx context.Context, sessionID uuid.UUID) (*entity.Session, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Session), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Session), args.Error(1)
+}
+
+// newFamilyToken matches the first refresh token of a newly started family
+func newFamilyToken(user *entity.User, token string) any {
+	return mock.MatchedBy(func(t *entity.RefreshToken) bool {
+		return t.UserID() == user.ID() && t.Token() == token && t.Generation() == 0 && t.Parent() == "" &&
+			t.TokenVersion() == user.TokenVersion()
+	})
+}
+
+// newSessionOf matches the session record created alongside a new family
+func newSessionOf(userID uuid.UUID) any {
+	return mock.MatchedBy(func(s *entity.Session) bool {
+		return s.UserID() == userID && s.ID() != uuid.Nil
+	})
+}
+
 // MockNotificationService
 type MockNotificationService struct {
 	mock.Mock
@@ -104,6 +176,91 @@ func (m *MockNotificationService) SendChangePasswordEmail(ctx context.Context, e
 	return args.Error(0)
 }
 
+func (m *MockNotificationService) SendVerificationEmail(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendInvitationEmail(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendAccountLockedEmail(ctx context.Context, email vo.Email, lockedUntil time.Time, token string) error {
+	args := m.Called(ctx, email, lockedUntil, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendEmailChangeConfirmation(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendEmailChangeNotice(ctx context.Context, email vo.Email, newEmail vo.Email) error {
+	args := m.Called(ctx, email, newEmail)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendNewDeviceLoginAlert(ctx context.Context, email vo.Email, device vo.DeviceFingerprint, ipAddress string, at time.Time) error {
+	args := m.Called(ctx, email, device, ipAddress, at)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendMagicLinkEmail(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendAccountCreatedEmail(ctx context.Context, email vo.Email, username string, token string) error {
+	args := m.Called(ctx, email, username, token)
+	return args.Error(0)
+}
+
+// MockVerificationTokenManager
+type MockVerificationTokenManager struct {
+	mock.Mock
+}
+
+func (m *MockVerificationTokenManager) GenerateVerificationToken(user *entity.User) (string, error) {
+	args := m.Called(user)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateVerificationToken(tokenString string) (uuid.UUID, vo.Email, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(vo.Email), args.Error(2)
+}
+
+func (m *MockVerificationTokenManager) GenerateUnlockToken(user *entity.User) (string, error) {
+	args := m.Called(user)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateUnlockToken(tokenString string) (uuid.UUID, time.Time, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *MockVerificationTokenManager) GenerateEmailChangeToken(user *entity.User, newEmail vo.Email) (string, error) {
+	args := m.Called(user, newEmail)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateEmailChangeToken(tokenString string) (uuid.UUID, vo.Email, vo.Email, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(vo.Email), args.Get(2).(vo.Email), args.Error(3)
+}
+
+func (m *MockVerificationTokenManager) GenerateMagicLinkToken(user *entity.User, linkID uuid.UUID) (string, error) {
+	args := m.Called(user, linkID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateMagicLinkToken(tokenString string) (uuid.UUID, vo.Email, uuid.UUID, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(vo.Email), args.Get(2).(uuid.UUID), args.Error(3)
+}
+
 // MockOTPRepository
 type MockOTPRepository struct {
 	mock.Mock
@@ -114,12 +271,14 @@ func (m *MockOTPRepository) SaveOTP(ctx context.Context, email vo.Email, otp vo.
 	return args.Error(0)
 }
 
-func (m *MockOTPRepository) GetOTP(ctx context.Context, email vo.Email) (vo.OTP, error) {
+func (m *MockOTPRepository) VerifyOTP(ctx context.Context, email vo.Email, otp vo.OTP) (bool, error) {
+	args := m.Called(ctx, email, otp)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOTPRepository) IncrementOTPAttempts(ctx context.Context, email vo.Email) (int, error) {
 	args := m.Called(ctx, email)
-	if args.Get(0) == nil {
-		return "", args.Error(1)
-	}
-	return args.Get(0).(vo.OTP), args.Error(1)
+	return args.Int(0), args.Error(1)
 }
 
 func (m *MockOTPRepository) DeleteOTP(ctx context.Context, email vo.Email) error {
@@ -127,6 +286,87 @@ func (m *MockOTPRepository) DeleteOTP(ctx context.Context, email vo.Email) error
 	return args.Error(0)
 }
 
+func (m *MockOTPRepository) StartOTPCooldown(ctx context.Context, email vo.Email, duration time.Duration) (bool, error) {
+	args := m.Called(ctx, email, duration)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockMFARepository
+type MockMFARepository struct {
+	mock.Mock
+}
+
+func (m *MockMFARepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserMFA), args.Error(1)
+}
+
+func (m *MockMFARepository) Save(ctx context.Context, mfa *entity.UserMFA) error {
+	args := m.Called(ctx, mfa)
+	return args.Error(0)
+}
+
+func (m *MockMFARepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// MockMFAPolicyRepository
+type MockMFAPolicyRepository struct {
+	mock.Mock
+}
+
+func (m *MockMFAPolicyRepository) GetRequiredRoles(ctx context.Context) ([]vo.Role, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]vo.Role), args.Error(1)
+}
+
+func (m *MockMFAPolicyRepository) SetRoleRequirement(ctx context.Context, role vo.Role, required bool) error {
+	args := m.Called(ctx, role, required)
+	return args.Error(0)
+}
+
+// MockMFAChallengeRepository
+type MockMFAChallengeRepository struct {
+	mock.Mock
+}
+
+func (m *MockMFAChallengeRepository) SaveChallenge(ctx context.Context, challenge string, userID uuid.UUID, expiresIn time.Duration) error {
+	args := m.Called(ctx, challenge, userID, expiresIn)
+	return args.Error(0)
+}
+
+func (m *MockMFAChallengeRepository) GetUserIDByChallenge(ctx context.Context, challenge string) (uuid.UUID, error) {
+	args := m.Called(ctx, challenge)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *MockMFAChallengeRepository) DeleteChallenge(ctx context.Context, challenge string) error {
+	args := m.Called(ctx, challenge)
+	return args.Error(0)
+}
+
+// MockMagicLinkRepository implements ports.MagicLinkRepository for testing
+type MockMagicLinkRepository struct {
+	mock.Mock
+}
+
+func (m *MockMagicLinkRepository) Save(ctx context.Context, linkID uuid.UUID, userID uuid.UUID, expiresIn time.Duration) error {
+	args := m.Called(ctx, linkID, userID, expiresIn)
+	return args.Error(0)
+}
+
+func (m *MockMagicLinkRepository) Consume(ctx context.Context, linkID uuid.UUID) (uuid.UUID, error) {
+	args := m.Called(ctx, linkID)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
 // MockLogger implements ports.Logger for testing
 type MockLogger struct {
 	mock.Mock
@@ -137,3 +377,249 @@ func (m *MockLogger) Info(msg string, keysAndValues ...any) {}
 func (m *MockLogger) Error(msg string, err error, keysAndValues ...any) {}
 
 func (m *MockLogger) Debug(msg string, keysAndValues ...any) {}
+
+// MockAPIKeyRepository implements ports.APIKeyRepository for testing
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Save(ctx context.Context, key *entity.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.APIKey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) FindByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) List(ctx context.Context, storeID *uuid.UUID) ([]*entity.APIKey, error) {
+	args := m.Called(ctx, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) UpdateRevokedAt(ctx context.Context, key *entity.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) UpdateLastUsedAt(ctx context.Context, key *entity.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// staticRoleCatalog implements ports.RoleCatalogProvider over a fixed catalog
+type staticRoleCatalog struct {
+	catalog vo.RoleCatalog
+}
+
+func newStaticRoleCatalog(catalog vo.RoleCatalog) *staticRoleCatalog {
+	return &staticRoleCatalog{catalog: catalog}
+}
+
+func (c *staticRoleCatalog) Catalog(ctx context.Context) (vo.RoleCatalog, error) {
+	return c.catalog, nil
+}
+
+func (c *staticRoleCatalog) Invalidate() {}
+
+// MockStoreMembershipRepository implements ports.StoreMembershipRepository for testing
+type MockStoreMembershipRepository struct {
+	mock.Mock
+}
+
+func (m *MockStoreMembershipRepository) Save(ctx context.Context, membership *entity.StoreMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Update(ctx context.Context, membership *entity.StoreMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Delete(ctx context.Context, userID, storeID uuid.UUID) error {
+	args := m.Called(ctx, userID, storeID)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Find(ctx context.Context, userID, storeID uuid.UUID) (*entity.StoreMembership, error) {
+	args := m.Called(ctx, userID, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.StoreMembership), args.Error(1)
+}
+
+func (m *MockStoreMembershipRepository) ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.StoreMembership, error) {
+	args := m.Called(ctx, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StoreMembership), args.Error(1)
+}
+
+func (m *MockStoreMembershipRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.StoreMembership, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StoreMembership), args.Error(1)
+}
+
+// stubPasswordCorpus implements vo.PasswordCorpus for testing
+type stubPasswordCorpus map[string]bool
+
+func (c stubPasswordCorpus) Contains(plainText string) bool {
+	return c[plainText]
+}
+
+// MockPasswordHistoryRepository implements ports.PasswordHistoryRepository for testing
+type MockPasswordHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordHistoryRepository) Add(ctx context.Context, userID uuid.UUID, password vo.Password, keep int) error {
+	args := m.Called(ctx, userID, password, keep)
+	return args.Error(0)
+}
+
+func (m *MockPasswordHistoryRepository) ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]vo.Password, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]vo.Password), args.Error(1)
+}
+
+func (m *MockPasswordHistoryRepository) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// MockStorePasswordPolicyRepository implements ports.StorePasswordPolicyRepository for testing
+type MockStorePasswordPolicyRepository struct {
+	mock.Mock
+}
+
+func (m *MockStorePasswordPolicyRepository) Save(ctx context.Context, policy *entity.StorePasswordPolicy) error {
+	args := m.Called(ctx, policy)
+	return args.Error(0)
+}
+
+func (m *MockStorePasswordPolicyRepository) Find(ctx context.Context, storeID uuid.UUID) (*entity.StorePasswordPolicy, error) {
+	args := m.Called(ctx, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.StorePasswordPolicy), args.Error(1)
+}
+
+func (m *MockStorePasswordPolicyRepository) MaxHistorySizeForUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+// MockAuditEventRepository implements ports.AuditEventRepository for testing
+type MockAuditEventRepository struct {
+	mock.Mock
+}
+
+// newMockAuditLog accepts every event, so tests only assert on the trail when
+// it is what they cover.
+func newMockAuditLog() *MockAuditEventRepository {
+	m := new(MockAuditEventRepository)
+	m.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return m
+}
+
+func (m *MockAuditEventRepository) Append(ctx context.Context, event *entity.AuditEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAuditEventRepository) List(ctx context.Context, filter dto.AuditFilter, pagination common.Pagination) (*common.PaginatedResult[*entity.AuditEvent], error) {
+	args := m.Called(ctx, filter, pagination)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*common.PaginatedResult[*entity.AuditEvent]), args.Error(1)
+}
+
+// recordedActions lists the actions appended to the audit log, in order.
+func (m *MockAuditEventRepository) recordedActions() []vo.AuditAction {
+	var actions []vo.AuditAction
+	for _, call := range m.Calls {
+		if call.Method == "Append" {
+			actions = append(actions, call.Arguments.Get(1).(*entity.AuditEvent).Action())
+		}
+	}
+	return actions
+}
+
+// MockLoginHistoryRepository implements ports.LoginHistoryRepository for testing
+type MockLoginHistoryRepository struct {
+	mock.Mock
+}
+
+// newMockLoginHistory accepts every attempt and knows every device, so tests
+// only set up the history when the new device alert is what they cover.
+func newMockLoginHistory() *MockLoginHistoryRepository {
+	m := new(MockLoginHistoryRepository)
+	m.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	m.On("SeenFrom", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	return m
+}
+
+func (m *MockLoginHistoryRepository) Append(ctx context.Context, attempt *entity.LoginAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func (m *MockLoginHistoryRepository) List(ctx context.Context, userID uuid.UUID, pagination common.Pagination) (*common.PaginatedResult[*entity.LoginAttempt], error) {
+	args := m.Called(ctx, userID, pagination)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*common.PaginatedResult[*entity.LoginAttempt]), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) SeenFrom(ctx context.Context, userID uuid.UUID, device vo.DeviceFingerprint, ipAddress string, since time.Time) (bool, error) {
+	args := m.Called(ctx, userID, device, ipAddress, since)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) HasSucceeded(ctx context.Context, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// recordedOutcomes lists the outcomes appended to the login history, in order.
+func (m *MockLoginHistoryRepository) recordedOutcomes() []vo.LoginOutcome {
+	var outcomes []vo.LoginOutcome
+	for _, call := range m.Calls {
+		if call.Method == "Append" {
+			outcomes = append(outcomes, call.Arguments.Get(1).(*entity.LoginAttempt).Outcome())
+		}
+	}
+	return outcomes
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+)
+
+// passwordHistory decides which former passwords a user may not reuse. The
+// size counts the current password, so 1 only blocks keeping the same one.
+// It is the largest of the server default and the policies of the stores the
+// user is an active member of.
+type passwordHistory struct {
+	history     ports.PasswordHistoryRepository
+	policies    ports.StorePasswordPolicyRepository
+	defaultSize int
+}
+
+func (h passwordHistory) size(ctx context.Context, user *entity.User) (int, error) {
+	storeSize, err := h.policies.MaxHistorySizeForUser(ctx, user.ID())
+	if err != nil {
+		return 0, fmt.Errorf("loading store password policies: %w", err)
+	}
+
+	return min(max(h.defaultSize, storeSize), vo.MaxPasswordHistory), nil
+}
+
+// previous returns the current hash followed by the most recent former ones,
+// newest first, as vo.PasswordCandidate expects.
+func (h passwordHistory) previous(ctx context.Context, user *entity.User, size int) ([]vo.Password, error) {
+	if size <= 0 {
+		return nil, nil
+	}
+
+	former, err := h.history.ListRecent(ctx, user.ID(), size-1)
+	if err != nil {
+		return nil, fmt.Errorf("loading password history: %w", err)
+	}
+
+	return append([]vo.Password{user.Password()}, former...), nil
+}
+
+// record moves the current hash into the history before it is replaced. If
+// the password change fails afterwards the entry only repeats the current
+// password, which is blocked anyway.
+func (h passwordHistory) record(ctx context.Context, user *entity.User, size int) error {
+	if err := h.history.Add(ctx, user.ID(), user.Password(), size-1); err != nil {
+		return fmt.Errorf("recording password history: %w", err)
+	}
+
+	return nil
+}
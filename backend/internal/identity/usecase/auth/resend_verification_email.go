@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+)
+
+type resendVerificationEmailUseCase struct {
+	userRepo            ports.UserRepository
+	verificationToken   security.VerificationTokenManager
+	notificationService ports.NotificationService
+	logger              ports.Logger
+}
+
+func NewResendVerificationEmail(
+	userRepo ports.UserRepository,
+	verificationToken security.VerificationTokenManager,
+	notificationService ports.NotificationService,
+	logger ports.Logger,
+) security.ResendVerificationEmailUseCase {
+	return &resendVerificationEmailUseCase{
+		userRepo:            userRepo,
+		verificationToken:   verificationToken,
+		notificationService: notificationService,
+		logger:              logger,
+	}
+}
+
+func (uc *resendVerificationEmailUseCase) Execute(ctx context.Context, email string) error {
+	uc.logger.Debug("starting resend verification email", "email", email)
+
+	emailVO, err := vo.NewEmail(email)
+	if err != nil {
+		uc.logger.Info("invalid email provided in resend verification", "email", email)
+		return err
+	}
+
+	// unknown and already verified addresses get the same answer as a
+	// successful resend to avoid user enumeration
+	user, err := uc.userRepo.FindByEmail(ctx, emailVO)
+	if err != nil {
+		uc.logger.Error("error finding user by email", err, "email", email)
+		return nil
+	}
+
+	if user == nil {
+		uc.logger.Info("user not found in resend verification", "email", email)
+		return nil
+	}
+
+	if user.EmailVerified() {
+		uc.logger.Info("email already verified, skipping resend", "userID", user.ID())
+		return nil
+	}
+
+	token, err := uc.verificationToken.GenerateVerificationToken(user)
+	if err != nil {
+		uc.logger.Error("failed to generate verification token", err, "userID", user.ID())
+		return err
+	}
+
+	if err := uc.notificationService.SendVerificationEmail(ctx, user.Email(), token); err != nil {
+		uc.logger.Error("failed to send verification email", err, "userID", user.ID())
+		return err
+	}
+
+	uc.logger.Info("verification email resent successfully", "userID", user.ID())
+	return nil
+}
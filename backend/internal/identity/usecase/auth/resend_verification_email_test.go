@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResendVerificationEmailUseCase_Execute(t *testing.T) {
+	emailStr := "test@example.com"
+	emailVO, _ := vo.NewEmail(emailStr)
+	unverified, _ := entity.NewUser(emailVO, "testuser", vo.Password("hash"), []vo.Role{vo.EmployeeRole})
+	verified, _ := entity.RestoreUser(uuid.New(), emailStr, "testuser", "hash", []string{"EMPLOYEE"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+
+	tests := []struct {
+		name      string
+		email     string
+		setup     func(*MockUserRepository, *MockVerificationTokenManager, *MockNotificationService)
+		expectErr bool
+	}{
+		{
+			name:  "Success",
+			email: emailStr,
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(unverified, nil)
+				vt.On("GenerateVerificationToken", unverified).Return("token", nil)
+				ns.On("SendVerificationEmail", mock.Anything, emailVO, "token").Return(nil)
+			},
+		},
+		{
+			name:  "Already Verified Sends Nothing",
+			email: emailStr,
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(verified, nil)
+			},
+		},
+		{
+			name:  "User Not Found",
+			email: emailStr,
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(nil, nil)
+			},
+		},
+		{
+			name:      "Invalid Email",
+			email:     "invalid",
+			setup:     func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {},
+			expectErr: true,
+		},
+		{
+			name:  "Send Email Error",
+			email: emailStr,
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(unverified, nil)
+				vt.On("GenerateVerificationToken", unverified).Return("token", nil)
+				ns.On("SendVerificationEmail", mock.Anything, emailVO, "token").Return(assert.AnError)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			vt := new(MockVerificationTokenManager)
+			ns := new(MockNotificationService)
+			ml := new(MockLogger)
+
+			tt.setup(ur, vt, ns)
+
+			uc := NewResendVerificationEmail(ur, vt, ns, ml)
+			err := uc.Execute(context.Background(), tt.email)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			ur.AssertExpectations(t)
+			vt.AssertExpectations(t)
+			ns.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+)
+
+type resetPasswordUseCase struct {
+	userRepo    ports.UserRepository
+	otpRepo     ports.OTPRepository
+	refreshRepo ports.RefreshTokenRepository
+	audit       ports.AuditEventRepository
+	logger      ports.Logger
+	peppers     vo.Peppers
+	policy      vo.PasswordPolicy
+	history     passwordHistory
+	maxAttempts int
+}
+
+func NewResetPassword(
+	userRepo ports.UserRepository,
+	otpRepo ports.OTPRepository,
+	refreshRepo ports.RefreshTokenRepository,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	peppers vo.Peppers,
+	policy vo.PasswordPolicy,
+	history ports.PasswordHistoryRepository,
+	storePolicies ports.StorePasswordPolicyRepository,
+	defaultHistorySize int,
+	maxAttempts int,
+) security.ResetPasswordUseCase {
+	return &resetPasswordUseCase{
+		userRepo:    userRepo,
+		otpRepo:     otpRepo,
+		refreshRepo: refreshRepo,
+		audit:       audit,
+		logger:      logger,
+		peppers:     peppers,
+		policy:      policy,
+		history: passwordHistory{
+			history:     history,
+			policies:    storePolicies,
+			defaultSize: defaultHistorySize,
+		},
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (uc *resetPasswordUseCase) Execute(ctx context.Context, email, otp, newPassword string) error {
+	uc.logger.Debug("starting password reset", "email", email)
+
+	emailVO, err := vo.NewEmail(email)
+	if err != nil {
+		uc.logger.Info("invalid email provided in password reset", "email", email)
+		return err
+	}
+
+	otpVO, err := vo.NewOTP(otp)
+	if err != nil {
+		uc.logger.Info("invalid otp format in password reset", "email", email)
+		return err
+	}
+
+	user, err := uc.userRepo.FindByEmail(ctx, emailVO)
+	if err != nil {
+		uc.logger.Error("error finding user during password reset", err, "email", email)
+		return fmt.Errorf("finding user by email: %w", err)
+	}
+
+	if user == nil {
+		uc.logger.Info("password reset for unknown user", "email", email)
+		return entity.ErrInvalidOTP
+	}
+
+	// the attempt is counted before the code is compared, so concurrent
+	// guesses cannot all be compared before any of them is counted
+	attempts, err := uc.otpRepo.IncrementOTPAttempts(ctx, user.Email())
+	if err != nil {
+		if errors.Is(err, entity.ErrOTPNotFound) {
+			uc.logger.Info("no pending otp for password reset", "userID", user.ID())
+			return entity.ErrInvalidOTP
+		}
+
+		uc.logger.Error("failed to count otp attempt", err, "userID", user.ID())
+		return fmt.Errorf("counting otp attempt: %w", err)
+	}
+
+	if attempts > uc.maxAttempts {
+		return uc.exhaustOTP(ctx, user, attempts)
+	}
+
+	matches, err := uc.otpRepo.VerifyOTP(ctx, user.Email(), otpVO)
+	if err != nil {
+		if errors.Is(err, entity.ErrOTPNotFound) {
+			uc.logger.Info("no pending otp for password reset", "userID", user.ID())
+			return entity.ErrInvalidOTP
+		}
+
+		uc.logger.Error("failed to verify otp", err, "userID", user.ID())
+		return fmt.Errorf("verifying otp: %w", err)
+	}
+
+	if !matches {
+		if attempts < uc.maxAttempts {
+			uc.logger.Info("wrong otp provided in password reset", "userID", user.ID(), "attempts", attempts)
+			return entity.ErrInvalidOTP
+		}
+
+		return uc.exhaustOTP(ctx, user, attempts)
+	}
+
+	// the otp stays valid when the new password is rejected, so the user can
+	// retry with a stronger one without requesting another code, as long as
+	// attempts remain
+	historySize, err := uc.history.size(ctx, user)
+	if err != nil {
+		uc.logger.Error("failed to resolve password history size", err, "userID", user.ID())
+		return err
+	}
+
+	previous, err := uc.history.previous(ctx, user, historySize)
+	if err != nil {
+		uc.logger.Error("failed to load password history", err, "userID", user.ID())
+		return err
+	}
+
+	candidate := vo.PasswordCandidate{
+		PlainText: newPassword,
+		Username:  user.Username(),
+		Email:     user.Email(),
+		Previous:  previous,
+	}
+	if err := uc.policy.Check(candidate); err != nil {
+		uc.logger.Info("new password rejected by policy", "userID", user.ID(), "reason", err)
+		return err
+	}
+
+	passwordVO, err := vo.NewPassword(newPassword, uc.peppers)
+	if err != nil {
+		uc.logger.Info("new password rejected in password reset", "userID", user.ID())
+		return err
+	}
+
+	if err := uc.history.record(ctx, user, historySize); err != nil {
+		uc.logger.Error("failed to record password history", err, "userID", user.ID())
+		return err
+	}
+
+	user.ChangePassword(passwordVO)
+	user.ResetFailedAttempts()
+	user.RevokeSessions()
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error("failed to update user password", err, "userID", user.ID())
+		return fmt.Errorf("updating user password: %w", err)
+	}
+
+	if err := uc.otpRepo.DeleteOTP(ctx, user.Email()); err != nil {
+		uc.logger.Error("failed to delete used otp", err, "userID", user.ID())
+		return fmt.Errorf("deleting otp: %w", err)
+	}
+
+	if err := uc.refreshRepo.DeleteAllRefreshTokens(ctx, user.ID()); err != nil {
+		uc.logger.Error("failed to revoke refresh tokens", err, "userID", user.ID())
+		return fmt.Errorf("revoking refresh tokens: %w", err)
+	}
+
+	// the emailed code proves control of the mailbox, not an authenticated
+	// session, so the reset has no actor
+	auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditPasswordReset, nil, user, dto.ClientInfoFromContext(ctx)))
+
+	uc.logger.Info("password reset successfully", "userID", user.ID())
+	return nil
+}
+
+func (uc *resetPasswordUseCase) exhaustOTP(ctx context.Context, user *entity.User, attempts int) error {
+	uc.logger.Info("otp invalidated after too many wrong attempts", "userID", user.ID(), "attempts", attempts)
+	if err := uc.otpRepo.DeleteOTP(ctx, user.Email()); err != nil && !errors.Is(err, entity.ErrOTPNotFound) {
+		uc.logger.Error("failed to delete exhausted otp", err, "userID", user.ID())
+		return fmt.Errorf("deleting exhausted otp: %w", err)
+	}
+
+	return entity.ErrOTPExhausted
+}
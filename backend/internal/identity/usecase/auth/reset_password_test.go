@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResetPasswordUseCase_Execute(t *testing.T) {
+	pepper := vo.SinglePepper("pepper")
+	emailStr := "test@example.com"
+	emailVO, _ := vo.NewEmail(emailStr)
+	otp := vo.OTP("123456")
+	newPass := "NewPass123!"
+	oldPass, _ := vo.NewPassword("OldPass123!", pepper)
+	maxAttempts := 5
+	policy := vo.DefaultPasswordPolicy(stubPasswordCorpus{}, pepper)
+
+	setupUser := func() *entity.User {
+		lockedUntil := time.Now().Add(time.Hour)
+		user, _ := entity.RestoreUser(uuid.New(), emailStr, "testuser", oldPass.String(), []string{"EMPLOYEE"}, true, 5, &lockedUntil, true, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+
+	tests := []struct {
+		name        string
+		otp         string
+		newPassword string
+		setup       func(*MockUserRepository, *MockOTPRepository, *MockRefreshTokenRepository, *entity.User)
+		wantErr     error
+		expectErr   bool
+	}{
+		{
+			name:        "Success",
+			otp:         otp.String(),
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(1, nil)
+				or.On("VerifyOTP", mock.Anything, emailVO, otp).Return(true, nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.Password().Matches(newPass, pepper) && u.FailedAttempts() == 0 && u.LockedUntil() == nil && u.TokenVersion() == 1
+				})).Return(nil)
+				or.On("DeleteOTP", mock.Anything, emailVO).Return(nil)
+				rr.On("DeleteAllRefreshTokens", mock.Anything, user.ID()).Return(nil)
+			},
+		},
+		{
+			name:        "Wrong OTP",
+			otp:         "654321",
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(1, nil)
+				or.On("VerifyOTP", mock.Anything, emailVO, vo.OTP("654321")).Return(false, nil)
+			},
+			wantErr:   entity.ErrInvalidOTP,
+			expectErr: true,
+		},
+		{
+			name:        "Wrong OTP Exhausts Attempts",
+			otp:         "654321",
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(maxAttempts, nil)
+				or.On("VerifyOTP", mock.Anything, emailVO, vo.OTP("654321")).Return(false, nil)
+				or.On("DeleteOTP", mock.Anything, emailVO).Return(nil)
+			},
+			wantErr:   entity.ErrOTPExhausted,
+			expectErr: true,
+		},
+		{
+			name:        "Attempts Already Exhausted - Right OTP Not Compared",
+			otp:         otp.String(),
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(maxAttempts+1, nil)
+				or.On("DeleteOTP", mock.Anything, emailVO).Return(nil)
+			},
+			wantErr:   entity.ErrOTPExhausted,
+			expectErr: true,
+		},
+		{
+			name:        "No Pending OTP",
+			otp:         otp.String(),
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(0, entity.ErrOTPNotFound)
+			},
+			wantErr:   entity.ErrInvalidOTP,
+			expectErr: true,
+		},
+		{
+			name:        "Unknown User",
+			otp:         otp.String(),
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(nil, nil)
+			},
+			wantErr:   entity.ErrInvalidOTP,
+			expectErr: true,
+		},
+		{
+			name:        "Malformed OTP",
+			otp:         "12ab",
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+			},
+			wantErr:   vo.ErrInvalidOTPFormat,
+			expectErr: true,
+		},
+		{
+			name:        "Weak New Password Keeps OTP",
+			otp:         otp.String(),
+			newPassword: "password",
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(1, nil)
+				or.On("VerifyOTP", mock.Anything, emailVO, otp).Return(true, nil)
+			},
+			wantErr:   vo.ErrLowPasswordComplexity,
+			expectErr: true,
+		},
+		{
+			name:        "Current Password Reused",
+			otp:         otp.String(),
+			newPassword: "OldPass123!",
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(1, nil)
+				or.On("VerifyOTP", mock.Anything, emailVO, otp).Return(true, nil)
+			},
+			wantErr:   vo.ErrPasswordReused,
+			expectErr: true,
+		},
+		{
+			name:        "Revoke Sessions Error",
+			otp:         otp.String(),
+			newPassword: newPass,
+			setup: func(ur *MockUserRepository, or *MockOTPRepository, rr *MockRefreshTokenRepository, user *entity.User) {
+				ur.On("FindByEmail", mock.Anything, emailVO).Return(user, nil)
+				or.On("IncrementOTPAttempts", mock.Anything, emailVO).Return(1, nil)
+				or.On("VerifyOTP", mock.Anything, emailVO, otp).Return(true, nil)
+				ur.On("Update", mock.Anything, mock.Anything).Return(nil)
+				or.On("DeleteOTP", mock.Anything, emailVO).Return(nil)
+				rr.On("DeleteAllRefreshTokens", mock.Anything, user.ID()).Return(assert.AnError)
+			},
+			wantErr:   assert.AnError,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			or := new(MockOTPRepository)
+			rr := new(MockRefreshTokenRepository)
+			ml := new(MockLogger)
+			hr := new(MockPasswordHistoryRepository)
+			sp := new(MockStorePasswordPolicyRepository)
+			sp.On("MaxHistorySizeForUser", mock.Anything, mock.Anything).Return(0, nil).Maybe()
+			hr.On("ListRecent", mock.Anything, mock.Anything, 0).Return(nil, nil).Maybe()
+			hr.On("Add", mock.Anything, mock.Anything, mock.Anything, 0).Return(nil).Maybe()
+			user := setupUser()
+
+			tt.setup(ur, or, rr, user)
+
+			uc := NewResetPassword(ur, or, rr, newMockAuditLog(), ml, pepper, policy, hr, sp, 1, maxAttempts)
+			err := uc.Execute(context.Background(), emailStr, tt.otp, tt.newPassword)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			ur.AssertExpectations(t)
+			or.AssertExpectations(t)
+			rr.AssertExpectations(t)
+		})
+	}
+}
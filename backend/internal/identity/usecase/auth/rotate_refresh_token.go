@@ -7,14 +7,18 @@ import (
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
 )
 
 type rotateRefreshTokenUseCase struct {
 	userRepo     ports.UserRepository
 	refreshRepo  ports.RefreshTokenRepository
 	tokenManager security.TokenManager
+	audit        ports.AuditEventRepository
 	logger       ports.Logger
 	expiresIn    time.Duration
 }
@@ -23,6 +27,7 @@ func NewRotateRefreshTokenUseCase(
 	userRepo ports.UserRepository,
 	refreshRepo ports.RefreshTokenRepository,
 	tokenManger security.TokenManager,
+	audit ports.AuditEventRepository,
 	logger ports.Logger,
 	expiresIn time.Duration,
 ) security.RotateRefreshTokenUseCase {
@@ -30,18 +35,31 @@ func NewRotateRefreshTokenUseCase(
 		userRepo:     userRepo,
 		refreshRepo:  refreshRepo,
 		tokenManager: tokenManger,
+		audit:        audit,
 		logger:       logger,
 		expiresIn:    expiresIn,
 	}
 }
 
-func (uc *rotateRefreshTokenUseCase) Execute(ctx context.Context, refreshToken string) (*dto.LoginResult, error) {
+func (uc *rotateRefreshTokenUseCase) Execute(ctx context.Context, refreshToken string, client dto.ClientInfo) (*dto.LoginResult, error) {
 	uc.logger.Debug("starting refresh token rotation")
 
-	userID, err := uc.refreshRepo.GetUserIDByRefreshToken(ctx, refreshToken)
+	current, err := uc.refreshRepo.FindRefreshToken(ctx, refreshToken)
 	if err != nil {
-		uc.logger.Info("failed to get user ID from refresh token (invalid or expired)", "error", err)
-		return nil, fmt.Errorf("getting user ID from refresh token: %w", err)
+		uc.logger.Info("failed to find refresh token (invalid or expired)", "error", err)
+		return nil, fmt.Errorf("finding refresh token: %w", err)
+	}
+
+	return uc.rotate(ctx, current, client, current.StoreID())
+}
+
+// rotate replaces current with the next token of its family, with storeID as
+// the active store of the new tokens.
+func (uc *rotateRefreshTokenUseCase) rotate(ctx context.Context, current *entity.RefreshToken, client dto.ClientInfo, storeID *uuid.UUID) (*dto.LoginResult, error) {
+	userID := current.UserID()
+
+	if current.IsRotated() {
+		return nil, uc.revokeReusedFamily(ctx, current, client)
 	}
 
 	user, err := uc.userRepo.FindByID(ctx, userID)
@@ -60,25 +78,112 @@ func (uc *rotateRefreshTokenUseCase) Execute(ctx context.Context, refreshToken s
 		return nil, entity.ErrUserIsDeactivated
 	}
 
-	if err := uc.refreshRepo.DeleteRefreshToken(ctx, refreshToken); err != nil {
-		uc.logger.Error("failed to delete old refresh token", err, "userID", userID)
-		return nil, fmt.Errorf("deleting old refresh token: %w", err)
+	if current.TokenVersion() != user.TokenVersion() {
+		return nil, uc.revokeStaleFamily(ctx, current)
+	}
+
+	alreadyRotated, err := uc.refreshRepo.MarkRefreshTokenRotated(ctx, current.Token())
+	if err != nil {
+		uc.logger.Error("failed to mark refresh token as rotated", err, "userID", userID)
+		return nil, fmt.Errorf("marking refresh token as rotated: %w", err)
+	}
+
+	if alreadyRotated {
+		return nil, uc.revokeReusedFamily(ctx, current, client)
 	}
 
-	accessToken, refreshToken, err := uc.tokenManager.GenerateTokens(ctx, user)
+	accessToken, newRefreshToken, err := uc.generateTokens(ctx, user, storeID)
 	if err != nil {
 		uc.logger.Error("failed to generate new tokens", err, "userID", userID)
 		return nil, fmt.Errorf("generating new tokens: %w", err)
 	}
 
-	if err := uc.refreshRepo.SaveRefreshToken(ctx, user.ID(), refreshToken, uc.expiresIn); err != nil {
+	if err := uc.refreshRepo.SaveRefreshToken(ctx, current.RotateInto(newRefreshToken, storeID), uc.expiresIn); err != nil {
 		uc.logger.Error("failed to save new refresh token", err, "userID", userID)
 		return nil, fmt.Errorf("saving new refresh token: %w", err)
 	}
 
-	uc.logger.Info("tokens rotated successfully", "userID", userID)
+	uc.touchSession(ctx, current, client)
+
+	event := userAuditEvent(ctx, vo.AuditTokenRotated, &userID, user, client).
+		WithChange("generation", current.Generation(), current.Generation()+1)
+	if !sameStore(current.StoreID(), storeID) {
+		event.WithChange("store_id", current.StoreID(), storeID)
+	}
+	auditlog.Record(ctx, uc.audit, uc.logger, event)
+
+	uc.logger.Info("tokens rotated successfully", "userID", userID, "familyID", current.FamilyID(), "generation", current.Generation()+1)
 	return &dto.LoginResult{
 		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		RefreshToken: newRefreshToken,
 	}, nil
 }
+
+func (uc *rotateRefreshTokenUseCase) generateTokens(ctx context.Context, user *entity.User, storeID *uuid.UUID) (string, string, error) {
+	if storeID == nil {
+		return uc.tokenManager.GenerateTokens(ctx, user)
+	}
+
+	return uc.tokenManager.GenerateStoreTokens(ctx, user, *storeID)
+}
+
+// revokeReusedFamily handles a refresh token presented after it was already
+// rotated. Either the legitimate client or an attacker holds a copy, and there
+// is no telling which, so the whole family is revoked and both must log in again.
+func (uc *rotateRefreshTokenUseCase) revokeReusedFamily(ctx context.Context, token *entity.RefreshToken, client dto.ClientInfo) error {
+	uc.logger.Error("security event: refresh token reuse detected", entity.ErrRefreshTokenReused,
+		"userID", token.UserID(),
+		"familyID", token.FamilyID(),
+		"generation", token.Generation(),
+	)
+
+	if err := uc.refreshRepo.RevokeFamily(ctx, token.UserID(), token.FamilyID()); err != nil {
+		uc.logger.Error("failed to revoke refresh token family", err, "userID", token.UserID(), "familyID", token.FamilyID())
+		return fmt.Errorf("revoking refresh token family: %w", err)
+	}
+
+	// whoever presented the token is not known to be the user, so the event
+	// has no actor
+	targetID := token.UserID()
+	auditlog.Record(ctx, uc.audit, uc.logger, entity.NewAuditEvent(vo.AuditTokenReuseDetected, nil, &targetID, client.IPAddress, client.UserAgent, time.Now()).
+		WithChange("family_id", token.FamilyID(), nil))
+
+	return entity.ErrRefreshTokenReused
+}
+
+// revokeStaleFamily ends a session that was started before the user's
+// sessions were revoked, e.g. by a password change.
+func (uc *rotateRefreshTokenUseCase) revokeStaleFamily(ctx context.Context, token *entity.RefreshToken) error {
+	uc.logger.Info("token rotation failed: session was revoked", "userID", token.UserID(), "familyID", token.FamilyID())
+
+	if err := uc.refreshRepo.RevokeFamily(ctx, token.UserID(), token.FamilyID()); err != nil {
+		uc.logger.Error("failed to revoke refresh token family", err, "userID", token.UserID(), "familyID", token.FamilyID())
+		return fmt.Errorf("revoking refresh token family: %w", err)
+	}
+
+	return entity.ErrSessionRevoked
+}
+
+// touchSession updates the session metadata shown to the user. It is best
+// effort: failing to record it must not cost the client its new tokens.
+func (uc *rotateRefreshTokenUseCase) touchSession(ctx context.Context, token *entity.RefreshToken, client dto.ClientInfo) {
+	session, err := uc.refreshRepo.FindSession(ctx, token.FamilyID())
+	if err != nil {
+		uc.logger.Error("failed to load session for refresh", err, "userID", token.UserID(), "sessionID", token.FamilyID())
+		return
+	}
+
+	session.Touch(client.IPAddress, client.UserAgent, time.Now())
+
+	if err := uc.refreshRepo.SaveSession(ctx, session, uc.expiresIn); err != nil {
+		uc.logger.Error("failed to update session", err, "userID", token.UserID(), "sessionID", token.FamilyID())
+	}
+}
+
+func sameStore(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
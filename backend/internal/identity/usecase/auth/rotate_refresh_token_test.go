@@ -2,8 +2,11 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/google/uuid"
@@ -15,9 +18,23 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 	userID := uuid.New()
 	token := "valid-refresh-token"
 	email, _ := vo.NewEmail("test@example.com")
-	password, _ := vo.NewPassword("Password123!", "pepper")
-	user, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true)
-	deactivatedUser, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, false)
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	deactivatedUser, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, false, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	familyID := uuid.New()
+	current := func() *entity.RefreshToken {
+		return entity.RestoreRefreshToken(token, userID, familyID, "parent-token", 2, false, 0, nil)
+	}
+	rotated := entity.RestoreRefreshToken(token, userID, familyID, "parent-token", 2, true, 0, nil)
+	revokedUser, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, true, 1, nil, vo.SystemRoleCatalog())
+	child := mock.MatchedBy(func(t *entity.RefreshToken) bool {
+		return t.Token() == "new-refresh" && t.Parent() == token && t.FamilyID() == familyID && t.Generation() == 3
+	})
+	storeID := uuid.New()
+	storeSession := entity.RestoreRefreshToken(token, userID, familyID, "parent-token", 2, false, 0, &storeID)
+	storeChild := mock.MatchedBy(func(t *entity.RefreshToken) bool {
+		return t.Token() == "new-refresh" && t.StoreID() != nil && *t.StoreID() == storeID
+	})
 
 	tests := []struct {
 		name      string
@@ -30,19 +47,82 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 			name:  "Success",
 			token: token,
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, token).Return(userID, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
+				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, nil)
+				tm.On("GenerateTokens", mock.Anything, user).Return("new-access", "new-refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, child, mock.Anything).Return(nil)
+				rr.On("FindSession", mock.Anything, familyID).Return(entity.NewSession(familyID, userID, "127.0.0.1", "old-agent", time.Now().Add(-time.Hour)), nil)
+				rr.On("SaveSession", mock.Anything, mock.MatchedBy(func(s *entity.Session) bool {
+					return s.IPAddress() == "10.0.0.1" && s.UserAgent() == "new-agent" && s.LastUsedAt().After(s.CreatedAt())
+				}), mock.Anything).Return(nil)
+			},
+			expectErr: false,
+		},
+		{
+			name:  "Session Metadata Error Does Not Fail Rotation",
+			token: token,
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
 				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
-				rr.On("DeleteRefreshToken", mock.Anything, token).Return(nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, nil)
 				tm.On("GenerateTokens", mock.Anything, user).Return("new-access", "new-refresh", nil)
-				rr.On("SaveRefreshToken", mock.Anything, mock.Anything, "new-refresh", mock.Anything).Return(nil)
+				rr.On("SaveRefreshToken", mock.Anything, child, mock.Anything).Return(nil)
+				rr.On("FindSession", mock.Anything, familyID).Return(nil, entity.ErrSessionNotFound)
 			},
 			expectErr: false,
 		},
+		{
+			name:  "Store Session Keeps Active Store",
+			token: token,
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
+				rr.On("FindRefreshToken", mock.Anything, token).Return(storeSession, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, nil)
+				tm.On("GenerateStoreTokens", mock.Anything, user, storeID).Return("new-access", "new-refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, storeChild, mock.Anything).Return(nil)
+				rr.On("FindSession", mock.Anything, familyID).Return(nil, entity.ErrSessionNotFound)
+			},
+			expectErr: false,
+		},
+		{
+			name:  "Reused Token - Revokes family",
+			token: token,
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
+				rr.On("FindRefreshToken", mock.Anything, token).Return(rotated, nil)
+				rr.On("RevokeFamily", mock.Anything, userID, familyID).Return(nil)
+			},
+			wantErr:   entity.ErrRefreshTokenReused,
+			expectErr: true,
+		},
+		{
+			name:  "Concurrent Rotation - Revokes family",
+			token: token,
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
+				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(true, nil)
+				rr.On("RevokeFamily", mock.Anything, userID, familyID).Return(nil)
+			},
+			wantErr:   entity.ErrRefreshTokenReused,
+			expectErr: true,
+		},
+		{
+			name:  "Revoked Sessions - Token version is stale",
+			token: token,
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
+				ur.On("FindByID", mock.Anything, userID).Return(revokedUser, nil)
+				rr.On("RevokeFamily", mock.Anything, userID, familyID).Return(nil)
+			},
+			wantErr:   entity.ErrSessionRevoked,
+			expectErr: true,
+		},
 		{
 			name:  "Invalid Token",
 			token: "invalid",
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, "invalid").Return(uuid.Nil, entity.ErrSessionNotFound)
+				rr.On("FindRefreshToken", mock.Anything, "invalid").Return(nil, entity.ErrSessionNotFound)
 			},
 			wantErr:   entity.ErrSessionNotFound,
 			expectErr: true,
@@ -51,7 +131,7 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 			name:  "User Not Found",
 			token: token,
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, token).Return(userID, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
 				ur.On("FindByID", mock.Anything, userID).Return(nil, nil)
 			},
 			wantErr:   entity.ErrUserNotFound,
@@ -61,7 +141,7 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 			name:  "User Deactivated",
 			token: token,
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, token).Return(userID, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
 				ur.On("FindByID", mock.Anything, userID).Return(deactivatedUser, nil)
 			},
 			wantErr:   entity.ErrUserIsDeactivated,
@@ -71,18 +151,18 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 			name:  "FindByID Error",
 			token: token,
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, token).Return(userID, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
 				ur.On("FindByID", mock.Anything, userID).Return(nil, assert.AnError)
 			},
 			expectErr: true,
 		},
 		{
-			name:  "DeleteRefreshToken Error",
+			name:  "MarkRefreshTokenRotated Error",
 			token: token,
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, token).Return(userID, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
 				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
-				rr.On("DeleteRefreshToken", mock.Anything, token).Return(assert.AnError)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, assert.AnError)
 			},
 			expectErr: true,
 		},
@@ -90,9 +170,9 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 			name:  "GenerateTokens Error",
 			token: token,
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, token).Return(userID, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
 				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
-				rr.On("DeleteRefreshToken", mock.Anything, token).Return(nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, nil)
 				tm.On("GenerateTokens", mock.Anything, user).Return("", "", assert.AnError)
 			},
 			expectErr: true,
@@ -101,11 +181,11 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 			name:  "SaveRefreshToken Error",
 			token: token,
 			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, tm *MockTokenManager) {
-				rr.On("GetUserIDByRefreshToken", mock.Anything, token).Return(userID, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(), nil)
 				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
-				rr.On("DeleteRefreshToken", mock.Anything, token).Return(nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, nil)
 				tm.On("GenerateTokens", mock.Anything, user).Return("new-access", "new-refresh", nil)
-				rr.On("SaveRefreshToken", mock.Anything, mock.Anything, "new-refresh", mock.Anything).Return(assert.AnError)
+				rr.On("SaveRefreshToken", mock.Anything, child, mock.Anything).Return(assert.AnError)
 			},
 			expectErr: true,
 		},
@@ -120,18 +200,23 @@ func TestRotateRefreshTokenUseCase_Execute(t *testing.T) {
 
 			tt.setup(ur, rr, tm)
 
-			uc := NewRotateRefreshTokenUseCase(ur, rr, tm, ml, 0)
-			res, err := uc.Execute(context.Background(), tt.token)
+			uc := NewRotateRefreshTokenUseCase(ur, rr, tm, newMockAuditLog(), ml, 0)
+			res, err := uc.Execute(context.Background(), tt.token, dto.ClientInfo{IPAddress: "10.0.0.1", UserAgent: "new-agent"})
 
 			if tt.expectErr {
 				assert.Error(t, err)
 				if tt.wantErr != nil {
 					assert.ErrorIs(t, err, tt.wantErr)
 				}
+				if errors.Is(tt.wantErr, entity.ErrRefreshTokenReused) || errors.Is(tt.wantErr, entity.ErrSessionRevoked) {
+					tm.AssertNotCalled(t, "GenerateTokens", mock.Anything, mock.Anything)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, res)
 			}
+
+			rr.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+)
+
+// startSession opens a new refresh token family together with the session
+// record that lists it under the user.
+func startSession(
+	ctx context.Context,
+	refreshRepo ports.RefreshTokenRepository,
+	user *entity.User,
+	refreshToken string,
+	client dto.ClientInfo,
+	expiresIn time.Duration,
+) error {
+	token := entity.NewRefreshToken(refreshToken, user.ID(), user.TokenVersion())
+	if err := refreshRepo.SaveRefreshToken(ctx, token, expiresIn); err != nil {
+		return fmt.Errorf("saving refresh token: %w", err)
+	}
+
+	session := entity.NewSession(token.FamilyID(), user.ID(), client.IPAddress, client.UserAgent, time.Now())
+	if err := refreshRepo.SaveSession(ctx, session, expiresIn); err != nil {
+		return fmt.Errorf("saving session: %w", err)
+	}
+
+	return nil
+}
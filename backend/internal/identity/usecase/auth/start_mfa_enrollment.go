@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/google/uuid"
+)
+
+type startMFAEnrollmentUseCase struct {
+	userRepo ports.UserRepository
+	mfaRepo  ports.MFARepository
+	logger   ports.Logger
+	issuer   string
+}
+
+func NewStartMFAEnrollment(
+	userRepo ports.UserRepository,
+	mfaRepo ports.MFARepository,
+	logger ports.Logger,
+	issuer string,
+) security.StartMFAEnrollmentUseCase {
+	return &startMFAEnrollmentUseCase{
+		userRepo: userRepo,
+		mfaRepo:  mfaRepo,
+		logger:   logger,
+		issuer:   issuer,
+	}
+}
+
+func (uc *startMFAEnrollmentUseCase) Execute(ctx context.Context, userID uuid.UUID) (*dto.MFAEnrollment, error) {
+	uc.logger.Debug("starting mfa enrollment", "userID", userID)
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for mfa enrollment", err, "userID", userID)
+		return nil, fmt.Errorf("finding user: %w", err)
+	}
+
+	if user == nil {
+		return nil, entity.ErrUserNotFound
+	}
+
+	current, err := uc.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to load mfa settings", err, "userID", userID)
+		return nil, fmt.Errorf("finding mfa settings: %w", err)
+	}
+
+	if current != nil && current.IsEnabled() {
+		uc.logger.Info("mfa enrollment refused: already enabled", "userID", userID)
+		return nil, entity.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := vo.GenerateTOTPSecret()
+	if err != nil {
+		uc.logger.Error("failed to generate totp secret", err, "userID", userID)
+		return nil, fmt.Errorf("generating totp secret: %w", err)
+	}
+
+	if err := uc.mfaRepo.Save(ctx, entity.NewUserMFA(userID, secret)); err != nil {
+		uc.logger.Error("failed to save pending mfa enrollment", err, "userID", userID)
+		return nil, fmt.Errorf("saving mfa enrollment: %w", err)
+	}
+
+	uc.logger.Info("mfa enrollment started", "userID", userID)
+	return &dto.MFAEnrollment{
+		Secret: secret.String(),
+		URI:    secret.ProvisioningURI(uc.issuer, user.Email().String()),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/google/uuid"
+)
+
+type switchStoreUseCase struct {
+	memberships ports.StoreMembershipRepository
+	rotation    *rotateRefreshTokenUseCase
+	logger      ports.Logger
+}
+
+func NewSwitchStoreUseCase(
+	userRepo ports.UserRepository,
+	refreshRepo ports.RefreshTokenRepository,
+	memberships ports.StoreMembershipRepository,
+	tokenManager security.TokenManager,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	expiresIn time.Duration,
+) security.SwitchStoreUseCase {
+	return &switchStoreUseCase{
+		memberships: memberships,
+		rotation: &rotateRefreshTokenUseCase{
+			userRepo:     userRepo,
+			refreshRepo:  refreshRepo,
+			tokenManager: tokenManager,
+			audit:        audit,
+			logger:       logger,
+			expiresIn:    expiresIn,
+		},
+		logger: logger,
+	}
+}
+
+// Execute rotates the refresh token of the caller instead of starting a new
+// session, so reuse detection and revocation keep covering the family.
+func (uc *switchStoreUseCase) Execute(ctx context.Context, userID uuid.UUID, storeID string, refreshToken string, client dto.ClientInfo) (*dto.LoginResult, error) {
+	uc.logger.Debug("starting active store switch", "userID", userID, "storeID", storeID)
+
+	var target *uuid.UUID
+	if storeID != "" {
+		parsed, err := uuid.Parse(storeID)
+		if err != nil {
+			uc.logger.Info("invalid store ID provided", "storeID", storeID)
+			return nil, entity.ErrMembershipStoreRequired
+		}
+
+		membership, err := uc.memberships.Find(ctx, userID, parsed)
+		if err != nil {
+			uc.logger.Error("failed to find store membership", err, "userID", userID, "storeID", parsed)
+			return nil, fmt.Errorf("finding store membership: %w", err)
+		}
+
+		if membership == nil || !membership.IsActive() {
+			uc.logger.Info("store switch refused: not an active member", "userID", userID, "storeID", parsed)
+			return nil, entity.ErrNotStoreMember
+		}
+
+		target = &parsed
+	}
+
+	current, err := uc.rotation.refreshRepo.FindRefreshToken(ctx, refreshToken)
+	if err != nil {
+		uc.logger.Info("failed to find refresh token (invalid or expired)", "error", err)
+		return nil, fmt.Errorf("finding refresh token: %w", err)
+	}
+
+	if current.UserID() != userID {
+		uc.logger.Info("security event: store switch with another user's refresh token", "userID", userID, "ownerID", current.UserID())
+		return nil, entity.ErrSessionNotFound
+	}
+
+	result, err := uc.rotation.rotate(ctx, current, client, target)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("active store switched successfully", "userID", userID, "storeID", storeID)
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSwitchStoreUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	storeID := uuid.New()
+	familyID := uuid.New()
+	token := "valid-refresh-token"
+	email, _ := vo.NewEmail("test@example.com")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, _ := entity.RestoreUser(userID, email.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	current := func(store *uuid.UUID) *entity.RefreshToken {
+		return entity.RestoreRefreshToken(token, userID, familyID, "", 0, false, 0, store)
+	}
+	membership, _ := entity.NewStoreMembership(userID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+	suspended, _ := entity.NewStoreMembership(userID, storeID, []vo.Role{vo.ManagerRole}, time.Now())
+	suspended.ChangeStatus(vo.MembershipSuspended, time.Now())
+
+	tests := []struct {
+		name    string
+		storeID string
+		setup   func(*MockUserRepository, *MockRefreshTokenRepository, *MockStoreMembershipRepository, *MockTokenManager)
+		wantErr error
+	}{
+		{
+			name:    "Switches Into Store",
+			storeID: storeID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, mr *MockStoreMembershipRepository, tm *MockTokenManager) {
+				mr.On("Find", mock.Anything, userID, storeID).Return(membership, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(nil), nil)
+				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, nil)
+				tm.On("GenerateStoreTokens", mock.Anything, user, storeID).Return("new-access", "new-refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, mock.MatchedBy(func(t *entity.RefreshToken) bool {
+					return t.FamilyID() == familyID && t.StoreID() != nil && *t.StoreID() == storeID
+				}), mock.Anything).Return(nil)
+				rr.On("FindSession", mock.Anything, familyID).Return(nil, entity.ErrSessionNotFound)
+			},
+		},
+		{
+			name:    "Empty Store Leaves Active Store",
+			storeID: "",
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, mr *MockStoreMembershipRepository, tm *MockTokenManager) {
+				rr.On("FindRefreshToken", mock.Anything, token).Return(current(&storeID), nil)
+				ur.On("FindByID", mock.Anything, userID).Return(user, nil)
+				rr.On("MarkRefreshTokenRotated", mock.Anything, token).Return(false, nil)
+				tm.On("GenerateTokens", mock.Anything, user).Return("new-access", "new-refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, mock.MatchedBy(func(t *entity.RefreshToken) bool {
+					return t.StoreID() == nil
+				}), mock.Anything).Return(nil)
+				rr.On("FindSession", mock.Anything, familyID).Return(nil, entity.ErrSessionNotFound)
+			},
+		},
+		{
+			name:    "Not A Member",
+			storeID: storeID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, mr *MockStoreMembershipRepository, tm *MockTokenManager) {
+				mr.On("Find", mock.Anything, userID, storeID).Return(nil, nil)
+			},
+			wantErr: entity.ErrNotStoreMember,
+		},
+		{
+			name:    "Suspended Member",
+			storeID: storeID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, mr *MockStoreMembershipRepository, tm *MockTokenManager) {
+				mr.On("Find", mock.Anything, userID, storeID).Return(suspended, nil)
+			},
+			wantErr: entity.ErrNotStoreMember,
+		},
+		{
+			name:    "Invalid Store ID",
+			storeID: "not-a-uuid",
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, mr *MockStoreMembershipRepository, tm *MockTokenManager) {
+			},
+			wantErr: entity.ErrMembershipStoreRequired,
+		},
+		{
+			name:    "Refresh Token Of Another User",
+			storeID: storeID.String(),
+			setup: func(ur *MockUserRepository, rr *MockRefreshTokenRepository, mr *MockStoreMembershipRepository, tm *MockTokenManager) {
+				mr.On("Find", mock.Anything, userID, storeID).Return(membership, nil)
+				rr.On("FindRefreshToken", mock.Anything, token).Return(entity.RestoreRefreshToken(token, uuid.New(), familyID, "", 0, false, 0, nil), nil)
+			},
+			wantErr: entity.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			rr := new(MockRefreshTokenRepository)
+			mr := new(MockStoreMembershipRepository)
+			tm := new(MockTokenManager)
+
+			tt.setup(ur, rr, mr, tm)
+
+			uc := NewSwitchStoreUseCase(ur, rr, mr, tm, newMockAuditLog(), new(MockLogger), time.Hour)
+			res, err := uc.Execute(context.Background(), userID, tt.storeID, token, dto.ClientInfo{})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, res)
+				tm.AssertNotCalled(t, "GenerateStoreTokens", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "new-access", res.AccessToken)
+			}
+
+			rr.AssertExpectations(t)
+			mr.AssertExpectations(t)
+			tm.AssertExpectations(t)
+		})
+	}
+}
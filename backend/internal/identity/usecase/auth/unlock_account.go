@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+)
+
+type unlockAccountUseCase struct {
+	userRepo     ports.UserRepository
+	unlockTokens security.VerificationTokenManager
+	audit        ports.AuditEventRepository
+	logger       ports.Logger
+}
+
+func NewUnlockAccount(
+	userRepo ports.UserRepository,
+	unlockTokens security.VerificationTokenManager,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) security.UnlockAccountUseCase {
+	return &unlockAccountUseCase{
+		userRepo:     userRepo,
+		unlockTokens: unlockTokens,
+		audit:        audit,
+		logger:       logger,
+	}
+}
+
+// Execute lifts the lockout the emailed link was issued for. The link only
+// works while that same lockout is in place, so it cannot be replayed after
+// the account was unlocked or to lift a later lockout.
+func (uc *unlockAccountUseCase) Execute(ctx context.Context, token string) error {
+	uc.logger.Debug("starting self-service account unlock")
+
+	userID, lockedUntil, err := uc.unlockTokens.ValidateUnlockToken(token)
+	if err != nil {
+		uc.logger.Info("invalid unlock token", "error", err)
+		return fmt.Errorf("validating unlock token: %w", err)
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for unlock", err, "userID", userID)
+		return fmt.Errorf("finding user by ID: %w", err)
+	}
+
+	if user == nil || user.LockedUntil() == nil || user.LockedUntil().Unix() != lockedUntil.Unix() {
+		uc.logger.Info("unlock token does not match the current lockout", "userID", userID)
+		return entity.ErrInvalidUnlockToken
+	}
+
+	attempts, previous := user.FailedAttempts(), user.LockedUntil()
+	user.ResetFailedAttempts()
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error("failed to unlock user", err, "userID", userID)
+		return fmt.Errorf("unlocking user: %w", err)
+	}
+
+	// the link proves control of the mailbox, not an authenticated session,
+	// so the unlock has no actor
+	auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditAccountUnlocked, nil, user, dto.ClientInfoFromContext(ctx)).
+		WithChange("failed_attempts", attempts, 0).
+		WithChange("locked_until", previous, nil))
+
+	uc.logger.Info("account unlocked by its owner", "userID", userID)
+	return nil
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnlockAccountUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	token := "unlock-token"
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	lockedUntil := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	setupUser := func(lock *time.Time) *entity.User {
+		user, _ := entity.RestoreUser(userID, "test@example.com", "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 5, lock, true, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(*MockUserRepository, *MockVerificationTokenManager)
+		wantErr error
+		expErr  bool
+	}{
+		{
+			name: "Success",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateUnlockToken", token).Return(userID, lockedUntil, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(&lockedUntil), nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.FailedAttempts() == 0 && u.LockedUntil() == nil
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Already Unlocked",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateUnlockToken", token).Return(userID, lockedUntil, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(nil), nil)
+			},
+			wantErr: entity.ErrInvalidUnlockToken,
+			expErr:  true,
+		},
+		{
+			name: "Issued For An Earlier Lockout",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				later := lockedUntil.Add(time.Hour)
+				vt.On("ValidateUnlockToken", token).Return(userID, lockedUntil, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(&later), nil)
+			},
+			wantErr: entity.ErrInvalidUnlockToken,
+			expErr:  true,
+		},
+		{
+			name: "Invalid Token",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateUnlockToken", token).Return(uuid.Nil, time.Time{}, assert.AnError)
+			},
+			wantErr: assert.AnError,
+			expErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			vt := new(MockVerificationTokenManager)
+			audit := newMockAuditLog()
+			tt.setup(ur, vt)
+
+			uc := NewUnlockAccount(ur, vt, audit, new(MockLogger))
+			err := uc.Execute(context.Background(), token)
+
+			if tt.expErr {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditAccountUnlocked}, audit.recordedActions())
+			}
+
+			ur.AssertExpectations(t)
+			vt.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+)
+
+type verifyEmailUseCase struct {
+	userRepo          ports.UserRepository
+	verificationToken security.VerificationTokenManager
+	logger            ports.Logger
+}
+
+func NewVerifyEmail(
+	userRepo ports.UserRepository,
+	verificationToken security.VerificationTokenManager,
+	logger ports.Logger,
+) security.VerifyEmailUseCase {
+	return &verifyEmailUseCase{
+		userRepo:          userRepo,
+		verificationToken: verificationToken,
+		logger:            logger,
+	}
+}
+
+func (uc *verifyEmailUseCase) Execute(ctx context.Context, token string) error {
+	uc.logger.Debug("starting email verification")
+
+	userID, email, err := uc.verificationToken.ValidateVerificationToken(token)
+	if err != nil {
+		uc.logger.Info("invalid verification token", "error", err)
+		return fmt.Errorf("validating verification token: %w", err)
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for email verification", err, "userID", userID)
+		return fmt.Errorf("finding user by ID: %w", err)
+	}
+
+	if user == nil {
+		uc.logger.Info("user not found for email verification", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	// the token is bound to the address it was sent to, so a link issued
+	// before an email change can't verify the new address
+	if !user.Email().Equals(email) {
+		uc.logger.Info("verification token issued for a different email", "userID", userID)
+		return entity.ErrInvalidVerificationToken
+	}
+
+	if user.EmailVerified() {
+		uc.logger.Info("email already verified", "userID", userID)
+		return nil
+	}
+
+	user.VerifyEmail()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		uc.logger.Error("failed to update user email verification", err, "userID", userID)
+		return fmt.Errorf("updating user email verification: %w", err)
+	}
+
+	uc.logger.Info("email verified successfully", "userID", userID)
+	return nil
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVerifyEmailUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	token := "verification-token"
+	emailVO, _ := vo.NewEmail("test@example.com")
+	otherEmail, _ := vo.NewEmail("other@example.com")
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+
+	setupUser := func(verified bool) *entity.User {
+		user, _ := entity.RestoreUser(userID, emailVO.String(), "testuser", password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, verified, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(*MockUserRepository, *MockVerificationTokenManager)
+		wantErr error
+		expErr  bool
+	}{
+		{
+			name: "Success",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateVerificationToken", token).Return(userID, emailVO, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(false), nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.EmailVerified()
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Already Verified Is Idempotent",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateVerificationToken", token).Return(userID, emailVO, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(true), nil)
+			},
+		},
+		{
+			name: "Invalid Token",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateVerificationToken", token).Return(uuid.Nil, vo.Email(""), assert.AnError)
+			},
+			wantErr: assert.AnError,
+			expErr:  true,
+		},
+		{
+			name: "Token For Another Email",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateVerificationToken", token).Return(userID, otherEmail, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(false), nil)
+			},
+			wantErr: entity.ErrInvalidVerificationToken,
+			expErr:  true,
+		},
+		{
+			name: "User Not Found",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateVerificationToken", token).Return(userID, emailVO, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(nil, nil)
+			},
+			wantErr: entity.ErrUserNotFound,
+			expErr:  true,
+		},
+		{
+			name: "Update Error",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateVerificationToken", token).Return(userID, emailVO, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(false), nil)
+				ur.On("Update", mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+			expErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			vt := new(MockVerificationTokenManager)
+			ml := new(MockLogger)
+
+			tt.setup(ur, vt)
+
+			uc := NewVerifyEmail(ur, vt, ml)
+			err := uc.Execute(context.Background(), token)
+
+			if tt.expErr {
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			ur.AssertExpectations(t)
+			vt.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+)
+
+type verifyMFALoginUseCase struct {
+	userRepo      ports.UserRepository
+	mfaRepo       ports.MFARepository
+	challengeRepo ports.MFAChallengeRepository
+	tokenManager  security.TokenManager
+	refreshRepo   ports.RefreshTokenRepository
+	audit         ports.AuditEventRepository
+	lockout       lockoutNotifier
+	logins        loginRecorder
+	logger        ports.Logger
+	baseDuration  time.Duration
+	threshold     int
+	expiresIn     time.Duration
+}
+
+func NewVerifyMFALogin(
+	userRepo ports.UserRepository,
+	mfaRepo ports.MFARepository,
+	challengeRepo ports.MFAChallengeRepository,
+	tokenManager security.TokenManager,
+	refreshRepo ports.RefreshTokenRepository,
+	audit ports.AuditEventRepository,
+	history ports.LoginHistoryRepository,
+	notifier ports.NotificationService,
+	unlockTokens security.VerificationTokenManager,
+	logger ports.Logger,
+	baseDuration time.Duration,
+	threshold int,
+	expiresIn time.Duration,
+	newDeviceWindow time.Duration,
+) security.VerifyMFALoginUseCase {
+	return &verifyMFALoginUseCase{
+		userRepo:      userRepo,
+		mfaRepo:       mfaRepo,
+		challengeRepo: challengeRepo,
+		tokenManager:  tokenManager,
+		refreshRepo:   refreshRepo,
+		audit:         audit,
+		logger:        logger,
+		baseDuration:  baseDuration,
+		threshold:     threshold,
+		expiresIn:     expiresIn,
+		lockout: lockoutNotifier{
+			notifier: notifier,
+			tokens:   unlockTokens,
+			logger:   logger,
+		},
+		logins: loginRecorder{
+			history:         history,
+			notifier:        notifier,
+			logger:          logger,
+			newDeviceWindow: newDeviceWindow,
+		},
+	}
+}
+
+func (uc *verifyMFALoginUseCase) Execute(ctx context.Context, challenge string, code string, client dto.ClientInfo) (*dto.LoginResult, error) {
+	uc.logger.Debug("starting mfa login verification")
+
+	userID, err := uc.challengeRepo.GetUserIDByChallenge(ctx, challenge)
+	if err != nil {
+		uc.logger.Info("mfa challenge lookup failed", "error", err)
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for mfa login", err, "userID", userID)
+		return nil, fmt.Errorf("finding user: %w", err)
+	}
+
+	if user == nil {
+		return nil, entity.ErrMFAChallengeNotFound
+	}
+
+	if !user.IsActive() {
+		return nil, entity.ErrUserIsDeactivated
+	}
+
+	now := time.Now()
+	if user.IsLocked(now) {
+		uc.logger.Info("user is locked", "userID", userID)
+		auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditLoginFailed, nil, user, client))
+		uc.logins.failed(ctx, user, vo.LoginAccountLocked, client, now)
+		return nil, entity.ErrUserBlocked
+	}
+
+	mfa, err := uc.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to load mfa settings", err, "userID", userID)
+		return nil, fmt.Errorf("finding mfa settings: %w", err)
+	}
+
+	if mfa == nil {
+		return nil, entity.ErrMFANotEnrolled
+	}
+
+	var recoveryCodes []string
+
+	switch {
+	case !mfa.IsEnabled():
+		// Enrollment forced by the role policy: the first valid code confirms it.
+		if err := mfa.Confirm(code, now); err != nil {
+			return nil, uc.registerFailure(ctx, user, client, now)
+		}
+
+		recoveryCodes, err = replaceRecoveryCodes(mfa)
+		if err != nil {
+			uc.logger.Error("failed to generate recovery codes", err, "userID", userID)
+			return nil, err
+		}
+	case mfa.VerifyCode(code, now):
+	case mfa.UseRecoveryCode(code):
+		uc.logger.Info("recovery code used", "userID", userID, "remaining", len(mfa.RecoveryCodes()))
+	default:
+		return nil, uc.registerFailure(ctx, user, client, now)
+	}
+
+	if err := uc.challengeRepo.DeleteChallenge(ctx, challenge); err != nil {
+		uc.logger.Error("failed to delete mfa challenge", err, "userID", userID)
+		return nil, fmt.Errorf("deleting mfa challenge: %w", err)
+	}
+
+	if err := uc.mfaRepo.Save(ctx, mfa); err != nil {
+		uc.logger.Error("failed to save mfa settings", err, "userID", userID)
+		return nil, fmt.Errorf("saving mfa settings: %w", err)
+	}
+
+	user.ResetFailedAttempts()
+
+	accessToken, refreshToken, err := uc.tokenManager.GenerateTokens(ctx, user)
+	if err != nil {
+		uc.logger.Error("failed to generate auth tokens", err, "userID", userID)
+		return nil, fmt.Errorf("generating tokens: %w", err)
+	}
+
+	if err := startSession(ctx, uc.refreshRepo, user, refreshToken, client, uc.expiresIn); err != nil {
+		uc.logger.Error("failed to start session", err, "userID", userID)
+		return nil, err
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("error reset failed attempts: %w", err)
+	}
+
+	auditlog.Record(ctx, uc.audit, uc.logger, userAuditEvent(ctx, vo.AuditLoginSucceeded, &userID, user, client))
+	uc.logins.succeeded(ctx, user, client, now)
+
+	uc.logger.Info("user logged in with mfa", "userID", userID)
+	return &dto.LoginResult{
+		AccessToken:   accessToken,
+		RefreshToken:  refreshToken,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// registerFailure counts a wrong code as a failed login, so the lockout also
+// covers brute-forcing the second factor.
+func (uc *verifyMFALoginUseCase) registerFailure(ctx context.Context, user *entity.User, client dto.ClientInfo, now time.Time) error {
+	uc.logger.Info("mfa login failed: invalid code", "userID", user.ID())
+
+	attempts := user.FailedAttempts()
+	locked := user.RecordFailedLogin(uc.threshold, uc.baseDuration, now)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("error updating failed attempts: %w", err)
+	}
+
+	recordFailedLogin(ctx, uc.audit, uc.logger, user, attempts, locked, client)
+	uc.logins.failed(ctx, user, vo.LoginInvalidMFACode, client, now)
+	if locked {
+		uc.lockout.notify(ctx, user)
+	}
+
+	return entity.ErrInvalidMFACode
+}
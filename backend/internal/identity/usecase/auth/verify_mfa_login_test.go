@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVerifyMFALoginUseCase_Execute(t *testing.T) {
+	pepper := vo.SinglePepper("test-pepper")
+	challenge := "challenge-token"
+	userID := uuid.New()
+	passwordVO, _ := vo.NewPassword("Password123!", pepper)
+	secret, _ := vo.GenerateTOTPSecret()
+	recoveryCodes, _ := vo.GenerateRecoveryCodes(2)
+
+	setupUser := func() *entity.User {
+		user, _ := entity.RestoreUser(userID, "admin@example.com", "admin", passwordVO.String(), []string{"ADMIN"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+		return user
+	}
+
+	setupMFA := func(enabled bool) *entity.UserMFA {
+		mfa, _ := entity.RestoreUserMFA(userID, secret.String(), enabled, nil, 0)
+		mfa.ReplaceRecoveryCodes(recoveryCodes)
+		return mfa
+	}
+
+	validCode := func() string {
+		return secret.Code(vo.TOTPStep(time.Now()))
+	}
+
+	tests := []struct {
+		name              string
+		code              func() string
+		setup             func(*MockUserRepository, *MockMFARepository, *MockMFAChallengeRepository, *MockTokenManager, *MockRefreshTokenRepository)
+		expectErr         error
+		wantRecoveryCodes bool
+	}{
+		{
+			name: "Success - Valid TOTP code",
+			code: validCode,
+			setup: func(ur *MockUserRepository, mr *MockMFARepository, cr *MockMFAChallengeRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				cr.On("GetUserIDByChallenge", mock.Anything, challenge).Return(userID, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(), nil)
+				mr.On("FindByUserID", mock.Anything, userID).Return(setupMFA(true), nil)
+				cr.On("DeleteChallenge", mock.Anything, challenge).Return(nil)
+				mr.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.UserMFA) bool {
+					return m.LastUsedStep() > 0
+				})).Return(nil)
+				tm.On("GenerateTokens", mock.Anything, mock.Anything).Return("access", "refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, newFamilyToken(setupUser(), "refresh"), time.Hour).Return(nil)
+				rr.On("SaveSession", mock.Anything, newSessionOf(userID), time.Hour).Return(nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.FailedAttempts() == 0
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Success - Recovery code is consumed",
+			code: func() string { return recoveryCodes[0].String() },
+			setup: func(ur *MockUserRepository, mr *MockMFARepository, cr *MockMFAChallengeRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				cr.On("GetUserIDByChallenge", mock.Anything, challenge).Return(userID, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(), nil)
+				mr.On("FindByUserID", mock.Anything, userID).Return(setupMFA(true), nil)
+				cr.On("DeleteChallenge", mock.Anything, challenge).Return(nil)
+				mr.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.UserMFA) bool {
+					return len(m.RecoveryCodes()) == 1
+				})).Return(nil)
+				tm.On("GenerateTokens", mock.Anything, mock.Anything).Return("access", "refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, newFamilyToken(setupUser(), "refresh"), time.Hour).Return(nil)
+				rr.On("SaveSession", mock.Anything, newSessionOf(userID), time.Hour).Return(nil)
+				ur.On("Update", mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "Success - Pending enrollment is confirmed",
+			code: validCode,
+			setup: func(ur *MockUserRepository, mr *MockMFARepository, cr *MockMFAChallengeRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				cr.On("GetUserIDByChallenge", mock.Anything, challenge).Return(userID, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(), nil)
+				mr.On("FindByUserID", mock.Anything, userID).Return(entity.NewUserMFA(userID, secret), nil)
+				cr.On("DeleteChallenge", mock.Anything, challenge).Return(nil)
+				mr.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.UserMFA) bool {
+					return m.IsEnabled() && len(m.RecoveryCodes()) == recoveryCodesAmount
+				})).Return(nil)
+				tm.On("GenerateTokens", mock.Anything, mock.Anything).Return("access", "refresh", nil)
+				rr.On("SaveRefreshToken", mock.Anything, newFamilyToken(setupUser(), "refresh"), time.Hour).Return(nil)
+				rr.On("SaveSession", mock.Anything, newSessionOf(userID), time.Hour).Return(nil)
+				ur.On("Update", mock.Anything, mock.Anything).Return(nil)
+			},
+			wantRecoveryCodes: true,
+		},
+		{
+			name: "Invalid Code - Records failed attempt",
+			code: func() string { return "000000" },
+			setup: func(ur *MockUserRepository, mr *MockMFARepository, cr *MockMFAChallengeRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				cr.On("GetUserIDByChallenge", mock.Anything, challenge).Return(userID, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(), nil)
+				mfa, _ := entity.RestoreUserMFA(userID, secret.String(), true, nil, vo.TOTPStep(time.Now())+1)
+				mr.On("FindByUserID", mock.Anything, userID).Return(mfa, nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.FailedAttempts() == 1
+				})).Return(nil)
+			},
+			expectErr: entity.ErrInvalidMFACode,
+		},
+		{
+			name: "Challenge Not Found",
+			code: validCode,
+			setup: func(ur *MockUserRepository, mr *MockMFARepository, cr *MockMFAChallengeRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				cr.On("GetUserIDByChallenge", mock.Anything, challenge).Return(uuid.Nil, entity.ErrMFAChallengeNotFound)
+			},
+			expectErr: entity.ErrMFAChallengeNotFound,
+		},
+		{
+			name: "Locked User",
+			code: validCode,
+			setup: func(ur *MockUserRepository, mr *MockMFARepository, cr *MockMFAChallengeRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				lockedUntil := time.Now().Add(time.Hour)
+				lockedUser, _ := entity.RestoreUser(userID, "admin@example.com", "admin", passwordVO.String(), []string{"ADMIN"}, true, 5, &lockedUntil, true, 0, nil, vo.SystemRoleCatalog())
+				cr.On("GetUserIDByChallenge", mock.Anything, challenge).Return(userID, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(lockedUser, nil)
+			},
+			expectErr: entity.ErrUserBlocked,
+		},
+		{
+			name: "MFA Reset After Challenge",
+			code: validCode,
+			setup: func(ur *MockUserRepository, mr *MockMFARepository, cr *MockMFAChallengeRepository, tm *MockTokenManager, rr *MockRefreshTokenRepository) {
+				cr.On("GetUserIDByChallenge", mock.Anything, challenge).Return(userID, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(setupUser(), nil)
+				mr.On("FindByUserID", mock.Anything, userID).Return(nil, nil)
+			},
+			expectErr: entity.ErrMFANotEnrolled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUser := new(MockUserRepository)
+			mockMFA := new(MockMFARepository)
+			mockChallenge := new(MockMFAChallengeRepository)
+			mockTM := new(MockTokenManager)
+			mockRR := new(MockRefreshTokenRepository)
+			mockLogger := new(MockLogger)
+
+			tt.setup(mockUser, mockMFA, mockChallenge, mockTM, mockRR)
+
+			uc := NewVerifyMFALogin(mockUser, mockMFA, mockChallenge, mockTM, mockRR, newMockAuditLog(), newMockLoginHistory(), new(MockNotificationService), new(MockVerificationTokenManager), mockLogger, 15*time.Minute, 5, time.Hour, 30*24*time.Hour)
+			result, err := uc.Execute(context.Background(), challenge, tt.code(), dto.ClientInfo{})
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				assert.Nil(t, result)
+				mockTM.AssertNotCalled(t, "GenerateTokens", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "access", result.AccessToken)
+				assert.Equal(t, "refresh", result.RefreshToken)
+				assert.Equal(t, tt.wantRecoveryCodes, len(result.RecoveryCodes) > 0)
+			}
+
+			mockUser.AssertExpectations(t)
+			mockMFA.AssertExpectations(t)
+			mockChallenge.AssertExpectations(t)
+			mockTM.AssertExpectations(t)
+			mockRR.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type acceptInvitationUseCase struct {
+	invitations ports.InvitationRepository
+	userRepo    ports.UserRepository
+	memberships ports.StoreMembershipRepository
+	audit       ports.AuditEventRepository
+	logger      ports.Logger
+	txManager   ports.TransactionManager
+	peppers     vo.Peppers
+	policy      vo.PasswordPolicy
+}
+
+func NewAcceptInvitationUseCase(
+	invitations ports.InvitationRepository,
+	userRepo ports.UserRepository,
+	memberships ports.StoreMembershipRepository,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	txManager ports.TransactionManager,
+	peppers vo.Peppers,
+	policy vo.PasswordPolicy,
+) user.AcceptInvitationUseCase {
+	return &acceptInvitationUseCase{
+		invitations: invitations,
+		userRepo:    userRepo,
+		memberships: memberships,
+		audit:       audit,
+		logger:      logger,
+		txManager:   txManager,
+		peppers:     peppers,
+		policy:      policy,
+	}
+}
+
+// Execute creates the invited user with no global roles and makes them a
+// member of the inviting store. The email is verified already: the invitee
+// proved they own it by presenting the token that was sent there.
+func (uc *acceptInvitationUseCase) Execute(ctx context.Context, input dto.AcceptInvitationInput) error {
+	uc.logger.Debug("starting invitation acceptance", "username", input.Username)
+
+	now := time.Now()
+	invitation, err := acceptPendingInvitation(ctx, uc.invitations, uc.logger, input.Token, now)
+	if err != nil {
+		return err
+	}
+
+	candidate := vo.PasswordCandidate{PlainText: input.Password, Username: input.Username, Email: invitation.Email()}
+	if err := uc.policy.Check(candidate); err != nil {
+		uc.logger.Info("password rejected by policy", "invitationID", invitation.ID(), "reason", err)
+		return err
+	}
+
+	password, err := vo.NewPassword(input.Password, uc.peppers)
+	if err != nil {
+		uc.logger.Info("invalid password in invitation acceptance", "invitationID", invitation.ID())
+		return err
+	}
+
+	existing, err := uc.userRepo.FindByEmail(ctx, invitation.Email())
+	if err != nil {
+		uc.logger.Error("failed to find user by email", err, "invitationID", invitation.ID())
+		return err
+	}
+
+	// a registered invitee accepts from their account instead, which keeps
+	// the password they already have
+	if existing != nil {
+		uc.logger.Info("invitation refused: email already registered", "invitationID", invitation.ID())
+		return entity.ErrEmailAlreadyRegistered
+	}
+
+	invitedUser, err := entity.NewUser(invitation.Email(), input.Username, password, []vo.Role{})
+	if err != nil {
+		uc.logger.Info("invalid user input in invitation acceptance", "error", err)
+		return err
+	}
+	invitedUser.VerifyEmail()
+
+	membership, err := entity.NewStoreMembership(invitedUser.ID(), invitation.StoreID(), invitation.Roles(), now)
+	if err != nil {
+		uc.logger.Error("failed to create store membership", err, "invitationID", invitation.ID())
+		return err
+	}
+
+	err = uc.txManager.Execute(ctx, func(txCtx context.Context) error {
+		if err := uc.invitations.UpdateAcceptedAt(txCtx, invitation); err != nil {
+			uc.logger.Info("failed to consume invitation", "invitationID", invitation.ID(), "error", err)
+			return err
+		}
+
+		if err := uc.userRepo.Save(txCtx, invitedUser); err != nil {
+			uc.logger.Error("failed to save invited user", err, "invitationID", invitation.ID())
+			return err
+		}
+
+		if err := uc.memberships.Save(txCtx, membership); err != nil {
+			uc.logger.Error("failed to save store membership", err, "invitationID", invitation.ID())
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	auditlog.Record(ctx, uc.audit, uc.logger, selfAuditEvent(ctx, vo.AuditInvitationAccepted, nil, invitedUser).
+		WithChange("invitation_id", nil, invitation.ID()).
+		WithChange("store_id", nil, invitation.StoreID()).
+		WithChange("roles", nil, invitation.Roles()))
+
+	uc.logger.Info("invitation accepted successfully", "invitationID", invitation.ID(), "userID", invitedUser.ID(), "storeID", invitation.StoreID())
+	return nil
+}
+
+type acceptInvitationAsMemberUseCase struct {
+	invitations ports.InvitationRepository
+	userRepo    ports.UserRepository
+	memberships ports.StoreMembershipRepository
+	audit       ports.AuditEventRepository
+	logger      ports.Logger
+	txManager   ports.TransactionManager
+}
+
+func NewAcceptInvitationAsMemberUseCase(
+	invitations ports.InvitationRepository,
+	userRepo ports.UserRepository,
+	memberships ports.StoreMembershipRepository,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	txManager ports.TransactionManager,
+) user.AcceptInvitationAsMemberUseCase {
+	return &acceptInvitationAsMemberUseCase{
+		invitations: invitations,
+		userRepo:    userRepo,
+		memberships: memberships,
+		audit:       audit,
+		logger:      logger,
+		txManager:   txManager,
+	}
+}
+
+// Execute makes an existing user a member of the inviting store and leaves
+// the account itself untouched. The invitation must have been sent to the
+// user's email, so a forwarded link cannot be accepted by someone else.
+func (uc *acceptInvitationAsMemberUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.AcceptInvitationAsMemberInput) error {
+	uc.logger.Debug("starting invitation acceptance by existing user", "userID", userID)
+
+	now := time.Now()
+	invitation, err := acceptPendingInvitation(ctx, uc.invitations, uc.logger, input.Token, now)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user", err, "userID", userID)
+		return err
+	}
+
+	if currentUser == nil {
+		uc.logger.Info("user not found for invitation acceptance", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	if currentUser.Email() != invitation.Email() {
+		uc.logger.Info("security event: invitation for another email refused", "userID", userID, "invitationID", invitation.ID())
+		return entity.ErrInvalidInvitation
+	}
+
+	existing, err := uc.memberships.Find(ctx, userID, invitation.StoreID())
+	if err != nil {
+		uc.logger.Error("failed to find store membership", err, "userID", userID, "storeID", invitation.StoreID())
+		return err
+	}
+
+	if existing != nil {
+		uc.logger.Info("invitation refused: user is already a store member", "userID", userID, "storeID", invitation.StoreID())
+		return entity.ErrMembershipAlreadyExists
+	}
+
+	membership, err := entity.NewStoreMembership(userID, invitation.StoreID(), invitation.Roles(), now)
+	if err != nil {
+		uc.logger.Error("failed to create store membership", err, "invitationID", invitation.ID())
+		return err
+	}
+
+	err = uc.txManager.Execute(ctx, func(txCtx context.Context) error {
+		if err := uc.invitations.UpdateAcceptedAt(txCtx, invitation); err != nil {
+			uc.logger.Info("failed to consume invitation", "invitationID", invitation.ID(), "error", err)
+			return err
+		}
+
+		if err := uc.memberships.Save(txCtx, membership); err != nil {
+			uc.logger.Error("failed to save store membership", err, "invitationID", invitation.ID())
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	auditlog.Record(ctx, uc.audit, uc.logger, selfAuditEvent(ctx, vo.AuditInvitationAccepted, &userID, currentUser).
+		WithChange("invitation_id", nil, invitation.ID()).
+		WithChange("store_id", nil, invitation.StoreID()).
+		WithChange("roles", nil, invitation.Roles()))
+
+	uc.logger.Info("invitation accepted successfully", "invitationID", invitation.ID(), "userID", userID, "storeID", invitation.StoreID())
+	return nil
+}
+
+// acceptPendingInvitation resolves an invite token and marks the invitation
+// accepted in memory; callers persist it together with the membership.
+func acceptPendingInvitation(ctx context.Context, invitations ports.InvitationRepository, logger ports.Logger, rawToken string, now time.Time) (*entity.Invitation, error) {
+	token, err := vo.NewInviteToken(rawToken)
+	if err != nil {
+		logger.Info("malformed invite token provided")
+		return nil, entity.ErrInvalidInvitation
+	}
+
+	invitation, err := invitations.FindByTokenHash(ctx, token.Hash())
+	if err != nil {
+		logger.Error("failed to find invitation", err)
+		return nil, err
+	}
+
+	if invitation == nil {
+		logger.Info("invite token not found")
+		return nil, entity.ErrInvalidInvitation
+	}
+
+	if err := invitation.Accept(now); err != nil {
+		logger.Info("invitation is no longer pending", "invitationID", invitation.ID())
+		return nil, err
+	}
+
+	return invitation, nil
+}
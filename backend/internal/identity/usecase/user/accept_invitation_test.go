@@ -0,0 +1,235 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAcceptInvitationUseCase_Execute(t *testing.T) {
+	email, _ := vo.NewEmail("staff@test.com")
+	token, _ := vo.GenerateInviteToken()
+	storeID := uuid.New()
+	pending := func() *entity.Invitation {
+		invitation, _ := entity.NewInvitation(email, storeID, []vo.Role{vo.EmployeeRole}, token, uuid.New(), time.Hour, time.Now())
+		return invitation
+	}
+	expired, _ := entity.NewInvitation(email, storeID, []vo.Role{vo.EmployeeRole}, token, uuid.New(), time.Hour, time.Now().Add(-2*time.Hour))
+	existing, _ := entity.NewUser(email, "taken", vo.Password("hash"), nil)
+	input := dto.AcceptInvitationInput{Token: token.String(), Username: "staff", Password: "StrongPass123!"}
+
+	tests := []struct {
+		name    string
+		input   dto.AcceptInvitationInput
+		setup   func(*MockInvitationRepository, *MockUserRepository, *MockStoreMembershipRepository, *MockTransactionManager)
+		wantErr error
+	}{
+		{
+			name:  "Success",
+			input: input,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+				ur.On("FindByEmail", mock.Anything, email).Return(nil, nil)
+				tx.On("Execute", mock.Anything, mock.Anything).Return(nil)
+				ir.On("UpdateAcceptedAt", mock.Anything, mock.MatchedBy(func(i *entity.Invitation) bool {
+					return i.AcceptedAt() != nil
+				})).Return(nil)
+				ur.On("Save", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.Username() == "staff" && u.Email() == email && u.EmailVerified() && len(u.Roles()) == 0
+				})).Return(nil)
+				mr.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.StoreMembership) bool {
+					return m.StoreID() == storeID && m.Roles()[0] == vo.EmployeeRole && m.IsActive()
+				})).Return(nil)
+			},
+		},
+		{
+			name:  "Concurrent Acceptance",
+			input: input,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+				ur.On("FindByEmail", mock.Anything, email).Return(nil, nil)
+				tx.On("Execute", mock.Anything, mock.Anything).Return(nil)
+				ir.On("UpdateAcceptedAt", mock.Anything, mock.Anything).Return(entity.ErrInvalidInvitation)
+			},
+			wantErr: entity.ErrInvalidInvitation,
+		},
+		{
+			name:  "Expired Invitation",
+			input: input,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(expired, nil)
+			},
+			wantErr: entity.ErrInvalidInvitation,
+		},
+		{
+			name:  "Unknown Token",
+			input: input,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(nil, nil)
+			},
+			wantErr: entity.ErrInvalidInvitation,
+		},
+		{
+			name:  "Malformed Token",
+			input: dto.AcceptInvitationInput{Token: "garbage", Username: "staff", Password: "StrongPass123!"},
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+			},
+			wantErr: entity.ErrInvalidInvitation,
+		},
+		{
+			name:  "Email Already Registered",
+			input: input,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+				ur.On("FindByEmail", mock.Anything, email).Return(existing, nil)
+			},
+			wantErr: entity.ErrEmailAlreadyRegistered,
+		},
+		{
+			name:  "Breached Password",
+			input: dto.AcceptInvitationInput{Token: token.String(), Username: "staff", Password: "Password1!"},
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+			},
+			wantErr: vo.ErrBreachedPassword,
+		},
+		{
+			name:  "Weak Password",
+			input: dto.AcceptInvitationInput{Token: token.String(), Username: "staff", Password: "short"},
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+			},
+			wantErr: vo.ErrPasswordTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ir := new(MockInvitationRepository)
+			ur := new(MockUserRepository)
+			mr := new(MockStoreMembershipRepository)
+			tx := new(MockTransactionManager)
+			tt.setup(ir, ur, mr, tx)
+			audit := newMockAuditLog()
+
+			uc := NewAcceptInvitationUseCase(ir, ur, mr, audit, new(MockLogger), tx, vo.SinglePepper("pepper"), vo.DefaultPasswordPolicy(stubPasswordCorpus{"Password1!": true}, vo.SinglePepper("pepper")))
+			err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				ur.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditInvitationAccepted}, audit.recordedActions())
+			}
+
+			ir.AssertExpectations(t)
+			ur.AssertExpectations(t)
+			mr.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAcceptInvitationAsMemberUseCase_Execute(t *testing.T) {
+	email, _ := vo.NewEmail("staff@test.com")
+	otherEmail, _ := vo.NewEmail("other@test.com")
+	token, _ := vo.GenerateInviteToken()
+	storeID := uuid.New()
+	pending := func() *entity.Invitation {
+		invitation, _ := entity.NewInvitation(email, storeID, []vo.Role{vo.EmployeeRole}, token, uuid.New(), time.Hour, time.Now())
+		return invitation
+	}
+	expired, _ := entity.NewInvitation(email, storeID, []vo.Role{vo.EmployeeRole}, token, uuid.New(), time.Hour, time.Now().Add(-2*time.Hour))
+	invitee, _ := entity.NewUser(email, "staff", vo.Password("hash"), nil)
+	stranger, _ := entity.NewUser(otherEmail, "other", vo.Password("hash"), nil)
+	input := dto.AcceptInvitationAsMemberInput{Token: token.String()}
+
+	tests := []struct {
+		name    string
+		user    *entity.User
+		setup   func(*MockInvitationRepository, *MockUserRepository, *MockStoreMembershipRepository, *MockTransactionManager)
+		wantErr error
+	}{
+		{
+			name: "Success - Only Adds The Membership",
+			user: invitee,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+				ur.On("FindByID", mock.Anything, invitee.ID()).Return(invitee, nil)
+				mr.On("Find", mock.Anything, invitee.ID(), storeID).Return(nil, nil)
+				tx.On("Execute", mock.Anything, mock.Anything).Return(nil)
+				ir.On("UpdateAcceptedAt", mock.Anything, mock.MatchedBy(func(i *entity.Invitation) bool {
+					return i.AcceptedAt() != nil
+				})).Return(nil)
+				mr.On("Save", mock.Anything, mock.MatchedBy(func(m *entity.StoreMembership) bool {
+					return m.UserID() == invitee.ID() && m.StoreID() == storeID && m.Roles()[0] == vo.EmployeeRole
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Invitation For Another Email",
+			user: stranger,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+				ur.On("FindByID", mock.Anything, stranger.ID()).Return(stranger, nil)
+			},
+			wantErr: entity.ErrInvalidInvitation,
+		},
+		{
+			name: "Already A Member",
+			user: invitee,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				member, _ := entity.NewStoreMembership(invitee.ID(), storeID, []vo.Role{vo.ManagerRole}, time.Now())
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(pending(), nil)
+				ur.On("FindByID", mock.Anything, invitee.ID()).Return(invitee, nil)
+				mr.On("Find", mock.Anything, invitee.ID(), storeID).Return(member, nil)
+			},
+			wantErr: entity.ErrMembershipAlreadyExists,
+		},
+		{
+			name: "Expired Invitation",
+			user: invitee,
+			setup: func(ir *MockInvitationRepository, ur *MockUserRepository, mr *MockStoreMembershipRepository, tx *MockTransactionManager) {
+				ir.On("FindByTokenHash", mock.Anything, token.Hash()).Return(expired, nil)
+			},
+			wantErr: entity.ErrInvalidInvitation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ir := new(MockInvitationRepository)
+			ur := new(MockUserRepository)
+			mr := new(MockStoreMembershipRepository)
+			tx := new(MockTransactionManager)
+			tt.setup(ir, ur, mr, tx)
+			audit := newMockAuditLog()
+
+			uc := NewAcceptInvitationAsMemberUseCase(ir, ur, mr, audit, new(MockLogger), tx)
+			err := uc.Execute(context.Background(), tt.user.ID(), input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mr.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditInvitationAccepted}, audit.recordedActions())
+			}
+
+			ur.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+			ur.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			ir.AssertExpectations(t)
+			ur.AssertExpectations(t)
+			mr.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type accountMocks struct {
+	users       *MockUserRepository
+	mfa         *MockMFARepository
+	history     *MockPasswordHistoryRepository
+	logins      *MockLoginHistoryRepository
+	invitations *MockInvitationRepository
+	refresh     *MockRefreshTokenRepository
+	otp         *MockOTPRepository
+	tx          *MockTransactionManager
+}
+
+func TestDeleteAccountUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	email, _ := vo.NewEmail("test@example.com")
+	isAnonymized := mock.MatchedBy(func(e vo.Email) bool {
+		return strings.HasSuffix(e.String(), "@anonymized.invalid")
+	})
+
+	tests := []struct {
+		name      string
+		password  string
+		setup     func(m accountMocks)
+		wantErr   error
+		wantAudit []vo.AuditAction
+	}{
+		{
+			name:     "Success - Anonymizes and clears sessions and otp",
+			password: "Password123!",
+			setup: func(m accountMocks) {
+				m.users.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, email.String(), "testuser"), nil)
+				m.tx.On("Execute", mock.Anything, mock.Anything).Return(nil)
+				m.users.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.IsDeleted() && !u.IsActive() && u.Username() != "testuser" && !u.Email().Equals(email)
+				})).Return(nil)
+				m.mfa.On("Delete", mock.Anything, userID).Return(nil)
+				m.history.On("DeleteAll", mock.Anything, userID).Return(nil)
+				m.logins.On("DeleteAll", mock.Anything, userID).Return(nil)
+				m.invitations.On("RedactEmail", mock.Anything, email, isAnonymized).Return(nil)
+				m.refresh.On("DeleteAllRefreshTokens", mock.Anything, userID).Return(nil)
+				m.otp.On("DeleteOTP", mock.Anything, email).Return(entity.ErrOTPNotFound)
+			},
+			wantAudit: []vo.AuditAction{vo.AuditAccountDeleted},
+		},
+		{
+			name:     "Wrong Password",
+			password: "Wrong123!",
+			setup: func(m accountMocks) {
+				m.users.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, email.String(), "testuser"), nil)
+			},
+			wantErr: entity.ErrInvalidCredentials,
+		},
+		{
+			name:     "Already Deleted",
+			password: "Password123!",
+			setup: func(m accountMocks) {
+				deleted := newProfileUser(t, userID, email.String(), "testuser")
+				deleted.Anonymize(time.Now())
+				m.users.On("FindByID", mock.Anything, userID).Return(deleted, nil)
+			},
+			wantErr: entity.ErrUserNotFound,
+		},
+		{
+			name:     "Transaction Error - Redis untouched",
+			password: "Password123!",
+			setup: func(m accountMocks) {
+				m.users.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, email.String(), "testuser"), nil)
+				m.tx.On("Execute", mock.Anything, mock.Anything).Return(nil)
+				m.users.On("Update", mock.Anything, mock.Anything).Return(nil)
+				m.mfa.On("Delete", mock.Anything, userID).Return(nil)
+				m.history.On("DeleteAll", mock.Anything, userID).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := accountMocks{
+				users:       new(MockUserRepository),
+				mfa:         new(MockMFARepository),
+				history:     new(MockPasswordHistoryRepository),
+				logins:      new(MockLoginHistoryRepository),
+				invitations: new(MockInvitationRepository),
+				refresh:     new(MockRefreshTokenRepository),
+				otp:         new(MockOTPRepository),
+				tx:          new(MockTransactionManager),
+			}
+			audit := newMockAuditLog()
+			tt.setup(m)
+
+			uc := NewDeleteAccountUseCase(m.users, m.mfa, m.history, m.logins, m.invitations, m.refresh, m.otp, m.tx, audit, new(MockLogger), vo.SinglePepper("pepper"))
+			err := uc.Execute(context.Background(), userID, tt.password)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantAudit, audit.recordedActions())
+			m.users.AssertExpectations(t)
+			m.mfa.AssertExpectations(t)
+			m.history.AssertExpectations(t)
+			m.logins.AssertExpectations(t)
+			m.invitations.AssertExpectations(t)
+			m.refresh.AssertExpectations(t)
+			m.otp.AssertExpectations(t)
+			m.tx.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExportMyDataUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	email, _ := vo.NewEmail("test@example.com")
+	now := time.Now()
+
+	secret, _ := vo.GenerateTOTPSecret()
+	mfa, _ := entity.RestoreUserMFA(userID, secret.String(), true, []string{"code-1", "code-2"}, 0)
+	session := entity.NewSession(uuid.New(), userID, "10.0.0.1", "laptop", now)
+	membership, _ := entity.NewStoreMembership(userID, uuid.New(), []vo.Role{vo.EmployeeRole}, now)
+
+	older := entity.NewAuditEvent(vo.AuditLoginSucceeded, nil, &userID, "10.0.0.1", "laptop", now.Add(-time.Hour))
+	self := entity.NewAuditEvent(vo.AuditProfileUpdated, &userID, &userID, "10.0.0.1", "laptop", now)
+	targetID := uuid.New()
+	onOther := entity.NewAuditEvent(vo.AuditRolesChanged, &userID, &targetID, "10.0.0.1", "laptop", now.Add(-time.Minute))
+
+	attempt := entity.NewLoginAttempt(userID, vo.LoginSucceeded, "10.0.0.1", "laptop", now)
+
+	page := func(events ...*entity.AuditEvent) *common.PaginatedResult[*entity.AuditEvent] {
+		return common.NewPaginatedResult(events, int64(len(events)), common.NewPagination(1, exportPageSize, "", "", ""))
+	}
+
+	setup := func(user *entity.User) (*MockUserRepository, *MockMFARepository, *MockRefreshTokenRepository, *MockStoreMembershipRepository, *MockInvitationRepository, *MockAuditEventRepository) {
+		ur := new(MockUserRepository)
+		ur.On("FindByID", mock.Anything, userID).Return(user, nil)
+		return ur, new(MockMFARepository), new(MockRefreshTokenRepository), new(MockStoreMembershipRepository), new(MockInvitationRepository), new(MockAuditEventRepository)
+	}
+
+	t.Run("Success - Gathers everything without secrets", func(t *testing.T) {
+		ur, mr, rr, sm, ir, ar := setup(newProfileUser(t, userID, email.String(), "testuser"))
+		mr.On("FindByUserID", mock.Anything, userID).Return(mfa, nil)
+		rr.On("ListSessions", mock.Anything, userID).Return([]*entity.Session{session}, nil)
+		sm.On("ListByUser", mock.Anything, userID).Return([]*entity.StoreMembership{membership}, nil)
+		ir.On("ListByEmail", mock.Anything, email).Return([]*entity.Invitation{}, nil)
+		ar.On("List", mock.Anything, dto.AuditFilter{ActorID: &userID}, mock.Anything).Return(page(self, onOther), nil)
+		ar.On("List", mock.Anything, dto.AuditFilter{TargetID: &userID}, mock.Anything).Return(page(self, older), nil)
+		lh := new(MockLoginHistoryRepository)
+		lh.On("List", mock.Anything, userID, mock.Anything).Return(
+			common.NewPaginatedResult([]*entity.LoginAttempt{attempt}, 1, common.NewPagination(1, exportPageSize, "", "", "")), nil)
+
+		uc := NewExportMyDataUseCase(ur, mr, rr, sm, ir, ar, lh, new(MockLogger))
+		export, err := uc.Execute(context.Background(), userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, userID, export.Profile.ID)
+		assert.Equal(t, email.String(), export.Profile.Email)
+		assert.Equal(t, dto.ExportedMFA{Enrolled: true, Enabled: true, RecoveryCodesLeft: 2}, export.MFA)
+		assert.Len(t, export.Sessions, 1)
+		assert.Len(t, export.Stores, 1)
+		assert.Empty(t, export.Invitations)
+		assert.Len(t, export.AuditEvents, 3)
+		assert.Equal(t, []uuid.UUID{self.ID(), onOther.ID(), older.ID()}, []uuid.UUID{
+			export.AuditEvents[0].ID, export.AuditEvents[1].ID, export.AuditEvents[2].ID,
+		})
+		assert.Equal(t, []dto.LoginAttemptInfo{{
+			ID:         attempt.ID(),
+			Outcome:    "succeeded",
+			IPAddress:  "10.0.0.1",
+			UserAgent:  "laptop",
+			Device:     attempt.Device().String(),
+			OccurredAt: now,
+		}}, export.LoginHistory)
+	})
+
+	t.Run("Deleted User", func(t *testing.T) {
+		deleted := newProfileUser(t, userID, email.String(), "testuser")
+		deleted.Anonymize(now)
+		ur, mr, rr, sm, ir, ar := setup(deleted)
+
+		uc := NewExportMyDataUseCase(ur, mr, rr, sm, ir, ar, new(MockLoginHistoryRepository), new(MockLogger))
+		_, err := uc.Execute(context.Background(), userID)
+
+		assert.ErrorIs(t, err, entity.ErrUserNotFound)
+	})
+}
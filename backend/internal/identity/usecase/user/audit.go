@@ -0,0 +1,18 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+// selfAuditEvent describes a user acting on their own account. actorID is
+// nil when the action was proven some other way than a session, e.g. by a
+// link sent by email.
+func selfAuditEvent(ctx context.Context, action vo.AuditAction, actorID *uuid.UUID, user *entity.User) *entity.AuditEvent {
+	targetID := user.ID()
+	return auditlog.Event(ctx, action, actorID, &targetID)
+}
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+)
+
+type confirmEmailChangeUseCase struct {
+	userRepo          ports.UserRepository
+	emailChangeTokens security.VerificationTokenManager
+	audit             ports.AuditEventRepository
+	logger            ports.Logger
+}
+
+func NewConfirmEmailChangeUseCase(
+	userRepo ports.UserRepository,
+	emailChangeTokens security.VerificationTokenManager,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) user.ConfirmEmailChangeUseCase {
+	return &confirmEmailChangeUseCase{
+		userRepo:          userRepo,
+		emailChangeTokens: emailChangeTokens,
+		audit:             audit,
+		logger:            logger,
+	}
+}
+
+// Execute switches the user to the address the link was sent to. Following the
+// link proves the new mailbox is theirs, so the new address counts as
+// verified.
+func (uc *confirmEmailChangeUseCase) Execute(ctx context.Context, token string) error {
+	uc.logger.Debug("starting email change confirmation")
+
+	userID, currentEmail, newEmail, err := uc.emailChangeTokens.ValidateEmailChangeToken(token)
+	if err != nil {
+		uc.logger.Info("invalid email change token", "error", err)
+		return fmt.Errorf("validating email change token: %w", err)
+	}
+
+	currentUser, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for email change", err, "userID", userID)
+		return fmt.Errorf("finding user by ID: %w", err)
+	}
+
+	if currentUser == nil {
+		uc.logger.Info("user not found for email change", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	// a link issued before another change, or already used, no longer
+	// matches the current address
+	if !currentUser.Email().Equals(currentEmail) {
+		uc.logger.Info("email change token issued for a different email", "userID", userID)
+		return entity.ErrInvalidEmailChangeToken
+	}
+
+	// the address may have been taken since the change was requested
+	existing, err := uc.userRepo.FindByEmail(ctx, newEmail)
+	if err != nil {
+		uc.logger.Error("failed to find user by email", err, "userID", userID)
+		return fmt.Errorf("finding user by email: %w", err)
+	}
+
+	if existing != nil {
+		uc.logger.Info("email change refused: email already registered", "userID", userID)
+		return entity.ErrEmailAlreadyRegistered
+	}
+
+	currentUser.ChangeEmail(newEmail)
+	currentUser.VerifyEmail()
+
+	if err := uc.userRepo.Update(ctx, currentUser); err != nil {
+		uc.logger.Error("failed to update user email", err, "userID", userID)
+		return fmt.Errorf("updating user email: %w", err)
+	}
+
+	auditlog.Record(ctx, uc.audit, uc.logger, selfAuditEvent(ctx, vo.AuditEmailChanged, nil, currentUser).
+		WithChange("email", currentEmail, newEmail))
+
+	uc.logger.Info("email changed successfully", "userID", userID)
+	return nil
+}
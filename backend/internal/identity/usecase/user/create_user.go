@@ -7,22 +7,37 @@ import (
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
 	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
 )
 
 type CreateUserUseCase struct {
-	userRepo  ports.UserRepository
-	logger    ports.Logger
-	txManager ports.TransactionManager
-	pepper    string
+	userRepo            ports.UserRepository
+	verificationToken   security.VerificationTokenManager
+	notificationService ports.NotificationService
+	logger              ports.Logger
+	txManager           ports.TransactionManager
+	peppers             vo.Peppers
+	policy              vo.PasswordPolicy
 }
 
-func NewCreateUserService(userRepo ports.UserRepository, logger ports.Logger, txManager ports.TransactionManager, pepper string) user.CreateUserUseCase {
+func NewCreateUserService(
+	userRepo ports.UserRepository,
+	verificationToken security.VerificationTokenManager,
+	notificationService ports.NotificationService,
+	logger ports.Logger,
+	txManager ports.TransactionManager,
+	peppers vo.Peppers,
+	policy vo.PasswordPolicy,
+) user.CreateUserUseCase {
 	return &CreateUserUseCase{
-		userRepo:  userRepo,
-		logger:    logger,
-		txManager: txManager,
-		pepper:    pepper,
+		userRepo:            userRepo,
+		verificationToken:   verificationToken,
+		notificationService: notificationService,
+		logger:              logger,
+		txManager:           txManager,
+		peppers:             peppers,
+		policy:              policy,
 	}
 }
 
@@ -35,28 +50,25 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, input dto.CreateUserIn
 		return err
 	}
 
-	password, err := vo.NewPassword(input.Password, uc.pepper)
-	if err != nil {
-		uc.logger.Error("failed to process password", err, "email", input.Email)
+	candidate := vo.PasswordCandidate{PlainText: input.Password, Username: input.Username, Email: email}
+	if err := uc.policy.Check(candidate); err != nil {
+		uc.logger.Info("password rejected by policy", "email", input.Email, "reason", err)
 		return err
 	}
 
-	roles := make([]vo.Role, 0, len(input.Roles))
-	for _, role := range input.Roles {
-		voRole, err := vo.NewRole(role)
-		if err != nil {
-			uc.logger.Info("invalid role in user creation", "role", role)
-			return err
-		}
-
-		roles = append(roles, voRole)
+	password, err := vo.NewPassword(input.Password, uc.peppers)
+	if err != nil {
+		uc.logger.Error("failed to process password", err, "email", input.Email)
+		return err
 	}
 
+	// self-registered users get no roles and therefore no permissions; an
+	// admin grants them later, or staff are invited into a store instead
 	createdUser, err := entity.NewUser(
 		email,
 		input.Username,
 		password,
-		roles,
+		[]vo.Role{},
 	)
 	if err != nil {
 		uc.logger.Error("failed to create user entity", err, "email", input.Email)
@@ -71,7 +83,24 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, input dto.CreateUserIn
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
 
 	uc.logger.Info("user created successfully", "userID", createdUser.ID(), "email", input.Email)
+
+	// the account already exists at this point, so a delivery failure is only
+	// logged: the user can ask for a new link through the resend endpoint
+	token, err := uc.verificationToken.GenerateVerificationToken(createdUser)
+	if err != nil {
+		uc.logger.Error("failed to generate verification token", err, "userID", createdUser.ID())
+		return nil
+	}
+
+	if err := uc.notificationService.SendVerificationEmail(ctx, createdUser.Email(), token); err != nil {
+		uc.logger.Error("failed to send verification email", err, "userID", createdUser.ID())
+		return nil
+	}
+
 	return nil
 }
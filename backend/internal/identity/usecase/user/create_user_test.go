@@ -6,17 +6,19 @@ import (
 
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestCreateUserUseCase_Execute(t *testing.T) {
-	pepper := "test-pepper"
+	pepper := vo.SinglePepper("test-pepper")
+	policy := vo.DefaultPasswordPolicy(stubPasswordCorpus{"Password1!": true}, pepper)
 
 	tests := []struct {
 		name    string
 		input   dto.CreateUserInput
-		setup   func(mockRepo *MockUserRepository, mockTx *MockTransactionManager)
+		setup   func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService)
 		wantErr bool
 	}{
 		{
@@ -25,13 +27,14 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 				Username: "murilo",
 				Email:    "murilo@test.com",
 				Password: "StrongPass123!",
-				Roles:    []string{"ADMIN"},
 			},
-			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager) {
+			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService) {
 				mockTx.On("Execute", mock.Anything, mock.Anything).Return(nil)
 				mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
-					return u.Username() == "murilo" && u.Email().String() == "murilo@test.com"
+					return u.Username() == "murilo" && u.Email().String() == "murilo@test.com" && !u.EmailVerified() && len(u.Roles()) == 0
 				})).Return(nil)
+				mockVT.On("GenerateVerificationToken", mock.Anything).Return("verification-token", nil)
+				mockNS.On("SendVerificationEmail", mock.Anything, mock.Anything, "verification-token").Return(nil)
 			},
 			wantErr: false,
 		},
@@ -41,9 +44,31 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 				Username: "murilo",
 				Email:    "invalid-email",
 				Password: "StrongPass123!",
-				Roles:    []string{"ADMIN"},
 			},
-			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager) {},
+			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService) {
+			},
+			wantErr: true,
+		},
+		{
+			name: "Breached Password",
+			input: dto.CreateUserInput{
+				Username: "murilo",
+				Email:    "murilo@test.com",
+				Password: "Password1!",
+			},
+			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService) {
+			},
+			wantErr: true,
+		},
+		{
+			name: "Password Contains Username",
+			input: dto.CreateUserInput{
+				Username: "murilo",
+				Email:    "someone@test.com",
+				Password: "Murilo2024!",
+			},
+			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService) {
+			},
 			wantErr: true,
 		},
 		{
@@ -52,9 +77,8 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 				Username: "murilo",
 				Email:    "murilo@test.com",
 				Password: "StrongPass123!",
-				Roles:    []string{"ADMIN"},
 			},
-			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager) {
+			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService) {
 				mockTx.On("Execute", mock.Anything, mock.Anything).Return(assert.AnError)
 			},
 			wantErr: true,
@@ -65,14 +89,28 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 				Username: "murilo",
 				Email:    "murilo@test.com",
 				Password: "StrongPass123!",
-				Roles:    []string{"ADMIN"},
 			},
-			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager) {
+			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService) {
 				mockTx.On("Execute", mock.Anything, mock.Anything).Return(nil)
 				mockRepo.On("Save", mock.Anything, mock.Anything).Return(assert.AnError)
 			},
 			wantErr: true,
 		},
+		{
+			name: "Verification Email Error Does Not Fail Creation",
+			input: dto.CreateUserInput{
+				Username: "murilo",
+				Email:    "murilo@test.com",
+				Password: "StrongPass123!",
+			},
+			setup: func(mockRepo *MockUserRepository, mockTx *MockTransactionManager, mockVT *MockVerificationTokenManager, mockNS *MockNotificationService) {
+				mockTx.On("Execute", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+				mockVT.On("GenerateVerificationToken", mock.Anything).Return("verification-token", nil)
+				mockNS.On("SendVerificationEmail", mock.Anything, mock.Anything, "verification-token").Return(assert.AnError)
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,18 +118,23 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 			mockRepo := new(MockUserRepository)
 			mockLogger := new(MockLogger)
 			mockTx := new(MockTransactionManager)
-			
-			tt.setup(mockRepo, mockTx)
+			mockVT := new(MockVerificationTokenManager)
+			mockNS := new(MockNotificationService)
+
+			tt.setup(mockRepo, mockTx, mockVT, mockNS)
 
-			uc := NewCreateUserService(mockRepo, mockLogger, mockTx, pepper)
+			uc := NewCreateUserService(mockRepo, mockVT, mockNS, mockLogger, mockTx, pepper, policy)
 			err := uc.Execute(context.Background(), tt.input)
 
 			if tt.wantErr {
 				assert.Error(t, err)
+				mockNS.AssertNotCalled(t, "SendVerificationEmail", mock.Anything, mock.Anything, mock.Anything)
 			} else {
 				assert.NoError(t, err)
 				mockRepo.AssertExpectations(t)
 				mockTx.AssertExpectations(t)
+				mockVT.AssertExpectations(t)
+				mockNS.AssertExpectations(t)
 			}
 		})
 	}
@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type deleteAccountUseCase struct {
+	userRepo    ports.UserRepository
+	mfaRepo     ports.MFARepository
+	history     ports.PasswordHistoryRepository
+	logins      ports.LoginHistoryRepository
+	invitations ports.InvitationRepository
+	refreshRepo ports.RefreshTokenRepository
+	otpRepo     ports.OTPRepository
+	txManager   ports.TransactionManager
+	audit       ports.AuditEventRepository
+	logger      ports.Logger
+	peppers     vo.Peppers
+}
+
+func NewDeleteAccountUseCase(
+	userRepo ports.UserRepository,
+	mfaRepo ports.MFARepository,
+	history ports.PasswordHistoryRepository,
+	logins ports.LoginHistoryRepository,
+	invitations ports.InvitationRepository,
+	refreshRepo ports.RefreshTokenRepository,
+	otpRepo ports.OTPRepository,
+	txManager ports.TransactionManager,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+	peppers vo.Peppers,
+) user.DeleteAccountUseCase {
+	return &deleteAccountUseCase{
+		userRepo:    userRepo,
+		mfaRepo:     mfaRepo,
+		history:     history,
+		logins:      logins,
+		invitations: invitations,
+		refreshRepo: refreshRepo,
+		otpRepo:     otpRepo,
+		txManager:   txManager,
+		audit:       audit,
+		logger:      logger,
+		peppers:     peppers,
+	}
+}
+
+// Execute honors an erasure request. The user row is anonymized instead of
+// deleted, so store memberships, API keys, invitations sent and the audit
+// trail keep pointing at a valid user ID while nothing in them identifies the
+// person anymore. Audit events keep their IP addresses, which are retained as
+// security records.
+func (uc *deleteAccountUseCase) Execute(ctx context.Context, userID uuid.UUID, password string) error {
+	uc.logger.Debug("starting account deletion", "userID", userID)
+
+	currentUser, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for deletion", err, "userID", userID)
+		return err
+	}
+
+	if currentUser == nil || currentUser.IsDeleted() {
+		uc.logger.Info("user not found for deletion", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	if !currentUser.Password().Matches(password, uc.peppers) {
+		uc.logger.Info("account deletion refused: wrong password", "userID", userID)
+		return entity.ErrInvalidCredentials
+	}
+
+	email := currentUser.Email()
+	currentUser.Anonymize(time.Now())
+
+	err = uc.txManager.Execute(ctx, func(txCtx context.Context) error {
+		if err := uc.userRepo.Update(txCtx, currentUser); err != nil {
+			uc.logger.Error("failed to anonymize user", err, "userID", userID)
+			return err
+		}
+
+		if err := uc.mfaRepo.Delete(txCtx, userID); err != nil {
+			uc.logger.Error("failed to delete mfa", err, "userID", userID)
+			return err
+		}
+
+		if err := uc.history.DeleteAll(txCtx, userID); err != nil {
+			uc.logger.Error("failed to delete password history", err, "userID", userID)
+			return err
+		}
+
+		if err := uc.logins.DeleteAll(txCtx, userID); err != nil {
+			uc.logger.Error("failed to delete login history", err, "userID", userID)
+			return err
+		}
+
+		if err := uc.invitations.RedactEmail(txCtx, email, currentUser.Email()); err != nil {
+			uc.logger.Error("failed to redact invitations", err, "userID", userID)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Redis is cleaned up after the commit. The token version was bumped, so
+	// tokens left behind by a failure here are already useless and expire on
+	// their own.
+	if err := uc.refreshRepo.DeleteAllRefreshTokens(ctx, userID); err != nil {
+		uc.logger.Error("failed to delete refresh tokens of deleted user", err, "userID", userID)
+	}
+
+	if err := uc.otpRepo.DeleteOTP(ctx, email); err != nil && !errors.Is(err, entity.ErrOTPNotFound) {
+		uc.logger.Error("failed to delete otp of deleted user", err, "userID", userID)
+	}
+
+	auditlog.Record(ctx, uc.audit, uc.logger, selfAuditEvent(ctx, vo.AuditAccountDeleted, &userID, currentUser))
+
+	uc.logger.Info("account deleted and anonymized", "userID", userID)
+	return nil
+}
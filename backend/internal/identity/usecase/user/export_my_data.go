@@ -0,0 +1,248 @@
+package user
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/google/uuid"
+)
+
+// exportPageSize is the largest page the audit log and the login history
+// serve.
+const exportPageSize = 100
+
+type exportMyDataUseCase struct {
+	userRepo    ports.UserRepository
+	mfaRepo     ports.MFARepository
+	refreshRepo ports.RefreshTokenRepository
+	memberships ports.StoreMembershipRepository
+	invitations ports.InvitationRepository
+	audit       ports.AuditEventRepository
+	logins      ports.LoginHistoryRepository
+	logger      ports.Logger
+}
+
+func NewExportMyDataUseCase(
+	userRepo ports.UserRepository,
+	mfaRepo ports.MFARepository,
+	refreshRepo ports.RefreshTokenRepository,
+	memberships ports.StoreMembershipRepository,
+	invitations ports.InvitationRepository,
+	audit ports.AuditEventRepository,
+	logins ports.LoginHistoryRepository,
+	logger ports.Logger,
+) user.ExportMyDataUseCase {
+	return &exportMyDataUseCase{
+		userRepo:    userRepo,
+		mfaRepo:     mfaRepo,
+		refreshRepo: refreshRepo,
+		memberships: memberships,
+		invitations: invitations,
+		audit:       audit,
+		logins:      logins,
+		logger:      logger,
+	}
+}
+
+// Execute gathers what Postgres and Redis hold about the user. Audit events
+// are included whether the user was the actor or the target.
+func (uc *exportMyDataUseCase) Execute(ctx context.Context, userID uuid.UUID) (*dto.PersonalDataExport, error) {
+	uc.logger.Debug("starting personal data export", "userID", userID)
+
+	currentUser, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for export", err, "userID", userID)
+		return nil, err
+	}
+
+	if currentUser == nil || currentUser.IsDeleted() {
+		uc.logger.Info("user not found for export", "userID", userID)
+		return nil, entity.ErrUserNotFound
+	}
+
+	mfa, err := uc.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find mfa for export", err, "userID", userID)
+		return nil, err
+	}
+
+	sessions, err := uc.refreshRepo.ListSessions(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to list sessions for export", err, "userID", userID)
+		return nil, err
+	}
+
+	memberships, err := uc.memberships.ListByUser(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to list store memberships for export", err, "userID", userID)
+		return nil, err
+	}
+
+	invitations, err := uc.invitations.ListByEmail(ctx, currentUser.Email())
+	if err != nil {
+		uc.logger.Error("failed to list invitations for export", err, "userID", userID)
+		return nil, err
+	}
+
+	events, err := uc.auditEvents(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to list audit events for export", err, "userID", userID)
+		return nil, err
+	}
+
+	logins, err := uc.loginHistory(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to list login history for export", err, "userID", userID)
+		return nil, err
+	}
+
+	export := &dto.PersonalDataExport{
+		ExportedAt:   time.Now(),
+		Profile:      toExportedProfile(currentUser),
+		Sessions:     make([]dto.SessionInfo, 0, len(sessions)),
+		Stores:       make([]dto.StoreMemberInfo, 0, len(memberships)),
+		Invitations:  make([]dto.InvitationInfo, 0, len(invitations)),
+		AuditEvents:  make([]dto.AuditEventInfo, 0, len(events)),
+		LoginHistory: make([]dto.LoginAttemptInfo, 0, len(logins)),
+	}
+
+	if mfa != nil {
+		export.MFA = dto.ExportedMFA{
+			Enrolled:          true,
+			Enabled:           mfa.IsEnabled(),
+			RecoveryCodesLeft: len(mfa.RecoveryCodes()),
+		}
+	}
+
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, toSessionInfo(session))
+	}
+
+	for _, membership := range memberships {
+		export.Stores = append(export.Stores, toStoreMemberInfo(membership))
+	}
+
+	for _, invitation := range invitations {
+		export.Invitations = append(export.Invitations, toInvitationInfo(invitation))
+	}
+
+	for _, event := range events {
+		export.AuditEvents = append(export.AuditEvents, toAuditEventInfo(event))
+	}
+
+	for _, attempt := range logins {
+		export.LoginHistory = append(export.LoginHistory, toLoginAttemptInfo(attempt))
+	}
+
+	uc.logger.Info("personal data exported", "userID", userID)
+	return export, nil
+}
+
+// auditEvents returns the events the user took part in, newest first. Most
+// of a user's own actions name them as both actor and target, so events are
+// deduplicated.
+func (uc *exportMyDataUseCase) auditEvents(ctx context.Context, userID uuid.UUID) ([]*entity.AuditEvent, error) {
+	seen := make(map[uuid.UUID]bool)
+	var events []*entity.AuditEvent
+
+	for _, filter := range []dto.AuditFilter{{ActorID: &userID}, {TargetID: &userID}} {
+		for page := 1; ; page++ {
+			result, err := uc.audit.List(ctx, filter, common.NewPagination(page, exportPageSize, "", "", ""))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, event := range result.Items {
+				if !seen[event.ID()] {
+					seen[event.ID()] = true
+					events = append(events, event)
+				}
+			}
+
+			if page >= result.TotalPages {
+				break
+			}
+		}
+	}
+
+	slices.SortFunc(events, func(a, b *entity.AuditEvent) int {
+		return b.OccurredAt().Compare(a.OccurredAt())
+	})
+
+	return events, nil
+}
+
+// loginHistory returns every login attempt of the user, newest first.
+func (uc *exportMyDataUseCase) loginHistory(ctx context.Context, userID uuid.UUID) ([]*entity.LoginAttempt, error) {
+	var attempts []*entity.LoginAttempt
+
+	for page := 1; ; page++ {
+		result, err := uc.logins.List(ctx, userID, common.NewPagination(page, exportPageSize, "", "", ""))
+		if err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, result.Items...)
+
+		if page >= result.TotalPages {
+			return attempts, nil
+		}
+	}
+}
+
+func toExportedProfile(user *entity.User) dto.ExportedProfile {
+	return dto.ExportedProfile{
+		ID:             user.ID(),
+		Username:       user.Username(),
+		Email:          user.Email().String(),
+		Roles:          toUserInfo(user).Role,
+		Active:         user.IsActive(),
+		EmailVerified:  user.EmailVerified(),
+		FailedAttempts: user.FailedAttempts(),
+		LockedUntil:    user.LockedUntil(),
+	}
+}
+
+func toInvitationInfo(invitation *entity.Invitation) dto.InvitationInfo {
+	roles := make([]string, 0, len(invitation.Roles()))
+	for _, role := range invitation.Roles() {
+		roles = append(roles, role.String())
+	}
+
+	return dto.InvitationInfo{
+		ID:         invitation.ID(),
+		Email:      invitation.Email().String(),
+		StoreID:    invitation.StoreID(),
+		Roles:      roles,
+		InvitedBy:  invitation.InvitedBy(),
+		CreatedAt:  invitation.CreatedAt(),
+		ExpiresAt:  invitation.ExpiresAt(),
+		AcceptedAt: invitation.AcceptedAt(),
+		RevokedAt:  invitation.RevokedAt(),
+	}
+}
+
+func toAuditEventInfo(event *entity.AuditEvent) dto.AuditEventInfo {
+	changes := make(map[string]dto.AuditChangeInfo, len(event.Changes()))
+	for field, change := range event.Changes() {
+		changes[field] = dto.AuditChangeInfo{Before: change.Before, After: change.After}
+	}
+
+	return dto.AuditEventInfo{
+		ID:             event.ID(),
+		Action:         event.Action().String(),
+		ActorID:        event.ActorID(),
+		TargetID:       event.TargetID(),
+		ImpersonatorID: event.ImpersonatorID(),
+		IPAddress:      event.IPAddress(),
+		UserAgent:      event.UserAgent(),
+		Changes:        changes,
+		OccurredAt:     event.OccurredAt(),
+	}
+}
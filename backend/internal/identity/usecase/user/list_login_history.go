@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/google/uuid"
+)
+
+type listLoginHistoryUseCase struct {
+	history ports.LoginHistoryRepository
+	logger  ports.Logger
+}
+
+func NewListLoginHistoryUseCase(history ports.LoginHistoryRepository, logger ports.Logger) user.ListLoginHistoryUseCase {
+	return &listLoginHistoryUseCase{
+		history: history,
+		logger:  logger,
+	}
+}
+
+// Execute lists the user's login attempts, newest first, failed ones
+// included, so they can spot attempts they did not make.
+func (uc *listLoginHistoryUseCase) Execute(ctx context.Context, userID uuid.UUID, pagination common.Pagination) (*common.PaginatedResult[dto.LoginAttemptInfo], error) {
+	uc.logger.Debug("listing login history", "userID", userID)
+
+	result, err := uc.history.List(ctx, userID, pagination)
+	if err != nil {
+		uc.logger.Error("failed to list login history", err, "userID", userID)
+		return nil, err
+	}
+
+	items := make([]dto.LoginAttemptInfo, 0, len(result.Items))
+	for _, attempt := range result.Items {
+		items = append(items, toLoginAttemptInfo(attempt))
+	}
+
+	return common.NewPaginatedResult(items, result.TotalCount, pagination), nil
+}
+
+func toLoginAttemptInfo(attempt *entity.LoginAttempt) dto.LoginAttemptInfo {
+	return dto.LoginAttemptInfo{
+		ID:         attempt.ID(),
+		Outcome:    attempt.Outcome().String(),
+		IPAddress:  attempt.IPAddress(),
+		UserAgent:  attempt.UserAgent(),
+		Device:     attempt.Device().String(),
+		OccurredAt: attempt.OccurredAt(),
+	}
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/google/uuid"
+)
+
+type listMyStoresUseCase struct {
+	memberships ports.StoreMembershipRepository
+	logger      ports.Logger
+}
+
+func NewListMyStoresUseCase(memberships ports.StoreMembershipRepository, logger ports.Logger) user.ListMyStoresUseCase {
+	return &listMyStoresUseCase{
+		memberships: memberships,
+		logger:      logger,
+	}
+}
+
+// Execute lists the stores the user can switch into, suspended ones included
+// so the client can tell why a store is unavailable.
+func (uc *listMyStoresUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.StoreMemberInfo, error) {
+	uc.logger.Debug("listing user stores", "userID", userID)
+
+	memberships, err := uc.memberships.ListByUser(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to list store memberships", err, "userID", userID)
+		return nil, err
+	}
+
+	result := make([]dto.StoreMemberInfo, 0, len(memberships))
+	for _, membership := range memberships {
+		result = append(result, toStoreMemberInfo(membership))
+	}
+
+	return result, nil
+}
+
+func toStoreMemberInfo(membership *entity.StoreMembership) dto.StoreMemberInfo {
+	roles := make([]string, 0, len(membership.Roles()))
+	for _, role := range membership.Roles() {
+		roles = append(roles, role.String())
+	}
+
+	return dto.StoreMemberInfo{
+		UserID:    membership.UserID(),
+		StoreID:   membership.StoreID(),
+		Roles:     roles,
+		Status:    membership.Status().String(),
+		CreatedAt: membership.CreatedAt(),
+		UpdatedAt: membership.UpdatedAt(),
+	}
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"slices"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/google/uuid"
+)
+
+type listSessionsUseCase struct {
+	refreshRepo ports.RefreshTokenRepository
+	logger      ports.Logger
+}
+
+func NewListSessionsUseCase(refreshRepo ports.RefreshTokenRepository, logger ports.Logger) user.ListSessionsUseCase {
+	return &listSessionsUseCase{
+		refreshRepo: refreshRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *listSessionsUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]dto.SessionInfo, error) {
+	uc.logger.Debug("listing user sessions", "userID", userID)
+
+	sessions, err := uc.refreshRepo.ListSessions(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to list sessions", err, "userID", userID)
+		return nil, err
+	}
+
+	slices.SortFunc(sessions, func(a, b *entity.Session) int {
+		return b.LastUsedAt().Compare(a.LastUsedAt())
+	})
+
+	result := make([]dto.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, toSessionInfo(session))
+	}
+
+	return result, nil
+}
+
+func toSessionInfo(session *entity.Session) dto.SessionInfo {
+	return dto.SessionInfo{
+		ID:         session.ID(),
+		CreatedAt:  session.CreatedAt(),
+		LastUsedAt: session.LastUsedAt(),
+		IPAddress:  session.IPAddress(),
+		UserAgent:  session.UserAgent(),
+	}
+}
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLoginHistoryUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	pagination := common.NewPagination(1, 10, "", "occurred_at", "DESC")
+	failed := entity.NewLoginAttempt(userID, vo.LoginInvalidPassword, "203.0.113.7", "curl/8.5.0", time.Now())
+
+	t.Run("Success", func(t *testing.T) {
+		history := new(MockLoginHistoryRepository)
+		history.On("List", context.Background(), userID, pagination).Return(
+			common.NewPaginatedResult([]*entity.LoginAttempt{failed}, 1, pagination), nil)
+
+		uc := NewListLoginHistoryUseCase(history, new(MockLogger))
+		result, err := uc.Execute(context.Background(), userID, pagination)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), result.TotalCount)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, failed.ID(), result.Items[0].ID)
+		assert.Equal(t, "invalid_password", result.Items[0].Outcome)
+		assert.Equal(t, "203.0.113.7", result.Items[0].IPAddress)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		history := new(MockLoginHistoryRepository)
+		history.On("List", context.Background(), userID, pagination).Return(nil, assert.AnError)
+
+		uc := NewListLoginHistoryUseCase(history, new(MockLogger))
+		_, err := uc.Execute(context.Background(), userID, pagination)
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
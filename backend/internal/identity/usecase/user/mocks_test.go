@@ -2,8 +2,10 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/MuriloFlores/order-manager/internal/common"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
 	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
 	"github.com/google/uuid"
@@ -36,6 +38,14 @@ func (m *MockUserRepository) FindByEmail(ctx context.Context, email vo.Email) (*
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (m *MockUserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
 func (m *MockUserRepository) GetUsersInfo(ctx context.Context, roles []vo.Role, pagination common.Pagination) (*common.PaginatedResult[*entity.User], error) {
 	args := m.Called(ctx, roles, pagination)
 	if args.Get(0) == nil {
@@ -49,6 +59,65 @@ func (m *MockUserRepository) Update(ctx context.Context, user *entity.User) erro
 	return args.Error(0)
 }
 
+// MockRefreshTokenRepository
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepository) SaveRefreshToken(ctx context.Context, refreshToken *entity.RefreshToken, expiresIn time.Duration) error {
+	args := m.Called(ctx, refreshToken, expiresIn)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) FindRefreshToken(ctx context.Context, refreshToken string) (*entity.RefreshToken, error) {
+	args := m.Called(ctx, refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) MarkRefreshTokenRotated(ctx context.Context, refreshToken string) (bool, error) {
+	args := m.Called(ctx, refreshToken)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) DeleteRefreshToken(ctx context.Context, refreshToken string) error {
+	args := m.Called(ctx, refreshToken)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, userID uuid.UUID, familyID uuid.UUID) error {
+	args := m.Called(ctx, userID, familyID)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) DeleteAllRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) SaveSession(ctx context.Context, session *entity.Session, expiresIn time.Duration) error {
+	args := m.Called(ctx, session, expiresIn)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) FindSession(ctx context.Context, sessionID uuid.UUID) (*entity.Session, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Session), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Session), args.Error(1)
+}
+
 // MockLogger implements ports.Logger for testing
 type MockLogger struct {
 	mock.Mock
@@ -60,6 +129,106 @@ func (m *MockLogger) Error(msg string, err error, keysAndValues ...any) {}
 
 func (m *MockLogger) Debug(msg string, keysAndValues ...any) {}
 
+// MockNotificationService implements ports.NotificationService for testing
+type MockNotificationService struct {
+	mock.Mock
+}
+
+func (m *MockNotificationService) SendForgotPasswordEmail(ctx context.Context, email vo.Email, otp vo.OTP) error {
+	args := m.Called(ctx, email, otp)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendChangePasswordEmail(ctx context.Context, email vo.Email, otp vo.OTP) error {
+	args := m.Called(ctx, email, otp)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendVerificationEmail(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendInvitationEmail(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendAccountLockedEmail(ctx context.Context, email vo.Email, lockedUntil time.Time, token string) error {
+	args := m.Called(ctx, email, lockedUntil, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendEmailChangeConfirmation(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendEmailChangeNotice(ctx context.Context, email vo.Email, newEmail vo.Email) error {
+	args := m.Called(ctx, email, newEmail)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendNewDeviceLoginAlert(ctx context.Context, email vo.Email, device vo.DeviceFingerprint, ipAddress string, at time.Time) error {
+	args := m.Called(ctx, email, device, ipAddress, at)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendMagicLinkEmail(ctx context.Context, email vo.Email, token string) error {
+	args := m.Called(ctx, email, token)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) SendAccountCreatedEmail(ctx context.Context, email vo.Email, username string, token string) error {
+	args := m.Called(ctx, email, username, token)
+	return args.Error(0)
+}
+
+// MockVerificationTokenManager implements security.VerificationTokenManager for testing
+type MockVerificationTokenManager struct {
+	mock.Mock
+}
+
+func (m *MockVerificationTokenManager) GenerateVerificationToken(user *entity.User) (string, error) {
+	args := m.Called(user)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateVerificationToken(tokenString string) (uuid.UUID, vo.Email, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(vo.Email), args.Error(2)
+}
+
+func (m *MockVerificationTokenManager) GenerateUnlockToken(user *entity.User) (string, error) {
+	args := m.Called(user)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateUnlockToken(tokenString string) (uuid.UUID, time.Time, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *MockVerificationTokenManager) GenerateEmailChangeToken(user *entity.User, newEmail vo.Email) (string, error) {
+	args := m.Called(user, newEmail)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateEmailChangeToken(tokenString string) (uuid.UUID, vo.Email, vo.Email, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(vo.Email), args.Get(2).(vo.Email), args.Error(3)
+}
+
+func (m *MockVerificationTokenManager) GenerateMagicLinkToken(user *entity.User, linkID uuid.UUID) (string, error) {
+	args := m.Called(user, linkID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockVerificationTokenManager) ValidateMagicLinkToken(tokenString string) (uuid.UUID, vo.Email, uuid.UUID, error) {
+	args := m.Called(tokenString)
+	return args.Get(0).(uuid.UUID), args.Get(1).(vo.Email), args.Get(2).(uuid.UUID), args.Error(3)
+}
+
 // MockTransactionManager implements ports.TransactionManager for testing
 type MockTransactionManager struct {
 	mock.Mock
@@ -72,3 +241,257 @@ func (m *MockTransactionManager) Execute(ctx context.Context, fn func(ctx contex
 	}
 	return fn(ctx)
 }
+
+// MockStoreMembershipRepository implements ports.StoreMembershipRepository for testing
+type MockStoreMembershipRepository struct {
+	mock.Mock
+}
+
+func (m *MockStoreMembershipRepository) Save(ctx context.Context, membership *entity.StoreMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Update(ctx context.Context, membership *entity.StoreMembership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Delete(ctx context.Context, userID, storeID uuid.UUID) error {
+	args := m.Called(ctx, userID, storeID)
+	return args.Error(0)
+}
+
+func (m *MockStoreMembershipRepository) Find(ctx context.Context, userID, storeID uuid.UUID) (*entity.StoreMembership, error) {
+	args := m.Called(ctx, userID, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.StoreMembership), args.Error(1)
+}
+
+func (m *MockStoreMembershipRepository) ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.StoreMembership, error) {
+	args := m.Called(ctx, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StoreMembership), args.Error(1)
+}
+
+func (m *MockStoreMembershipRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.StoreMembership, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StoreMembership), args.Error(1)
+}
+
+// MockInvitationRepository implements ports.InvitationRepository for testing
+type MockInvitationRepository struct {
+	mock.Mock
+}
+
+func (m *MockInvitationRepository) Save(ctx context.Context, invitation *entity.Invitation) error {
+	args := m.Called(ctx, invitation)
+	return args.Error(0)
+}
+
+func (m *MockInvitationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Invitation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Invitation), args.Error(1)
+}
+
+func (m *MockInvitationRepository) FindByTokenHash(ctx context.Context, hash string) (*entity.Invitation, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Invitation), args.Error(1)
+}
+
+func (m *MockInvitationRepository) ListByStore(ctx context.Context, storeID uuid.UUID) ([]*entity.Invitation, error) {
+	args := m.Called(ctx, storeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Invitation), args.Error(1)
+}
+
+func (m *MockInvitationRepository) ListByEmail(ctx context.Context, email vo.Email) ([]*entity.Invitation, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Invitation), args.Error(1)
+}
+
+func (m *MockInvitationRepository) RedactEmail(ctx context.Context, email vo.Email, replacement vo.Email) error {
+	args := m.Called(ctx, email, replacement)
+	return args.Error(0)
+}
+
+func (m *MockInvitationRepository) UpdateAcceptedAt(ctx context.Context, invitation *entity.Invitation) error {
+	args := m.Called(ctx, invitation)
+	return args.Error(0)
+}
+
+func (m *MockInvitationRepository) UpdateRevokedAt(ctx context.Context, invitation *entity.Invitation) error {
+	args := m.Called(ctx, invitation)
+	return args.Error(0)
+}
+
+// stubPasswordCorpus implements vo.PasswordCorpus for testing
+type stubPasswordCorpus map[string]bool
+
+func (c stubPasswordCorpus) Contains(plainText string) bool {
+	return c[plainText]
+}
+
+// MockAuditEventRepository implements ports.AuditEventRepository for testing
+type MockAuditEventRepository struct {
+	mock.Mock
+}
+
+// newMockAuditLog accepts every event, so tests only assert on the trail when
+// it is what they cover.
+func newMockAuditLog() *MockAuditEventRepository {
+	m := new(MockAuditEventRepository)
+	m.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return m
+}
+
+func (m *MockAuditEventRepository) Append(ctx context.Context, event *entity.AuditEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAuditEventRepository) List(ctx context.Context, filter dto.AuditFilter, pagination common.Pagination) (*common.PaginatedResult[*entity.AuditEvent], error) {
+	args := m.Called(ctx, filter, pagination)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*common.PaginatedResult[*entity.AuditEvent]), args.Error(1)
+}
+
+// recordedActions lists the actions appended to the audit log, in order.
+func (m *MockAuditEventRepository) recordedActions() []vo.AuditAction {
+	var actions []vo.AuditAction
+	for _, call := range m.Calls {
+		if call.Method == "Append" {
+			actions = append(actions, call.Arguments.Get(1).(*entity.AuditEvent).Action())
+		}
+	}
+	return actions
+}
+
+// MockOTPRepository
+type MockOTPRepository struct {
+	mock.Mock
+}
+
+func (m *MockOTPRepository) SaveOTP(ctx context.Context, email vo.Email, otp vo.OTP, expiresIn time.Duration) error {
+	args := m.Called(ctx, email, otp, expiresIn)
+	return args.Error(0)
+}
+
+func (m *MockOTPRepository) VerifyOTP(ctx context.Context, email vo.Email, otp vo.OTP) (bool, error) {
+	args := m.Called(ctx, email, otp)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOTPRepository) IncrementOTPAttempts(ctx context.Context, email vo.Email) (int, error) {
+	args := m.Called(ctx, email)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOTPRepository) DeleteOTP(ctx context.Context, email vo.Email) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+func (m *MockOTPRepository) StartOTPCooldown(ctx context.Context, email vo.Email, duration time.Duration) (bool, error) {
+	args := m.Called(ctx, email, duration)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockMFARepository
+type MockMFARepository struct {
+	mock.Mock
+}
+
+func (m *MockMFARepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserMFA), args.Error(1)
+}
+
+func (m *MockMFARepository) Save(ctx context.Context, mfa *entity.UserMFA) error {
+	args := m.Called(ctx, mfa)
+	return args.Error(0)
+}
+
+func (m *MockMFARepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// MockPasswordHistoryRepository implements ports.PasswordHistoryRepository for testing
+type MockPasswordHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordHistoryRepository) Add(ctx context.Context, userID uuid.UUID, password vo.Password, keep int) error {
+	args := m.Called(ctx, userID, password, keep)
+	return args.Error(0)
+}
+
+func (m *MockPasswordHistoryRepository) ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]vo.Password, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]vo.Password), args.Error(1)
+}
+
+func (m *MockPasswordHistoryRepository) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// MockLoginHistoryRepository implements ports.LoginHistoryRepository for testing
+type MockLoginHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoginHistoryRepository) Append(ctx context.Context, attempt *entity.LoginAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func (m *MockLoginHistoryRepository) List(ctx context.Context, userID uuid.UUID, pagination common.Pagination) (*common.PaginatedResult[*entity.LoginAttempt], error) {
+	args := m.Called(ctx, userID, pagination)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*common.PaginatedResult[*entity.LoginAttempt]), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) SeenFrom(ctx context.Context, userID uuid.UUID, device vo.DeviceFingerprint, ipAddress string, since time.Time) (bool, error) {
+	args := m.Called(ctx, userID, device, ipAddress, since)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) HasSucceeded(ctx context.Context, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
@@ -36,15 +36,20 @@ func (uc *MyInfoUseCase) Execute(ctx context.Context, userID uuid.UUID) (*dto.Us
 		return nil, entity.ErrUserNotFound
 	}
 
-	rolesStr := make([]string, len(userData.Roles()))
-	for i, role := range userData.Roles() {
+	uc.logger.Info("user info retrieved", "userID", userID)
+	info := toUserInfo(userData)
+	return &info, nil
+}
+
+func toUserInfo(user *entity.User) dto.UserInfo {
+	rolesStr := make([]string, len(user.Roles()))
+	for i, role := range user.Roles() {
 		rolesStr[i] = role.String()
 	}
 
-	uc.logger.Info("user info retrieved", "userID", userID)
-	return &dto.UserInfo{
-		Username: userData.Username(),
-		Email:    userData.Email().String(),
+	return dto.UserInfo{
+		Username: user.Username(),
+		Email:    user.Email().String(),
 		Role:     rolesStr,
-	}, nil
+	}
 }
@@ -17,8 +17,8 @@ func TestMyInfoUseCase_Execute(t *testing.T) {
 	username := "testuser"
 	
 	setupUser := func() *entity.User {
-		password, _ := vo.NewPassword("Password123!", "pepper")
-		user, _ := entity.RestoreUser(userID, emailStr, username, password.String(), []string{"EMPLOYEE"}, true)
+		password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+		user, _ := entity.RestoreUser(userID, emailStr, username, password.String(), []string{"EMPLOYEE"}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
 		return user
 	}
 
@@ -0,0 +1,217 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newProfileUser(t *testing.T, id uuid.UUID, email, username string) *entity.User {
+	t.Helper()
+
+	password, _ := vo.NewPassword("Password123!", vo.SinglePepper("pepper"))
+	user, err := entity.RestoreUser(id, email, username, password.String(), []string{vo.EmployeeRole.String()}, true, 0, nil, true, 0, nil, vo.SystemRoleCatalog())
+	assert.NoError(t, err)
+	return user
+}
+
+func TestUpdateProfileUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	currentEmail, _ := vo.NewEmail("old@example.com")
+	newEmail, _ := vo.NewEmail("new@example.com")
+	newUsername := "renamed"
+	newEmailStr := newEmail.String()
+	sameEmailStr := currentEmail.String()
+
+	tests := []struct {
+		name         string
+		input        dto.UpdateProfileInput
+		setup        func(*MockUserRepository, *MockVerificationTokenManager, *MockNotificationService)
+		wantErr      error
+		wantUsername string
+		wantPending  string
+		wantAudit    []vo.AuditAction
+	}{
+		{
+			name:  "Renames At Once",
+			input: dto.UpdateProfileInput{Username: &newUsername},
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByUsername", mock.Anything, newUsername).Return(nil, nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.Username() == newUsername
+				})).Return(nil)
+			},
+			wantUsername: newUsername,
+			wantAudit:    []vo.AuditAction{vo.AuditProfileUpdated},
+		},
+		{
+			name:  "Username Taken",
+			input: dto.UpdateProfileInput{Username: &newUsername},
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByUsername", mock.Anything, newUsername).Return(newProfileUser(t, uuid.New(), "other@example.com", newUsername), nil)
+			},
+			wantErr: entity.ErrUsernameTaken,
+		},
+		{
+			name:  "New Email Waits For Confirmation",
+			input: dto.UpdateProfileInput{Email: &newEmailStr},
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", mock.Anything, newEmail).Return(nil, nil)
+				vt.On("GenerateEmailChangeToken", mock.Anything, newEmail).Return("change-token", nil)
+				ns.On("SendEmailChangeConfirmation", mock.Anything, newEmail, "change-token").Return(nil)
+				ns.On("SendEmailChangeNotice", mock.Anything, currentEmail, newEmail).Return(nil)
+			},
+			wantUsername: "testuser",
+			wantPending:  newEmailStr,
+			wantAudit:    []vo.AuditAction{vo.AuditEmailChangeRequest},
+		},
+		{
+			name:  "Failed Notice To Old Address Does Not Fail The Request",
+			input: dto.UpdateProfileInput{Email: &newEmailStr},
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", mock.Anything, newEmail).Return(nil, nil)
+				vt.On("GenerateEmailChangeToken", mock.Anything, newEmail).Return("change-token", nil)
+				ns.On("SendEmailChangeConfirmation", mock.Anything, newEmail, "change-token").Return(nil)
+				ns.On("SendEmailChangeNotice", mock.Anything, currentEmail, newEmail).Return(assert.AnError)
+			},
+			wantUsername: "testuser",
+			wantPending:  newEmailStr,
+			wantAudit:    []vo.AuditAction{vo.AuditEmailChangeRequest},
+		},
+		{
+			name:  "Email Already Registered",
+			input: dto.UpdateProfileInput{Email: &newEmailStr},
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByEmail", mock.Anything, newEmail).Return(newProfileUser(t, uuid.New(), newEmailStr, "other"), nil)
+			},
+			wantErr: entity.ErrEmailAlreadyRegistered,
+		},
+		{
+			name:  "Failed Confirmation Leaves Username Unchanged",
+			input: dto.UpdateProfileInput{Username: &newUsername, Email: &newEmailStr},
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+				ur.On("FindByUsername", mock.Anything, newUsername).Return(nil, nil)
+				ur.On("FindByEmail", mock.Anything, newEmail).Return(nil, nil)
+				vt.On("GenerateEmailChangeToken", mock.Anything, newEmail).Return("change-token", nil)
+				ns.On("SendEmailChangeConfirmation", mock.Anything, newEmail, "change-token").Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name:  "Current Email Is A No-op",
+			input: dto.UpdateProfileInput{Email: &sameEmailStr},
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager, ns *MockNotificationService) {
+			},
+			wantUsername: "testuser",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			vt := new(MockVerificationTokenManager)
+			ns := new(MockNotificationService)
+			audit := newMockAuditLog()
+
+			ur.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, currentEmail.String(), "testuser"), nil)
+			tt.setup(ur, vt, ns)
+
+			uc := NewUpdateProfileUseCase(ur, vt, ns, audit, new(MockLogger))
+			result, err := uc.Execute(context.Background(), userID, tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				ur.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantUsername, result.Username)
+				assert.Equal(t, currentEmail.String(), result.Email)
+				assert.Equal(t, tt.wantPending, result.PendingEmail)
+			}
+
+			assert.Equal(t, tt.wantAudit, audit.recordedActions())
+			ur.AssertExpectations(t)
+			vt.AssertExpectations(t)
+			ns.AssertExpectations(t)
+		})
+	}
+}
+
+func TestConfirmEmailChangeUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	token := "change-token"
+	currentEmail, _ := vo.NewEmail("old@example.com")
+	newEmail, _ := vo.NewEmail("new@example.com")
+
+	tests := []struct {
+		name    string
+		setup   func(*MockUserRepository, *MockVerificationTokenManager)
+		wantErr error
+	}{
+		{
+			name: "Success",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateEmailChangeToken", token).Return(userID, currentEmail, newEmail, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, currentEmail.String(), "testuser"), nil)
+				ur.On("FindByEmail", mock.Anything, newEmail).Return(nil, nil)
+				ur.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+					return u.Email().Equals(newEmail) && u.EmailVerified()
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Link Already Used Or Outdated",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateEmailChangeToken", token).Return(userID, currentEmail, newEmail, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, newEmail.String(), "testuser"), nil)
+			},
+			wantErr: entity.ErrInvalidEmailChangeToken,
+		},
+		{
+			name: "Email Taken Since The Request",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateEmailChangeToken", token).Return(userID, currentEmail, newEmail, nil)
+				ur.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, currentEmail.String(), "testuser"), nil)
+				ur.On("FindByEmail", mock.Anything, newEmail).Return(newProfileUser(t, uuid.New(), newEmail.String(), "other"), nil)
+			},
+			wantErr: entity.ErrEmailAlreadyRegistered,
+		},
+		{
+			name: "Invalid Token",
+			setup: func(ur *MockUserRepository, vt *MockVerificationTokenManager) {
+				vt.On("ValidateEmailChangeToken", token).Return(uuid.Nil, vo.Email(""), vo.Email(""), assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := new(MockUserRepository)
+			vt := new(MockVerificationTokenManager)
+			audit := newMockAuditLog()
+			tt.setup(ur, vt)
+
+			uc := NewConfirmEmailChangeUseCase(ur, vt, audit, new(MockLogger))
+			err := uc.Execute(context.Background(), token)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, audit.recordedActions())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []vo.AuditAction{vo.AuditEmailChanged}, audit.recordedActions())
+			}
+
+			ur.AssertExpectations(t)
+			vt.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/google/uuid"
+)
+
+type revokeAllSessionsUseCase struct {
+	userRepo    ports.UserRepository
+	refreshRepo ports.RefreshTokenRepository
+	logger      ports.Logger
+}
+
+func NewRevokeAllSessionsUseCase(userRepo ports.UserRepository, refreshRepo ports.RefreshTokenRepository, logger ports.Logger) user.RevokeAllSessionsUseCase {
+	return &revokeAllSessionsUseCase{
+		userRepo:    userRepo,
+		refreshRepo: refreshRepo,
+		logger:      logger,
+	}
+}
+
+// Execute bumps the token version along with deleting the refresh tokens, so
+// the access tokens already issued stop working right away as well.
+func (uc *revokeAllSessionsUseCase) Execute(ctx context.Context, userID uuid.UUID) error {
+	uc.logger.Debug("revoking all sessions", "userID", userID)
+
+	currentUser, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user", err, "userID", userID)
+		return err
+	}
+
+	if currentUser == nil {
+		uc.logger.Info("user not found for session revocation", "userID", userID)
+		return entity.ErrUserNotFound
+	}
+
+	currentUser.RevokeSessions()
+	if err := uc.userRepo.Update(ctx, currentUser); err != nil {
+		uc.logger.Error("failed to update token version", err, "userID", userID)
+		return err
+	}
+
+	if err := uc.refreshRepo.DeleteAllRefreshTokens(ctx, userID); err != nil {
+		uc.logger.Error("failed to revoke all sessions", err, "userID", userID)
+		return err
+	}
+
+	uc.logger.Info("user logged out everywhere", "userID", userID)
+	return nil
+}
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/google/uuid"
+)
+
+type revokeSessionUseCase struct {
+	refreshRepo ports.RefreshTokenRepository
+	logger      ports.Logger
+}
+
+func NewRevokeSessionUseCase(refreshRepo ports.RefreshTokenRepository, logger ports.Logger) user.RevokeSessionUseCase {
+	return &revokeSessionUseCase{
+		refreshRepo: refreshRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *revokeSessionUseCase) Execute(ctx context.Context, userID uuid.UUID, sessionID string) error {
+	uc.logger.Debug("revoking session", "userID", userID, "sessionID", sessionID)
+
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return entity.ErrSessionNotFound
+	}
+
+	session, err := uc.refreshRepo.FindSession(ctx, id)
+	if err != nil {
+		uc.logger.Info("session not found for revocation", "userID", userID, "sessionID", sessionID)
+		return err
+	}
+
+	// Someone else's session is reported as missing, not as forbidden, so
+	// session IDs can't be probed.
+	if session.UserID() != userID {
+		uc.logger.Info("session revocation refused: session belongs to another user", "userID", userID, "sessionID", sessionID)
+		return entity.ErrSessionNotFound
+	}
+
+	if err := uc.refreshRepo.RevokeFamily(ctx, userID, id); err != nil {
+		uc.logger.Error("failed to revoke session", err, "userID", userID, "sessionID", sessionID)
+		return err
+	}
+
+	uc.logger.Info("session revoked", "userID", userID, "sessionID", sessionID)
+	return nil
+}
@@ -0,0 +1,138 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListSessionsUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+	older := entity.RestoreSession(uuid.New(), userID, now.Add(-48*time.Hour), now.Add(-24*time.Hour), "10.0.0.1", "phone")
+	newer := entity.RestoreSession(uuid.New(), userID, now.Add(-2*time.Hour), now.Add(-time.Minute), "10.0.0.2", "laptop")
+
+	t.Run("Success - Most recently used first", func(t *testing.T) {
+		mockRR := new(MockRefreshTokenRepository)
+		mockRR.On("ListSessions", mock.Anything, userID).Return([]*entity.Session{older, newer}, nil)
+
+		uc := NewListSessionsUseCase(mockRR, new(MockLogger))
+		sessions, err := uc.Execute(context.Background(), userID)
+
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 2)
+		assert.Equal(t, newer.ID(), sessions[0].ID)
+		assert.Equal(t, "laptop", sessions[0].UserAgent)
+		assert.Equal(t, older.ID(), sessions[1].ID)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockRR := new(MockRefreshTokenRepository)
+		mockRR.On("ListSessions", mock.Anything, userID).Return(nil, assert.AnError)
+
+		uc := NewListSessionsUseCase(mockRR, new(MockLogger))
+		_, err := uc.Execute(context.Background(), userID)
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestRevokeSessionUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	tests := []struct {
+		name      string
+		sessionID string
+		setup     func(*MockRefreshTokenRepository)
+		wantErr   error
+	}{
+		{
+			name:      "Success",
+			sessionID: sessionID.String(),
+			setup: func(rr *MockRefreshTokenRepository) {
+				rr.On("FindSession", mock.Anything, sessionID).Return(entity.NewSession(sessionID, userID, "", "", time.Now()), nil)
+				rr.On("RevokeFamily", mock.Anything, userID, sessionID).Return(nil)
+			},
+		},
+		{
+			name:      "Session Of Another User",
+			sessionID: sessionID.String(),
+			setup: func(rr *MockRefreshTokenRepository) {
+				rr.On("FindSession", mock.Anything, sessionID).Return(entity.NewSession(sessionID, uuid.New(), "", "", time.Now()), nil)
+			},
+			wantErr: entity.ErrSessionNotFound,
+		},
+		{
+			name:      "Session Not Found",
+			sessionID: sessionID.String(),
+			setup: func(rr *MockRefreshTokenRepository) {
+				rr.On("FindSession", mock.Anything, sessionID).Return(nil, entity.ErrSessionNotFound)
+			},
+			wantErr: entity.ErrSessionNotFound,
+		},
+		{
+			name:      "Invalid Session ID",
+			sessionID: "not-a-uuid",
+			setup:     func(rr *MockRefreshTokenRepository) {},
+			wantErr:   entity.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRR := new(MockRefreshTokenRepository)
+			tt.setup(mockRR)
+
+			uc := NewRevokeSessionUseCase(mockRR, new(MockLogger))
+			err := uc.Execute(context.Background(), userID, tt.sessionID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRR.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRR.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRevokeAllSessionsUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("Success - Bumps token version", func(t *testing.T) {
+		mockUser := new(MockUserRepository)
+		mockUser.On("FindByID", mock.Anything, userID).Return(newProfileUser(t, userID, "test@example.com", "testuser"), nil)
+		mockUser.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool {
+			return u.TokenVersion() == 1
+		})).Return(nil)
+		mockRR := new(MockRefreshTokenRepository)
+		mockRR.On("DeleteAllRefreshTokens", mock.Anything, userID).Return(nil)
+
+		uc := NewRevokeAllSessionsUseCase(mockUser, mockRR, new(MockLogger))
+		err := uc.Execute(context.Background(), userID)
+
+		assert.NoError(t, err)
+		mockUser.AssertExpectations(t)
+		mockRR.AssertExpectations(t)
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		mockUser := new(MockUserRepository)
+		mockUser.On("FindByID", mock.Anything, userID).Return(nil, nil)
+		mockRR := new(MockRefreshTokenRepository)
+
+		uc := NewRevokeAllSessionsUseCase(mockUser, mockRR, new(MockLogger))
+		err := uc.Execute(context.Background(), userID)
+
+		assert.ErrorIs(t, err, entity.ErrUserNotFound)
+		mockRR.AssertNotCalled(t, "DeleteAllRefreshTokens", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,168 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/dto"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/entity"
+	"github.com/MuriloFlores/order-manager/internal/identity/domain/vo"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/security"
+	"github.com/MuriloFlores/order-manager/internal/identity/ports/user"
+	"github.com/MuriloFlores/order-manager/internal/identity/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+type updateProfileUseCase struct {
+	userRepo            ports.UserRepository
+	emailChangeTokens   security.VerificationTokenManager
+	notificationService ports.NotificationService
+	audit               ports.AuditEventRepository
+	logger              ports.Logger
+}
+
+func NewUpdateProfileUseCase(
+	userRepo ports.UserRepository,
+	emailChangeTokens security.VerificationTokenManager,
+	notificationService ports.NotificationService,
+	audit ports.AuditEventRepository,
+	logger ports.Logger,
+) user.UpdateProfileUseCase {
+	return &updateProfileUseCase{
+		userRepo:            userRepo,
+		emailChangeTokens:   emailChangeTokens,
+		notificationService: notificationService,
+		audit:               audit,
+		logger:              logger,
+	}
+}
+
+// Execute renames the user at once, but a new email only starts a change: the
+// current address stays in use until the link sent to the new one is
+// followed, so a typo or a hijacked session cannot take the account away from
+// its mailbox.
+func (uc *updateProfileUseCase) Execute(ctx context.Context, userID uuid.UUID, input dto.UpdateProfileInput) (*dto.UpdateProfileResult, error) {
+	uc.logger.Debug("starting profile update", "userID", userID)
+
+	currentUser, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		uc.logger.Error("failed to find user for profile update", err, "userID", userID)
+		return nil, err
+	}
+
+	if currentUser == nil {
+		uc.logger.Info("user not found for profile update", "userID", userID)
+		return nil, entity.ErrUserNotFound
+	}
+
+	previousUsername := currentUser.Username()
+	if input.Username != nil {
+		if err := uc.changeUsername(ctx, currentUser, strings.TrimSpace(*input.Username)); err != nil {
+			return nil, err
+		}
+	}
+
+	var pendingEmail vo.Email
+	if input.Email != nil {
+		pendingEmail, err = uc.newEmail(ctx, currentUser, *input.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the confirmation goes out before anything is saved, so a failed send
+	// leaves the profile as it was
+	if pendingEmail != "" {
+		if err := uc.requestEmailChange(ctx, currentUser, pendingEmail); err != nil {
+			return nil, err
+		}
+	}
+
+	if currentUser.Username() != previousUsername {
+		if err := uc.userRepo.Update(ctx, currentUser); err != nil {
+			uc.logger.Error("failed to update profile", err, "userID", userID)
+			return nil, err
+		}
+
+		auditlog.Record(ctx, uc.audit, uc.logger, selfAuditEvent(ctx, vo.AuditProfileUpdated, &userID, currentUser).
+			WithChange("username", previousUsername, currentUser.Username()))
+	}
+
+	uc.logger.Info("profile updated", "userID", userID, "emailChangePending", pendingEmail != "")
+	return &dto.UpdateProfileResult{
+		UserInfo:     toUserInfo(currentUser),
+		PendingEmail: pendingEmail.String(),
+	}, nil
+}
+
+func (uc *updateProfileUseCase) changeUsername(ctx context.Context, currentUser *entity.User, username string) error {
+	if username == currentUser.Username() {
+		return nil
+	}
+
+	existing, err := uc.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		uc.logger.Error("failed to find user by username", err, "userID", currentUser.ID())
+		return err
+	}
+
+	if existing != nil {
+		uc.logger.Info("username already taken", "userID", currentUser.ID())
+		return entity.ErrUsernameTaken
+	}
+
+	return currentUser.ChangeUsername(username)
+}
+
+// newEmail returns the address to confirm, or an empty one when it is the
+// current address.
+func (uc *updateProfileUseCase) newEmail(ctx context.Context, currentUser *entity.User, email string) (vo.Email, error) {
+	emailVO, err := vo.NewEmail(email)
+	if err != nil {
+		uc.logger.Info("invalid email in profile update", "userID", currentUser.ID())
+		return "", err
+	}
+
+	if emailVO.Equals(currentUser.Email()) {
+		return "", nil
+	}
+
+	existing, err := uc.userRepo.FindByEmail(ctx, emailVO)
+	if err != nil {
+		uc.logger.Error("failed to find user by email", err, "userID", currentUser.ID())
+		return "", err
+	}
+
+	if existing != nil {
+		uc.logger.Info("email change refused: email already registered", "userID", currentUser.ID())
+		return "", entity.ErrEmailAlreadyRegistered
+	}
+
+	return emailVO, nil
+}
+
+func (uc *updateProfileUseCase) requestEmailChange(ctx context.Context, currentUser *entity.User, newEmail vo.Email) error {
+	token, err := uc.emailChangeTokens.GenerateEmailChangeToken(currentUser, newEmail)
+	if err != nil {
+		uc.logger.Error("failed to generate email change token", err, "userID", currentUser.ID())
+		return err
+	}
+
+	if err := uc.notificationService.SendEmailChangeConfirmation(ctx, newEmail, token); err != nil {
+		uc.logger.Error("failed to send email change confirmation", err, "userID", currentUser.ID())
+		return err
+	}
+
+	// the notice is best effort: the change is harmless until confirmed and
+	// the request must not fail over a second email
+	if err := uc.notificationService.SendEmailChangeNotice(ctx, currentUser.Email(), newEmail); err != nil {
+		uc.logger.Error("failed to send email change notice", err, "userID", currentUser.ID())
+	}
+
+	userID := currentUser.ID()
+	auditlog.Record(ctx, uc.audit, uc.logger, selfAuditEvent(ctx, vo.AuditEmailChangeRequest, &userID, currentUser).
+		WithChange("email", currentUser.Email(), newEmail))
+
+	return nil
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS mfa_role_policies;
+DROP TABLE IF EXISTS user_mfa;
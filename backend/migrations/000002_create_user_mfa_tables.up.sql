@@ -0,0 +1,15 @@
+CREATE TABLE user_mfa
+(
+    user_id        UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret         VARCHAR(64) NOT NULL,
+    enabled        BOOLEAN     NOT NULL DEFAULT FALSE,
+    recovery_codes TEXT[]      NOT NULL DEFAULT '{}',
+    last_used_step BIGINT      NOT NULL DEFAULT 0
+);
+
+CREATE TABLE mfa_role_policies
+(
+    role VARCHAR(32) PRIMARY KEY,
+
+    CONSTRAINT chk_valid_mfa_role CHECK (role IN ('ADMIN', 'MANAGER', 'CASHIER', 'STOCK_CLERK'))
+);
@@ -0,0 +1,10 @@
+CREATE TABLE signing_keys
+(
+    kid         VARCHAR(64) PRIMARY KEY,
+    algorithm   VARCHAR(16) NOT NULL,
+    private_key BYTEA       NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    retired_at  TIMESTAMPTZ,
+
+    CONSTRAINT chk_valid_signing_algorithm CHECK (algorithm IN ('EdDSA', 'RS256'))
+);
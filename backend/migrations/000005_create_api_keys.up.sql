@@ -0,0 +1,18 @@
+CREATE TABLE api_keys
+(
+    id           UUID PRIMARY KEY,
+    name         VARCHAR(255) NOT NULL,
+    store_id     UUID         NOT NULL,
+    scopes       TEXT[]       NOT NULL,
+    prefix       VARCHAR(32)  NOT NULL,
+    hash         CHAR(64)     NOT NULL UNIQUE,
+    created_by   UUID         NOT NULL REFERENCES users (id),
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ,
+
+    CONSTRAINT chk_api_key_scopes CHECK (cardinality(scopes) > 0)
+);
+
+CREATE INDEX idx_api_keys_store_id ON api_keys (store_id);
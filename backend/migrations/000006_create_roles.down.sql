@@ -0,0 +1,15 @@
+-- The original constraints are restored NOT VALID: rows written since the up
+-- migration may hold EMPLOYEE or custom roles, which they never allowed.
+ALTER TABLE mfa_role_policies
+    DROP CONSTRAINT IF EXISTS fk_mfa_role_policies_role,
+    ADD CONSTRAINT chk_valid_mfa_role CHECK (role IN ('ADMIN', 'MANAGER', 'CASHIER', 'STOCK_CLERK')) NOT VALID;
+
+DROP TRIGGER IF EXISTS trg_roles_unused ON roles;
+DROP FUNCTION IF EXISTS check_role_unused();
+DROP TRIGGER IF EXISTS trg_users_roles_exist ON users;
+DROP FUNCTION IF EXISTS check_user_roles_exist();
+
+ALTER TABLE users
+    ADD CONSTRAINT chk_valid_roles CHECK (roles <@ ARRAY ['ADMIN', 'MANAGER', 'CASHIER', 'STOCK_CLERK']::TEXT[]) NOT VALID;
+
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,83 @@
+CREATE TABLE roles
+(
+    name        VARCHAR(32) PRIMARY KEY,
+    store_id    UUID,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    permissions TEXT[]       NOT NULL DEFAULT '{}',
+    system      BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_role_name CHECK (name ~ '^[A-Z][A-Z0-9_]{1,31}$'),
+    CONSTRAINT chk_role_owner CHECK (system = (store_id IS NULL))
+);
+
+CREATE INDEX idx_roles_store_id ON roles (store_id);
+
+-- System role permissions live in code; the rows exist so users and MFA
+-- policies can reference them like any other role.
+INSERT INTO roles (name, description, system)
+VALUES ('ADMIN', 'Full access to every store', TRUE),
+       ('MANAGER', 'Manages staff, catalog and stock', TRUE),
+       ('EMPLOYEE', 'Handles orders and stock', TRUE);
+
+-- chk_valid_roles allowed CASHIER and STOCK_CLERK, which the application never
+-- knew, and rejected EMPLOYEE, which it did. Legacy roles become EMPLOYEE;
+-- stores can recreate them as custom roles.
+UPDATE users
+SET roles = ARRAY(
+        SELECT DISTINCT CASE WHEN r IN ('CASHIER', 'STOCK_CLERK') THEN 'EMPLOYEE' ELSE r END
+        FROM unnest(roles) AS r
+            )
+WHERE roles && ARRAY ['CASHIER', 'STOCK_CLERK']::TEXT[];
+
+ALTER TABLE users
+    DROP CONSTRAINT chk_valid_roles;
+
+-- An array column cannot hold a foreign key, so both sides of the reference
+-- are enforced by triggers instead.
+CREATE FUNCTION check_user_roles_exist() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1
+               FROM unnest(NEW.roles) AS r(name)
+               WHERE NOT EXISTS (SELECT 1 FROM roles WHERE roles.name = r.name)) THEN
+        RAISE EXCEPTION 'user % references an unknown role', NEW.id
+            USING ERRCODE = 'foreign_key_violation';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_users_roles_exist
+    BEFORE INSERT OR UPDATE OF roles
+    ON users
+    FOR EACH ROW
+EXECUTE FUNCTION check_user_roles_exist();
+
+CREATE FUNCTION check_role_unused() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1 FROM users WHERE roles @> ARRAY [OLD.name]::TEXT[]) THEN
+        RAISE EXCEPTION 'role % is still assigned to users', OLD.name
+            USING ERRCODE = 'foreign_key_violation';
+    END IF;
+
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_roles_unused
+    BEFORE DELETE
+    ON roles
+    FOR EACH ROW
+EXECUTE FUNCTION check_role_unused();
+
+DELETE
+FROM mfa_role_policies
+WHERE role IN ('CASHIER', 'STOCK_CLERK');
+
+ALTER TABLE mfa_role_policies
+    DROP CONSTRAINT chk_valid_mfa_role,
+    ADD CONSTRAINT fk_mfa_role_policies_role FOREIGN KEY (role) REFERENCES roles (name) ON DELETE CASCADE;
@@ -0,0 +1,16 @@
+CREATE OR REPLACE FUNCTION check_role_unused() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1 FROM users WHERE roles @> ARRAY [OLD.name]::TEXT[]) THEN
+        RAISE EXCEPTION 'role % is still assigned to users', OLD.name
+            USING ERRCODE = 'foreign_key_violation';
+    END IF;
+
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_store_memberships_roles_exist ON store_memberships;
+DROP FUNCTION IF EXISTS check_membership_roles_exist();
+
+DROP TABLE IF EXISTS store_memberships;
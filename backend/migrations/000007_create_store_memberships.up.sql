@@ -0,0 +1,53 @@
+CREATE TABLE store_memberships
+(
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    store_id   UUID        NOT NULL,
+    roles      TEXT[]      NOT NULL,
+    status     VARCHAR(16) NOT NULL DEFAULT 'ACTIVE',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, store_id),
+    CONSTRAINT chk_membership_roles CHECK (cardinality(roles) > 0),
+    CONSTRAINT chk_membership_status CHECK (status IN ('ACTIVE', 'SUSPENDED'))
+);
+
+CREATE INDEX idx_store_memberships_store_id ON store_memberships (store_id);
+
+-- A membership may hold system roles and the custom roles of its own store,
+-- never the custom roles of another store.
+CREATE FUNCTION check_membership_roles_exist() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1
+               FROM unnest(NEW.roles) AS r(name)
+               WHERE NOT EXISTS (SELECT 1
+                                 FROM roles
+                                 WHERE roles.name = r.name
+                                   AND (roles.system OR roles.store_id = NEW.store_id))) THEN
+        RAISE EXCEPTION 'membership of user % in store % references an unknown role', NEW.user_id, NEW.store_id
+            USING ERRCODE = 'foreign_key_violation';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_store_memberships_roles_exist
+    BEFORE INSERT OR UPDATE OF roles
+    ON store_memberships
+    FOR EACH ROW
+EXECUTE FUNCTION check_membership_roles_exist();
+
+CREATE OR REPLACE FUNCTION check_role_unused() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1 FROM users WHERE roles @> ARRAY [OLD.name]::TEXT[])
+        OR EXISTS (SELECT 1 FROM store_memberships WHERE roles @> ARRAY [OLD.name]::TEXT[]) THEN
+        RAISE EXCEPTION 'role % is still assigned to users', OLD.name
+            USING ERRCODE = 'foreign_key_violation';
+    END IF;
+
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
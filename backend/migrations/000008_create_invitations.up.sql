@@ -0,0 +1,17 @@
+CREATE TABLE invitations
+(
+    id          UUID PRIMARY KEY,
+    email       VARCHAR(255) NOT NULL,
+    store_id    UUID         NOT NULL,
+    roles       TEXT[]       NOT NULL,
+    token_hash  CHAR(64)     NOT NULL UNIQUE,
+    invited_by  UUID         NOT NULL REFERENCES users (id),
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMPTZ  NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    revoked_at  TIMESTAMPTZ,
+
+    CONSTRAINT chk_invitation_roles CHECK (cardinality(roles) > 0)
+);
+
+CREATE INDEX idx_invitations_store_id ON invitations (store_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS store_password_policies;
+DROP TABLE IF EXISTS password_history;
@@ -0,0 +1,18 @@
+CREATE TABLE password_history
+(
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    password   VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_password_history_user_id ON password_history (user_id, id DESC);
+
+CREATE TABLE store_password_policies
+(
+    store_id     UUID PRIMARY KEY,
+    history_size INT         NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_history_size CHECK (history_size BETWEEN 0 AND 24)
+);
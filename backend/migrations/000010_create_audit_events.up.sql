@@ -0,0 +1,31 @@
+CREATE TABLE audit_events
+(
+    id          UUID PRIMARY KEY,
+    action      VARCHAR(64) NOT NULL,
+    actor_id    UUID,
+    target_id   UUID,
+    ip_address  VARCHAR(45),
+    user_agent  TEXT,
+    changes     JSONB       NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- actor_id and target_id carry no foreign key: the trail has to outlive the
+-- users it mentions.
+CREATE INDEX idx_audit_events_occurred_at ON audit_events (occurred_at DESC);
+CREATE INDEX idx_audit_events_actor_id ON audit_events (actor_id, occurred_at DESC);
+CREATE INDEX idx_audit_events_target_id ON audit_events (target_id, occurred_at DESC);
+CREATE INDEX idx_audit_events_action ON audit_events (action, occurred_at DESC);
+
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_events_append_only
+    BEFORE UPDATE OR DELETE OR TRUNCATE
+    ON audit_events
+    FOR EACH STATEMENT
+EXECUTE FUNCTION audit_events_append_only();
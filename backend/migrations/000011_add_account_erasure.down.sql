@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_invitations_email;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deleted_at;
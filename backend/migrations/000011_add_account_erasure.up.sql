@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_invitations_email ON invitations (email);
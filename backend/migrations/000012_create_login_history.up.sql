@@ -0,0 +1,16 @@
+CREATE TABLE login_history
+(
+    id          UUID PRIMARY KEY,
+    user_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    outcome     VARCHAR(32) NOT NULL,
+    ip_address  VARCHAR(45),
+    user_agent  TEXT,
+    device      VARCHAR(64) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_login_history_user_id ON login_history (user_id, occurred_at DESC);
+
+-- Serves the new device check, which only looks at successful logins.
+CREATE INDEX idx_login_history_seen ON login_history (user_id, device, ip_address, occurred_at DESC)
+    WHERE outcome = 'succeeded';
@@ -0,0 +1,8 @@
+-- impersonator_id is the admin who acted through an impersonated session;
+-- actor_id is then the impersonated user. Like the other ids, it carries no
+-- foreign key.
+ALTER TABLE audit_events
+    ADD COLUMN impersonator_id UUID;
+
+CREATE INDEX idx_audit_events_impersonator_id ON audit_events (impersonator_id, occurred_at DESC)
+    WHERE impersonator_id IS NOT NULL;
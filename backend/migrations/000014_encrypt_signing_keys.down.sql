@@ -0,0 +1,7 @@
+-- sealed keys cannot be decrypted here, and the previous code would read them
+-- as plaintext, so they are dropped: the keyring generates a new key on start
+-- and tokens signed with the dropped ones are no longer accepted.
+DELETE FROM signing_keys WHERE encrypted;
+
+ALTER TABLE signing_keys
+    DROP COLUMN IF EXISTS encrypted;
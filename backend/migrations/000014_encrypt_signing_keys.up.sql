@@ -0,0 +1,5 @@
+-- private_key now holds the key sealed with AES-256-GCM under a key kept out
+-- of the database. Rows written before this migration are still plaintext
+-- PKCS#8 DER; the repository seals them the first time it loads them.
+ALTER TABLE signing_keys
+    ADD COLUMN encrypted BOOLEAN NOT NULL DEFAULT FALSE;